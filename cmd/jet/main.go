@@ -3,12 +3,22 @@ package main
 import (
 	"flag"
 	"fmt"
+	_ "github.com/denisenkom/go-mssqldb"
+	"github.com/go-jet/jet/cockroachdb"
+	cockroachdbgen "github.com/go-jet/jet/generator/cockroachdb"
+	mariadbgen "github.com/go-jet/jet/generator/mariadb"
 	mysqlgen "github.com/go-jet/jet/generator/mysql"
 	postgresgen "github.com/go-jet/jet/generator/postgres"
+	sqlitegen "github.com/go-jet/jet/generator/sqlite"
+	sqlservergen "github.com/go-jet/jet/generator/sqlserver"
+	"github.com/go-jet/jet/mariadb"
 	"github.com/go-jet/jet/mysql"
 	"github.com/go-jet/jet/postgres"
+	"github.com/go-jet/jet/sqlite"
+	"github.com/go-jet/jet/sqlserver"
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 	"os"
 	"strings"
 )
@@ -25,11 +35,16 @@ var (
 	dbName     string
 	schemaName string
 
+	dsn string
+
+	ddlFile      string
+	snapshotFile string
+
 	destDir string
 )
 
 func init() {
-	flag.StringVar(&source, "source", "", "Database system name (PostgreSQL, MySQL or MariaDB)")
+	flag.StringVar(&source, "source", "", "Database system name (PostgreSQL, CockroachDB, MySQL, MariaDB, SQLite or SQLServer)")
 
 	flag.StringVar(&host, "host", "", "Database host path (Example: localhost)")
 	flag.IntVar(&port, "port", 0, "Database port")
@@ -37,9 +52,15 @@ func init() {
 	flag.StringVar(&password, "password", "", "The user’s password")
 	flag.StringVar(&params, "params", "", "Additional connection string parameters(optional)")
 	flag.StringVar(&dbName, "dbname", "", "Database name")
-	flag.StringVar(&schemaName, "schema", "public", `Database schema name. (default "public") (ignored for MySQL and MariaDB)`)
+	flag.StringVar(&schemaName, "schema", "public", `Database schema name, or comma separated list of schema names and patterns. (default "public", "dbo" for SQLServer) (ignored for MySQL and MariaDB)`)
 	flag.StringVar(&sslmode, "sslmode", "disable", `Whether or not to use SSL(optional)(default "disable") (ignored for MySQL and MariaDB)`)
 
+	flag.StringVar(&dsn, "dsn", "", "Database file path or connection string (Example: file:chinook.db) (SQLite only)")
+
+	flag.StringVar(&ddlFile, "ddl", "", `DDL script file to generate files from, instead of database connection (Example: schema.sql) (PostgreSQL only)`)
+
+	flag.StringVar(&snapshotFile, "snapshot", "", "Snapshot file path (snapshot and check command only)")
+
 	flag.StringVar(&destDir, "path", "", "Destination dir for files generated.")
 }
 
@@ -50,8 +71,21 @@ func main() {
 Jet generator 2.0.0
 
 Usage:
+  jet [command] [flags]
+
+Commands:
+  (none)
+        Generate SQL Builder and Model files at -path destination dir
+  snapshot
+        Save JSON snapshot of database schema meta data to -snapshot file
+  check
+        Compare database (or -snapshot file, if set) with files previously generated at -path destination dir.
+        Exits with non zero status and prints list of added (+), removed (-) and changed (~) tables, views, 
+        columns and enum values if generated files are not up to date.
+
+Flags:
   -source string
-    	Database system name (PostgreSQL, MySQL or MariaDB)
+    	Database system name (PostgreSQL, CockroachDB, MySQL, MariaDB, SQLite or SQLServer)
   -host string
         Database host path (Example: localhost)
   -port int
@@ -65,62 +99,282 @@ Usage:
   -params string
         Additional connection string parameters(optional)
   -schema string
-        Database schema name. (default "public") (ignored for MySQL and MariaDB)
+        Database schema name, or comma separated list of schema names and patterns. 
+        (Example: public,sales_*) (default "public", "dbo" for SQLServer) (ignored for MySQL and MariaDB)
   -sslmode string
         Whether or not to use SSL(optional) (default "disable") (ignored for MySQL and MariaDB)
+  -dsn string
+        Database file path or connection string. Replaces other connection flags.
+        (Example: file:chinook.db) (SQLite only)
+  -ddl string
+        DDL script file to generate files from, instead of database connection. Connection flags are not
+        required, dbname is used as destination folder name. (Example: schema.sql) (PostgreSQL only)
+  -snapshot string
+        Snapshot file path (Example: jetdb.json) (snapshot and check command only)
   -path string
         Destination dir for files generated.
 `)
 	}
 
-	flag.Parse()
+	command := ""
 
-	if source == "" || host == "" || port == 0 || user == "" || dbName == "" {
+	if len(os.Args) > 1 && !strings.HasPrefix(os.Args[1], "-") {
+		command = os.Args[1]
+		_ = flag.CommandLine.Parse(os.Args[2:])
+	} else {
+		flag.Parse()
+	}
+
+	switch command {
+	case "":
+		if ddlFile != "" {
+			generateFromDDL()
+		} else {
+			generate()
+		}
+	case "snapshot":
+		saveSnapshot()
+	case "check":
+		check()
+	default:
+		printErrorAndExit("\nERROR: unknown command " + command)
+	}
+}
+
+func generate() {
+	requireConnectionFlags()
+
+	var err error
+
+	switch {
+	case isPostgres():
+		err = postgresgen.GenerateSchemas(destDir, postgresConnection(), splitList(schemaName)...)
+	case isCockroachDB():
+		err = cockroachdbgen.Generate(destDir, postgresConnection(), splitList(schemaName)...)
+	case isMySQL():
+		err = mysqlgen.Generate(destDir, mysqlConnection())
+	case isMariaDB():
+		err = mariadbgen.Generate(destDir, mysqlConnection())
+	case isSQLite():
+		err = sqlitegen.Generate(destDir, dsn)
+	case isSQLServer():
+		err = sqlservergen.Generate(destDir, sqlServerConnection())
+	default:
+		exitOnUnsupportedSource()
+	}
+
+	exitOnError(err)
+}
+
+func generateFromDDL() {
+	if source == "" || dbName == "" {
 		printErrorAndExit("\nERROR: required flag(s) missing")
 	}
 
+	if !isPostgres() {
+		fmt.Println("ERROR: unsupported DDL source " + source + ". Only " + postgres.Dialect.Name() + " DDL is currently supported.")
+		os.Exit(-4)
+	}
+
+	err := postgresgen.GenerateFromDDL(destDir, ddlFile, dbName, splitList(schemaName)...)
+
+	exitOnError(err)
+}
+
+func saveSnapshot() {
+	requireConnectionFlags()
+
+	if snapshotFile == "" {
+		printErrorAndExit("\nERROR: required flag -snapshot missing")
+	}
+
+	var err error
+
+	switch {
+	case isCockroachDB():
+		printErrorAndExit("\nERROR: snapshot command is not supported for " + cockroachdb.Dialect.Name())
+	case isPostgres():
+		err = postgresgen.SaveSnapshot(snapshotFile, postgresConnection(), splitList(schemaName)...)
+	case isMySQL():
+		err = mysqlgen.SaveSnapshot(snapshotFile, mysqlConnection())
+	case isMariaDB():
+		err = mariadbgen.SaveSnapshot(snapshotFile, mysqlConnection())
+	case isSQLite():
+		err = sqlitegen.SaveSnapshot(snapshotFile, dsn)
+	case isSQLServer():
+		err = sqlservergen.SaveSnapshot(snapshotFile, sqlServerConnection())
+	default:
+		exitOnUnsupportedSource()
+	}
+
+	exitOnError(err)
+}
+
+func check() {
+	if snapshotFile == "" {
+		requireConnectionFlags()
+	}
+
+	var diff []string
 	var err error
 
-	switch strings.ToLower(strings.TrimSpace(source)) {
-	case strings.ToLower(postgres.Dialect.Name()),
-		strings.ToLower(postgres.Dialect.PackageName()):
-		genData := postgresgen.DBConnection{
-			Host:     host,
-			Port:     port,
-			User:     user,
-			Password: password,
-			SslMode:  sslmode,
-			Params:   params,
-
-			DBName:     dbName,
-			SchemaName: schemaName,
+	switch {
+	case isCockroachDB():
+		printErrorAndExit("\nERROR: check command is not supported for " + cockroachdb.Dialect.Name())
+	case isPostgres() && snapshotFile != "":
+		diff, err = postgresgen.CheckSnapshot(destDir, snapshotFile)
+	case isPostgres():
+		diff, err = postgresgen.Check(destDir, postgresConnection(), splitList(schemaName)...)
+	case isMySQL() && snapshotFile != "":
+		diff, err = mysqlgen.CheckSnapshot(destDir, snapshotFile)
+	case isMySQL():
+		diff, err = mysqlgen.Check(destDir, mysqlConnection())
+	case isMariaDB() && snapshotFile != "":
+		diff, err = mariadbgen.CheckSnapshot(destDir, snapshotFile)
+	case isMariaDB():
+		diff, err = mariadbgen.Check(destDir, mysqlConnection())
+	case isSQLite() && snapshotFile != "":
+		diff, err = sqlitegen.CheckSnapshot(destDir, snapshotFile)
+	case isSQLite():
+		diff, err = sqlitegen.Check(destDir, dsn)
+	case isSQLServer() && snapshotFile != "":
+		diff, err = sqlservergen.CheckSnapshot(destDir, snapshotFile)
+	case isSQLServer():
+		diff, err = sqlservergen.Check(destDir, sqlServerConnection())
+	default:
+		exitOnUnsupportedSource()
+	}
+
+	exitOnError(err)
+
+	if len(diff) > 0 {
+		fmt.Println("Generated files are not up to date. Added (+), removed (-) and changed (~) since last generation:")
+		fmt.Println(strings.Join(diff, "\n"))
+		os.Exit(-6)
+	}
+
+	fmt.Println("Generated files are up to date.")
+}
+
+func requireConnectionFlags() {
+	if isSQLite() {
+		if dsn == "" {
+			printErrorAndExit("\nERROR: required flag -dsn missing")
 		}
+		return
+	}
+
+	if source == "" || host == "" || port == 0 || user == "" || dbName == "" {
+		printErrorAndExit("\nERROR: required flag(s) missing")
+	}
+}
+
+func isPostgres() bool {
+	sourceName := strings.ToLower(strings.TrimSpace(source))
 
-		err = postgresgen.Generate(destDir, genData)
+	return sourceName == strings.ToLower(postgres.Dialect.Name()) || sourceName == strings.ToLower(postgres.Dialect.PackageName())
+}
 
-	case strings.ToLower(mysql.Dialect.Name()), "mariadb":
+func isCockroachDB() bool {
+	sourceName := strings.ToLower(strings.TrimSpace(source))
+
+	return sourceName == strings.ToLower(cockroachdb.Dialect.Name())
+}
+
+func isMySQL() bool {
+	sourceName := strings.ToLower(strings.TrimSpace(source))
+
+	return sourceName == strings.ToLower(mysql.Dialect.Name())
+}
+
+func isMariaDB() bool {
+	sourceName := strings.ToLower(strings.TrimSpace(source))
+
+	return sourceName == strings.ToLower(mariadb.Dialect.Name())
+}
+
+func isSQLite() bool {
+	sourceName := strings.ToLower(strings.TrimSpace(source))
+
+	return sourceName == strings.ToLower(sqlite.Dialect.Name())
+}
 
-		dbConn := mysqlgen.DBConnection{
-			Host:     host,
-			Port:     port,
-			User:     user,
-			Password: password,
-			Params:   params,
-			DBName:   dbName,
+func isSQLServer() bool {
+	sourceName := strings.ToLower(strings.TrimSpace(source))
+
+	return sourceName == strings.ToLower(sqlserver.Dialect.Name()) || sourceName == "mssql"
+}
+
+func postgresConnection() postgresgen.DBConnection {
+	return postgresgen.DBConnection{
+		Host:     host,
+		Port:     port,
+		User:     user,
+		Password: password,
+		SslMode:  sslmode,
+		Params:   params,
+
+		DBName: dbName,
+	}
+}
+
+func mysqlConnection() mysqlgen.DBConnection {
+	return mysqlgen.DBConnection{
+		Host:     host,
+		Port:     port,
+		User:     user,
+		Password: password,
+		Params:   params,
+		DBName:   dbName,
+	}
+}
+
+// sqlServerConnection uses first -schema flag schema, or default SQL Server schema dbo if flag is not set
+func sqlServerConnection() sqlservergen.DBConnection {
+	sqlServerSchema := "dbo"
+
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "schema" && len(splitList(schemaName)) > 0 {
+			sqlServerSchema = splitList(schemaName)[0]
 		}
+	})
 
-		err = mysqlgen.Generate(destDir, dbConn)
-	default:
-		fmt.Println("ERROR: unsupported source " + source + ". " + postgres.Dialect.Name() + " and " + mysql.Dialect.Name() + " are currently supported.")
-		os.Exit(-4)
+	return sqlservergen.DBConnection{
+		Host:       host,
+		Port:       port,
+		User:       user,
+		Password:   password,
+		Params:     params,
+		DBName:     dbName,
+		SchemaName: sqlServerSchema,
 	}
+}
 
+func exitOnUnsupportedSource() {
+	fmt.Println("ERROR: unsupported source " + source + ". " + postgres.Dialect.Name() + ", " + cockroachdb.Dialect.Name() + ", " + mysql.Dialect.Name() +
+		", " + mariadb.Dialect.Name() + ", " + sqlite.Dialect.Name() + " and " + sqlserver.Dialect.Name() + " are currently supported.")
+	os.Exit(-4)
+}
+
+func exitOnError(err error) {
 	if err != nil {
 		fmt.Println(err.Error())
 		os.Exit(-5)
 	}
 }
 
+func splitList(list string) []string {
+	ret := []string{}
+
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			ret = append(ret, item)
+		}
+	}
+
+	return ret
+}
+
 func printErrorAndExit(error string) {
 	fmt.Println(error)
 	flag.Usage()
@@ -0,0 +1,6 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/postgres"
+
+// CAST function converts a expr (of any type) into latter specified datatype.
+var CAST = postgres.CAST
@@ -0,0 +1,64 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/postgres"
+
+// Column is common column interface for all types of columns.
+type Column = postgres.Column
+
+// ColumnList function returns list of columns that be used as projection or column list for UPDATE and INSERT statement.
+type ColumnList = postgres.ColumnList
+
+// ColumnBool is interface for SQL boolean columns.
+type ColumnBool = postgres.ColumnBool
+
+// BoolColumn creates named bool column.
+var BoolColumn = postgres.BoolColumn
+
+// ColumnString is interface for SQL text, character, character varying
+// bytea, uuid columns and enums types.
+type ColumnString = postgres.ColumnString
+
+// StringColumn creates named string column.
+var StringColumn = postgres.StringColumn
+
+// ColumnInteger is interface for SQL smallint, integer, bigint columns.
+type ColumnInteger = postgres.ColumnInteger
+
+// IntegerColumn creates named integer column.
+var IntegerColumn = postgres.IntegerColumn
+
+// ColumnFloat is interface for SQL real, numeric, decimal or double precision column.
+type ColumnFloat = postgres.ColumnFloat
+
+// FloatColumn creates named float column.
+var FloatColumn = postgres.FloatColumn
+
+// ColumnDate is interface of SQL date columns.
+type ColumnDate = postgres.ColumnDate
+
+// DateColumn creates named date column.
+var DateColumn = postgres.DateColumn
+
+// ColumnTime is interface for SQL time column.
+type ColumnTime = postgres.ColumnTime
+
+// TimeColumn creates named time column
+var TimeColumn = postgres.TimeColumn
+
+// ColumnTimez is interface of SQL time with time zone columns.
+type ColumnTimez = postgres.ColumnTimez
+
+// TimezColumn creates named time with time zone column.
+var TimezColumn = postgres.TimezColumn
+
+// ColumnTimestamp is interface of SQL timestamp columns.
+type ColumnTimestamp = postgres.ColumnTimestamp
+
+// TimestampColumn creates named timestamp column
+var TimestampColumn = postgres.TimestampColumn
+
+// ColumnTimestampz is interface of SQL timestamp with timezone columns.
+type ColumnTimestampz = postgres.ColumnTimestampz
+
+// TimestampzColumn creates named timestamp with time zone column.
+var TimestampzColumn = postgres.TimestampzColumn
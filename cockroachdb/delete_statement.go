@@ -0,0 +1,6 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/internal/pg"
+
+// DeleteStatement is interface for CockroachDB DELETE statement
+type DeleteStatement = pg.DeleteStatement
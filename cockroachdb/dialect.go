@@ -0,0 +1,34 @@
+package cockroachdb
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+	"github.com/go-jet/jet/postgres"
+)
+
+// Dialect is implementation of CockroachDB dialect for SQL Builder serialisation.
+// CockroachDB statements are serialized the same way as PostgreSQL statements.
+var Dialect jet.Dialect = &dialect{Dialect: postgres.Dialect}
+
+type dialect struct {
+	jet.Dialect
+}
+
+func (d *dialect) Name() string {
+	return "CockroachDB"
+}
+
+func (d *dialect) PackageName() string {
+	return "cockroachdb"
+}
+
+// capabilities are SQL features supported by CockroachDB. PostgreSQL features not listed, like DISTINCT ON,
+// LATERAL and GROUPS window frame, are reported as errors.
+var capabilities = map[jet.Capability]bool{
+	jet.CapabilityReturning:      true,
+	jet.CapabilityUpsert:         true,
+	jet.CapabilityAsOfSystemTime: true,
+}
+
+func (d *dialect) Supports(capability jet.Capability) bool {
+	return capabilities[capability]
+}
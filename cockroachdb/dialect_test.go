@@ -0,0 +1,21 @@
+package cockroachdb
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestDialect(t *testing.T) {
+	assert.Equal(t, Dialect.Name(), "CockroachDB")
+	assert.Equal(t, Dialect.PackageName(), "cockroachdb")
+}
+
+func TestDialectCapabilities(t *testing.T) {
+	assert.Equal(t, Dialect.Supports(jet.CapabilityReturning), true)
+	assert.Equal(t, Dialect.Supports(jet.CapabilityUpsert), true)
+	assert.Equal(t, Dialect.Supports(jet.CapabilityAsOfSystemTime), true)
+	assert.Equal(t, Dialect.Supports(jet.CapabilityDistinctOn), false)
+	assert.Equal(t, Dialect.Supports(jet.CapabilityLateral), false)
+	assert.Equal(t, Dialect.Supports(jet.CapabilityWindowGroupsFrame), false)
+}
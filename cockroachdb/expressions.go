@@ -0,0 +1,86 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/postgres"
+
+// Expression is common interface for all expressions.
+// Can be Bool, Int, Float, String, Date, Time, Timez, Timestamp or Timestampz expressions.
+type Expression = postgres.Expression
+
+// BoolExpression interface
+type BoolExpression = postgres.BoolExpression
+
+// StringExpression interface
+type StringExpression = postgres.StringExpression
+
+// IntegerExpression interface
+type IntegerExpression = postgres.IntegerExpression
+
+// FloatExpression is interface
+type FloatExpression = postgres.FloatExpression
+
+// TimeExpression interface
+type TimeExpression = postgres.TimeExpression
+
+// TimezExpression interface for 'time with time zone' types
+type TimezExpression = postgres.TimezExpression
+
+// DateExpression is interface for date types
+type DateExpression = postgres.DateExpression
+
+// TimestampExpression interface
+type TimestampExpression = postgres.TimestampExpression
+
+// TimestampzExpression interface
+type TimestampzExpression = postgres.TimestampzExpression
+
+// BoolExp is bool expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as bool expression.
+// Does not add sql cast to generated sql builder output.
+var BoolExp = postgres.BoolExp
+
+// IntExp is int expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as int expression.
+// Does not add sql cast to generated sql builder output.
+var IntExp = postgres.IntExp
+
+// FloatExp is date expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as float expression.
+// Does not add sql cast to generated sql builder output.
+var FloatExp = postgres.FloatExp
+
+// TimeExp is time expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as time expression.
+// Does not add sql cast to generated sql builder output.
+var TimeExp = postgres.TimeExp
+
+// StringExp is string expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as string expression.
+// Does not add sql cast to generated sql builder output.
+var StringExp = postgres.StringExp
+
+// TimezExp is time with time zone expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as time with time zone expression.
+// Does not add sql cast to generated sql builder output.
+var TimezExp = postgres.TimezExp
+
+// DateExp is date expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as date expression.
+// Does not add sql cast to generated sql builder output.
+var DateExp = postgres.DateExp
+
+// TimestampExp is timestamp expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timestamp expression.
+// Does not add sql cast to generated sql builder output.
+var TimestampExp = postgres.TimestampExp
+
+// TimestampzExp is timestamp with time zone expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timestamp with time zone expression.
+// Does not add sql cast to generated sql builder output.
+var TimestampzExp = postgres.TimestampzExp
+
+// Raw can be used for any unsupported functions, operators or expressions.
+// For example: Raw("current_database()")
+var Raw = postgres.Raw
+
+// NewEnumValue creates new named enum value
+var NewEnumValue = postgres.NewEnumValue
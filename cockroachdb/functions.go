@@ -0,0 +1,319 @@
+package cockroachdb
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+	"github.com/go-jet/jet/postgres"
+)
+
+// ROW is construct one table row from list of expressions.
+var ROW = postgres.ROW
+
+// ------------------ Mathematical functions ---------------//
+
+// ABSf calculates absolute value from float expression
+var ABSf = postgres.ABSf
+
+// ABSi calculates absolute value from int expression
+var ABSi = postgres.ABSi
+
+// POW calculates power of base with exponent
+var POW = postgres.POW
+
+// POWER calculates power of base with exponent
+var POWER = postgres.POWER
+
+// SQRT calculates square root of numeric expression
+var SQRT = postgres.SQRT
+
+// CBRT calculates cube root of numeric expression
+var CBRT = postgres.CBRT
+
+// CEIL calculates ceil of float expression
+var CEIL = postgres.CEIL
+
+// FLOOR calculates floor of float expression
+var FLOOR = postgres.FLOOR
+
+// ROUND calculates round of a float expressions with optional precision
+var ROUND = postgres.ROUND
+
+// SIGN returns sign of float expression
+var SIGN = postgres.SIGN
+
+// TRUNC calculates trunc of float expression with optional precision
+var TRUNC = postgres.TRUNC
+
+// LN calculates natural algorithm of float expression
+var LN = postgres.LN
+
+// LOG calculates logarithm of float expression
+var LOG = postgres.LOG
+
+// ----------------- Aggregate functions  -------------------//
+
+// AVG is aggregate function used to calculate avg value from numeric expression
+var AVG = postgres.AVG
+
+// BIT_AND is aggregate function used to calculates the bitwise AND of all non-null input values, or null if none.
+var BIT_AND = postgres.BIT_AND
+
+// BIT_OR is aggregate function used to calculates the bitwise OR of all non-null input values, or null if none.
+var BIT_OR = postgres.BIT_OR
+
+// BOOL_AND is aggregate function. Returns true if all input values are true, otherwise false
+var BOOL_AND = postgres.BOOL_AND
+
+// BOOL_OR is aggregate function. Returns true if at least one input value is true, otherwise false
+var BOOL_OR = postgres.BOOL_OR
+
+// COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
+var COUNT = postgres.COUNT
+
+// EVERY is aggregate function. Returns true if all input values are true, otherwise false
+var EVERY = postgres.EVERY
+
+// MAX is aggregate function. Returns maximum value of expression across all input values
+var MAX = postgres.MAX
+
+// MAXf is aggregate function. Returns maximum value of float expression across all input values
+var MAXf = postgres.MAXf
+
+// MAXi is aggregate function. Returns maximum value of int expression across all input values
+var MAXi = postgres.MAXi
+
+// MIN is aggregate function. Returns minimum value of expression across all input values.
+var MIN = postgres.MIN
+
+// MINf is aggregate function. Returns minimum value of float expression across all input values
+var MINf = postgres.MINf
+
+// MINi is aggregate function. Returns minimum value of int expression across all input values
+var MINi = postgres.MINi
+
+// SUMf is aggregate function. Returns sum of expression across all float expressions
+var SUMf = postgres.SUMf
+
+// SUMi is aggregate function. Returns sum of expression across all integer expression.
+var SUMi = postgres.SUMi
+
+// -------------------- Window functions -----------------------//
+
+// ROW_NUMBER returns number of the current row within its partition, counting from 1
+var ROW_NUMBER = postgres.ROW_NUMBER
+
+// RANK of the current row with gaps; same as row_number of its first peer
+var RANK = postgres.RANK
+
+// DENSE_RANK returns rank of the current row without gaps; this function counts peer groups
+var DENSE_RANK = postgres.DENSE_RANK
+
+// PERCENT_RANK calculates relative rank of the current row: (rank - 1) / (total partition rows - 1)
+var PERCENT_RANK = postgres.PERCENT_RANK
+
+// CUME_DIST calculates cumulative distribution: (number of partition rows preceding or peer with current row) / total partition rows
+var CUME_DIST = postgres.CUME_DIST
+
+// NTILE returns integer ranging from 1 to the argument value, dividing the partition as equally as possible
+var NTILE = postgres.NTILE
+
+// LAG returns value evaluated at the row that is offset rows before the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+// Both offset and default are evaluated with respect to the current row.
+// If omitted, offset defaults to 1 and default to null
+var LAG = postgres.LAG
+
+// LEAD returns value evaluated at the row that is offset rows after the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+// Both offset and default are evaluated with respect to the current row.
+// If omitted, offset defaults to 1 and default to null
+var LEAD = postgres.LEAD
+
+// FIRST_VALUE returns value evaluated at the row that is the first row of the window frame
+var FIRST_VALUE = postgres.FIRST_VALUE
+
+// LAST_VALUE returns value evaluated at the row that is the last row of the window frame
+var LAST_VALUE = postgres.LAST_VALUE
+
+// NTH_VALUE returns value evaluated at the row that is the nth row of the window frame (counting from 1); null if no such row
+var NTH_VALUE = postgres.NTH_VALUE
+
+//--------------------- String functions ------------------//
+
+// BIT_LENGTH returns number of bits in string expression
+var BIT_LENGTH = postgres.BIT_LENGTH
+
+// CHAR_LENGTH returns number of characters in string expression
+var CHAR_LENGTH = postgres.CHAR_LENGTH
+
+// OCTET_LENGTH returns number of bytes in string expression
+var OCTET_LENGTH = postgres.OCTET_LENGTH
+
+// LOWER returns string expression in lower case
+var LOWER = postgres.LOWER
+
+// UPPER returns string expression in upper case
+var UPPER = postgres.UPPER
+
+// BTRIM removes the longest string consisting only of characters
+// in characters (a space by default) from the start and end of string
+var BTRIM = postgres.BTRIM
+
+// LTRIM removes the longest string containing only characters
+// from characters (a space by default) from the start of string
+var LTRIM = postgres.LTRIM
+
+// RTRIM removes the longest string containing only characters
+// from characters (a space by default) from the end of string
+var RTRIM = postgres.RTRIM
+
+// CHR returns character with the given code.
+var CHR = postgres.CHR
+
+// CONCAT adds two or more expressions together
+var CONCAT = postgres.CONCAT
+
+// CONCAT_WS adds two or more expressions together with a separator.
+var CONCAT_WS = postgres.CONCAT_WS
+
+// CONVERT converts string to dest_encoding. The original encoding is
+// specified by src_encoding. The string must be valid in this encoding.
+var CONVERT = postgres.CONVERT
+
+// CONVERT_FROM converts string to the database encoding. The original
+// encoding is specified by src_encoding. The string must be valid in this encoding.
+var CONVERT_FROM = postgres.CONVERT_FROM
+
+// CONVERT_TO converts string to dest_encoding.
+var CONVERT_TO = postgres.CONVERT_TO
+
+// ENCODE encodes binary data into a textual representation.
+// Supported formats are: base64, hex, escape. escape converts zero bytes and
+// high-bit-set bytes to octal sequences (\nnn) and doubles backslashes.
+var ENCODE = postgres.ENCODE
+
+// DECODE decodes binary data from textual representation in string.
+// Options for format are same as in encode.
+var DECODE = postgres.DECODE
+
+// FORMAT formats a number to a format like "#,###,###.##", rounded to a specified number of decimal places, then it returns the result as a string.
+var FORMAT = postgres.FORMAT
+
+// INITCAP converts the first letter of each word to upper case
+// and the rest to lower case. Words are sequences of alphanumeric
+// characters separated by non-alphanumeric characters.
+var INITCAP = postgres.INITCAP
+
+// LEFT returns first n characters in the string.
+// When n is negative, return all but last |n| characters.
+var LEFT = postgres.LEFT
+
+// RIGHT returns last n characters in the string.
+// When n is negative, return all but first |n| characters.
+var RIGHT = postgres.RIGHT
+
+// LENGTH returns number of characters in string with a given encoding
+var LENGTH = postgres.LENGTH
+
+// LPAD fills up the string to length length by prepending the characters
+// fill (a space by default). If the string is already longer than length
+// then it is truncated (on the right).
+var LPAD = postgres.LPAD
+
+// RPAD fills up the string to length length by appending the characters
+// fill (a space by default). If the string is already longer than length then it is truncated.
+var RPAD = postgres.RPAD
+
+// MD5 calculates the MD5 hash of string, returning the result in hexadecimal
+var MD5 = postgres.MD5
+
+// REPEAT repeats string the specified number of times
+var REPEAT = postgres.REPEAT
+
+// REPLACE replaces all occurrences in string of substring from with substring to
+var REPLACE = postgres.REPLACE
+
+// REVERSE returns reversed string.
+var REVERSE = postgres.REVERSE
+
+// STRPOS returns location of specified substring (same as position(substring in string),
+// but note the reversed argument order)
+var STRPOS = postgres.STRPOS
+
+// SUBSTR extracts substring
+var SUBSTR = postgres.SUBSTR
+
+// TO_ASCII convert string to ASCII from another encoding
+var TO_ASCII = postgres.TO_ASCII
+
+// TO_HEX converts number to its equivalent hexadecimal representation
+var TO_HEX = postgres.TO_HEX
+
+//----------Data Type Formatting Functions ----------------------//
+
+// TO_CHAR converts expression to string with format
+var TO_CHAR = postgres.TO_CHAR
+
+// TO_DATE converts string to date using format
+var TO_DATE = postgres.TO_DATE
+
+// TO_NUMBER converts string to numeric using format
+var TO_NUMBER = postgres.TO_NUMBER
+
+// TO_TIMESTAMP converts string to time stamp with time zone using format
+var TO_TIMESTAMP = postgres.TO_TIMESTAMP
+
+//----------------- Date/Time Functions and Operators ------------//
+
+// CURRENT_DATE returns current date
+var CURRENT_DATE = postgres.CURRENT_DATE
+
+// CURRENT_TIME returns current time with time zone
+var CURRENT_TIME = postgres.CURRENT_TIME
+
+// CURRENT_TIMESTAMP returns current timestamp with time zone
+var CURRENT_TIMESTAMP = postgres.CURRENT_TIMESTAMP
+
+// LOCALTIME returns local time of day using optional precision
+var LOCALTIME = postgres.LOCALTIME
+
+// LOCALTIMESTAMP returns current date and time using optional precision
+var LOCALTIMESTAMP = postgres.LOCALTIMESTAMP
+
+// NOW returns current date and time
+var NOW = postgres.NOW
+
+// --------------- Sequence Manipulation Functions -------------//
+
+// NEXTVAL advances sequence and returns new value
+var NEXTVAL = postgres.NEXTVAL
+
+// CURRVAL returns value most recently obtained with NEXTVAL for sequence
+var CURRVAL = postgres.CURRVAL
+
+// SETVAL sets sequence current value and returns it
+var SETVAL = postgres.SETVAL
+
+// --------------- Conditional Expressions Functions -------------//
+
+// COALESCE function returns the first of its arguments that is not null.
+var COALESCE = postgres.COALESCE
+
+// NULLIF function returns a null value if value1 equals value2; otherwise it returns value1.
+var NULLIF = postgres.NULLIF
+
+// GREATEST selects the largest  value from a list of expressions
+var GREATEST = postgres.GREATEST
+
+// LEAST selects the smallest  value from a list of expressions
+var LEAST = postgres.LEAST
+
+// EXISTS checks for existence of the rows in subQuery
+var EXISTS = postgres.EXISTS
+
+// CASE create CASE operator with optional list of expressions
+var CASE = postgres.CASE
+
+// FOLLOWER_READ_TIMESTAMP returns timestamp recent enough for follower reads, to be used with AS OF SYSTEM TIME
+func FOLLOWER_READ_TIMESTAMP() TimestampzExpression {
+	return TimestampzExp(jet.NewTimestampFunc("FOLLOWER_READ_TIMESTAMP"))
+}
@@ -0,0 +1,6 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/internal/pg"
+
+// InsertStatement is interface for SQL INSERT and UPSERT statements
+type InsertStatement = pg.InsertStatement
@@ -0,0 +1,18 @@
+package cockroachdb
+
+import "testing"
+
+func TestUpsert(t *testing.T) {
+	assertStatementSql(t, table1.UPSERT(table1Col1, table1ColFloat).VALUES(1, 2.2).RETURNING(table1Col1), `
+UPSERT INTO db.table1 (col1, col_float) VALUES
+     ($1, $2)
+RETURNING table1.col1 AS "table1.col1";
+`, 1, 2.2)
+}
+
+func TestInsert(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1).VALUES(1), `
+INSERT INTO db.table1 (col1) VALUES
+     ($1);
+`, 1)
+}
@@ -0,0 +1,15 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/postgres"
+
+const (
+	// DEFAULT is jet equivalent of SQL DEFAULT
+	DEFAULT = postgres.DEFAULT
+)
+
+var (
+	// NULL is jet equivalent of SQL NULL
+	NULL = postgres.NULL
+	// STAR is jet equivalent of SQL *
+	STAR = postgres.STAR
+)
@@ -0,0 +1,75 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/postgres"
+
+// Bool creates new bool literal expression
+var Bool = postgres.Bool
+
+// Int creates new integer literal expression
+var Int = postgres.Int
+
+// Float creates new float literal expression
+var Float = postgres.Float
+
+// String creates new string literal expression
+var String = postgres.String
+
+// Bytea craates new bytea literal expression
+var Bytea = postgres.Bytea
+
+// Date creates new date literal expression
+var Date = postgres.Date
+
+// DateT creates new date literal expression from time.Time object
+var DateT = postgres.DateT
+
+// Time creates new time literal expression
+var Time = postgres.Time
+
+// TimeT creates new time literal expression from time.Time object
+var TimeT = postgres.TimeT
+
+// Timez creates new time with time zone literal expression
+var Timez = postgres.Timez
+
+// TimezT creates new time with time zone literal expression from time.Time object
+var TimezT = postgres.TimezT
+
+// Timestamp creates new timestamp literal expression
+var Timestamp = postgres.Timestamp
+
+// TimestampT creates new timestamp literal expression from time.Time object
+var TimestampT = postgres.TimestampT
+
+// Timestampz creates new timestamp with time zone literal expression
+var Timestampz = postgres.Timestampz
+
+// TimestampzT creates new timestamp literal expression from time.Time object
+var TimestampzT = postgres.TimestampzT
+
+// BoolParam creates named bool parameter, bound when prepared statement is executed
+var BoolParam = postgres.BoolParam
+
+// IntParam creates named integer parameter, bound when prepared statement is executed
+var IntParam = postgres.IntParam
+
+// FloatParam creates named float parameter, bound when prepared statement is executed
+var FloatParam = postgres.FloatParam
+
+// StringParam creates named string parameter, bound when prepared statement is executed
+var StringParam = postgres.StringParam
+
+// DateParam creates named date parameter, bound when prepared statement is executed
+var DateParam = postgres.DateParam
+
+// TimeParam creates named time parameter, bound when prepared statement is executed
+var TimeParam = postgres.TimeParam
+
+// TimezParam creates named time with time zone parameter, bound when prepared statement is executed
+var TimezParam = postgres.TimezParam
+
+// TimestampParam creates named timestamp parameter, bound when prepared statement is executed
+var TimestampParam = postgres.TimestampParam
+
+// TimestampzParam creates named timestamp with time zone parameter, bound when prepared statement is executed
+var TimestampzParam = postgres.TimestampzParam
@@ -0,0 +1,25 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/postgres"
+
+// NOT returns negation of bool expression result
+var NOT = postgres.NOT
+
+// BIT_NOT inverts every bit in integer expression result
+var BIT_NOT = postgres.BIT_NOT
+
+// AND joins conditions with AND operator, skipping nil conditions
+var AND = postgres.AND
+
+// OR joins conditions with OR operator, skipping nil conditions
+var OR = postgres.OR
+
+// Conditional returns condition if include is true, otherwise nil. Condition is evaluated even if include is false.
+var Conditional = postgres.Conditional
+
+// ConditionalFunc returns condition created by conditionFunc if include is true, otherwise nil.
+// conditionFunc is called only if include is true.
+var ConditionalFunc = postgres.ConditionalFunc
+
+// ConditionalOrderBy returns orderBy if include is true, otherwise nil. Nil clauses are skipped in ORDER BY clause.
+var ConditionalOrderBy = postgres.ConditionalOrderBy
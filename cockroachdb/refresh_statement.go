@@ -0,0 +1,6 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/internal/pg"
+
+// RefreshMaterializedViewStatement is interface for CockroachDB REFRESH MATERIALIZED VIEW statement
+type RefreshMaterializedViewStatement = pg.RefreshMaterializedViewStatement
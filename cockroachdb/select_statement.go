@@ -0,0 +1,48 @@
+package cockroachdb
+
+import (
+	"github.com/go-jet/jet/internal/pg"
+	"github.com/go-jet/jet/postgres"
+)
+
+// RowLock is interface for SELECT statement row lock types
+type RowLock = postgres.RowLock
+
+// Row lock types
+var (
+	UPDATE        = postgres.UPDATE
+	NO_KEY_UPDATE = postgres.NO_KEY_UPDATE
+	SHARE         = postgres.SHARE
+	KEY_SHARE     = postgres.KEY_SHARE
+)
+
+// Window function clauses
+var (
+	PARTITION_BY = postgres.PARTITION_BY
+	ORDER_BY     = postgres.ORDER_BY
+	UNBOUNDED    = postgres.UNBOUNDED
+	CURRENT_ROW  = postgres.CURRENT_ROW
+)
+
+// PRECEDING window frame clause
+var PRECEDING = postgres.PRECEDING
+
+// FOLLOWING window frame clause
+var FOLLOWING = postgres.FOLLOWING
+
+// Window definition reference
+var Window = postgres.Window
+
+// SelectStatement is interface for CockroachDB SELECT statement
+type SelectStatement = pg.SelectStatement
+
+// SELECT creates new SelectStatement with list of projections
+func SELECT(projection Projection, projections ...Projection) SelectStatement {
+	return pg.SELECT(Dialect, append([]Projection{projection}, projections...))
+}
+
+// CountQuery derives statement that counts rows of statement: SELECT COUNT(*) AS "count" FROM (statement) AS "count_query".
+// ORDER BY, LIMIT and OFFSET clauses of statement are dropped, so total count can be derived from page query.
+func CountQuery(statement SelectStatement) SelectStatement {
+	return pg.CountQuery(Dialect, statement)
+}
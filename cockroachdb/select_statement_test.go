@@ -0,0 +1,47 @@
+package cockroachdb
+
+import "testing"
+
+func TestSelectAsOfSystemTime(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).AS_OF_SYSTEM_TIME(String("-10s")).WHERE(table1ColInt.GT(Int(2))), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+AS OF SYSTEM TIME $1
+WHERE table1.col_int > $2;
+`, "-10s", int64(2))
+	assertStatementSql(t, table1.SELECT(table1ColInt).AS_OF_SYSTEM_TIME(FOLLOWER_READ_TIMESTAMP()), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+AS OF SYSTEM TIME FOLLOWER_READ_TIMESTAMP();
+`)
+}
+
+func TestSelectDistinct(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt).DISTINCT().FROM(table1), `
+SELECT DISTINCT table1.col_int AS "table1.col_int"
+FROM db.table1;
+`)
+}
+
+func TestSelectUnsupported(t *testing.T) {
+	assertStatementSqlErr(t, SELECT(table1ColInt).DISTINCT(table1ColInt).FROM(table1),
+		"jet: DISTINCT ON is not supported by CockroachDB dialect")
+	assertStatementSqlErr(t, SELECT(table1ColInt).
+		FROM(table1.CROSS_JOIN(LATERAL(SELECT(table2ColInt).FROM(table2).WHERE(table2ColInt.EQ(table1ColInt)), "t2"))),
+		"jet: LATERAL is not supported by CockroachDB dialect")
+	assertStatementSqlErr(t, SELECT(SUMi(table1ColInt).OVER(ORDER_BY(table1ColInt).GROUPS(PRECEDING(1), CURRENT_ROW))).FROM(table1),
+		"jet: GROUPS window frame is not supported by CockroachDB dialect")
+}
+
+func TestCountQuery(t *testing.T) {
+	page := SELECT(table1ColInt).FROM(table1).AS_OF_SYSTEM_TIME(String("-10s")).ORDER_BY(table1ColInt).LIMIT(10)
+
+	assertStatementSql(t, CountQuery(page), `
+SELECT COUNT(*) AS "count"
+FROM (
+          SELECT table1.col_int AS "table1.col_int"
+          FROM db.table1
+          AS OF SYSTEM TIME $1
+     ) AS count_query;
+`, "-10s")
+}
@@ -0,0 +1,11 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/internal/pg"
+
+// SelectTable is interface for CockroachDB sub-queries
+type SelectTable = pg.SelectTable
+
+// LATERAL creates sub-query that can reference columns of FROM items appearing before it
+func LATERAL(selectStmt SelectStatement, alias string) SelectTable {
+	return pg.LATERAL(Dialect, selectStmt, alias)
+}
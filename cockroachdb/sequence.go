@@ -0,0 +1,9 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/postgres"
+
+// Sequence is interface for CockroachDB sequences
+type Sequence = postgres.Sequence
+
+// NewSequence creates new sequence with schema name and sequence name
+var NewSequence = postgres.NewSequence
@@ -0,0 +1,44 @@
+package cockroachdb
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+	"github.com/go-jet/jet/internal/pg"
+)
+
+type setStatement = pg.SetStatement
+
+// UNION effectively appends the result of sub-queries(select statements) into single query.
+// It eliminates duplicate rows from its result.
+func UNION(lhs, rhs jet.StatementWithProjections, selects ...jet.StatementWithProjections) setStatement {
+	return pg.UNION(Dialect, lhs, rhs, selects...)
+}
+
+// UNION_ALL effectively appends the result of sub-queries(select statements) into single query.
+// It does not eliminates duplicate rows from its result.
+func UNION_ALL(lhs, rhs jet.StatementWithProjections, selects ...jet.StatementWithProjections) setStatement {
+	return pg.UNION_ALL(Dialect, lhs, rhs, selects...)
+}
+
+// INTERSECT returns all rows that are in query results.
+// It eliminates duplicate rows from its result.
+func INTERSECT(lhs, rhs jet.StatementWithProjections, selects ...jet.StatementWithProjections) setStatement {
+	return pg.INTERSECT(Dialect, lhs, rhs, selects...)
+}
+
+// INTERSECT_ALL returns all rows that are in query results.
+// It does not eliminates duplicate rows from its result.
+func INTERSECT_ALL(lhs, rhs jet.StatementWithProjections, selects ...jet.StatementWithProjections) setStatement {
+	return pg.INTERSECT_ALL(Dialect, lhs, rhs, selects...)
+}
+
+// EXCEPT returns all rows that are in the result of query lhs but not in the result of query rhs.
+// It eliminates duplicate rows from its result.
+func EXCEPT(lhs, rhs jet.StatementWithProjections) setStatement {
+	return pg.EXCEPT(Dialect, lhs, rhs)
+}
+
+// EXCEPT_ALL returns all rows that are in the result of query lhs but not in the result of query rhs.
+// It does not eliminates duplicate rows from its result.
+func EXCEPT_ALL(lhs, rhs jet.StatementWithProjections) setStatement {
+	return pg.EXCEPT_ALL(Dialect, lhs, rhs)
+}
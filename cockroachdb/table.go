@@ -0,0 +1,58 @@
+package cockroachdb
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+	"github.com/go-jet/jet/internal/pg"
+)
+
+// Table is interface for CockroachDB tables
+type Table interface {
+	pg.Readable
+	pg.Writable
+	jet.SerializerTable
+
+	// UPSERT creates UPSERT statement, that inserts rows or updates rows with conflicting primary key
+	UPSERT(columns ...jet.Column) InsertStatement
+}
+
+// ReadableTable interface
+type ReadableTable = pg.ReadableTable
+
+// WritableTable interface
+type WritableTable = pg.WritableTable
+
+type tableImpl struct {
+	pg.Table
+}
+
+// NewTable creates new table with schema Name, table Name and list of columns
+func NewTable(schemaName, name string, column jet.ColumnExpression, columns ...jet.ColumnExpression) Table {
+	return &tableImpl{
+		Table: pg.NewTable(Dialect, schemaName, name, column, columns...),
+	}
+}
+
+func (t *tableImpl) UPSERT(columns ...jet.Column) InsertStatement {
+	return pg.NewUpsertStatement(Dialect, t, jet.UnwidColumnList(columns))
+}
+
+// MaterializedView is interface for CockroachDB materialized views. Materialized views are read only.
+type MaterializedView = pg.MaterializedView
+
+// NewMaterializedView creates new materialized view with schema Name, view Name and list of columns
+func NewMaterializedView(schemaName, name string, column jet.ColumnExpression, columns ...jet.ColumnExpression) MaterializedView {
+	return pg.NewMaterializedView(Dialect, schemaName, name, column, columns...)
+}
+
+// TableFunction is interface for CockroachDB set returning functions, callable as read only tables
+type TableFunction = pg.TableFunction
+
+// NewTableFunction creates new table function call with schema Name, function Name, list of function arguments
+// and list of result columns
+func NewTableFunction(schemaName, name string, args []Expression, column jet.ColumnExpression, columns ...jet.ColumnExpression) TableFunction {
+	return pg.NewTableFunction(Dialect, schemaName, name, args, column, columns...)
+}
+
+// ConditionalJoin returns table joined by join function if include is true, otherwise table unchanged.
+// join is called only if include is true.
+var ConditionalJoin = pg.ConditionalJoin
@@ -0,0 +1,41 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/postgres"
+
+// Statement is common interface for all statements(SELECT, INSERT, UPSERT, UPDATE, DELETE)
+type Statement = postgres.Statement
+
+// FormatOption changes format of sql query returned by Sql and DebugSql
+type FormatOption = postgres.FormatOption
+
+// Sql query formats
+const (
+	Pretty     = postgres.Pretty
+	Compact    = postgres.Compact
+	Normalized = postgres.Normalized
+)
+
+// SerializeError is returned when statement can not be serialized
+type SerializeError = postgres.SerializeError
+
+// SetStrictMode turns on or off strict mode. In strict mode invalid statements and destinations panic,
+// instead of returning an error.
+var SetStrictMode = postgres.SetStrictMode
+
+// ExplainMapping explains how statement projections would be mapped onto destination, without running the statement
+var ExplainMapping = postgres.ExplainMapping
+
+// PreparedStatement is statement prepared over database connection, executed with named parameter values
+type PreparedStatement = postgres.PreparedStatement
+
+// StatementCache is bounded cache of statements prepared on database connection pool
+type StatementCache = postgres.StatementCache
+
+// NewStatementCache creates new cache of at most size statements prepared on db
+var NewStatementCache = postgres.NewStatementCache
+
+// CompiledStatement is statement serialized once, reused on every execution
+type CompiledStatement = postgres.CompiledStatement
+
+// Projection is interface for all projection types. Types that can be part of, for instance SELECT clause.
+type Projection = postgres.Projection
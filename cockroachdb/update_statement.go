@@ -0,0 +1,6 @@
+package cockroachdb
+
+import "github.com/go-jet/jet/internal/pg"
+
+// UpdateStatement is interface of SQL UPDATE statement
+type UpdateStatement = pg.UpdateStatement
@@ -28,8 +28,16 @@ Then next step is to import generated SQL Builder and Model files and write SQL
 To write SQL queries for PostgreSQL import:
 	. "github.com/go-jet/jet/postgres"
 
-To write SQL queries for MySQL and MariaDB import:
+To write SQL queries for CockroachDB import:
+	. "github.com/go-jet/jet/cockroachdb"
+To write SQL queries for MySQL import:
 	. "github.com/go-jet/jet/mysql"
+To write SQL queries for MariaDB import:
+	. "github.com/go-jet/jet/mariadb"
+To write SQL queries for SQLite import:
+	. "github.com/go-jet/jet/sqlite"
+To write SQL queries for SQL Server import:
+	. "github.com/go-jet/jet/sqlserver"
 *Dot import is used so that Go code resemble as much as native SQL. Dot import is not mandatory.
 
 Write SQL:
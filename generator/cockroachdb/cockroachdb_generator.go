@@ -0,0 +1,15 @@
+package cockroachdb
+
+import (
+	"github.com/go-jet/jet/cockroachdb"
+	postgresgen "github.com/go-jet/jet/generator/postgres"
+)
+
+// DBConnection contains CockroachDB connection details
+type DBConnection = postgresgen.DBConnection
+
+// Generate generates jet files at destination dir for each database schema matching any of schema patterns.
+// CockroachDB is queried through PostgreSQL wire protocol, the same way as PostgreSQL database.
+func Generate(destDir string, dbConn DBConnection, schemaPatterns ...string) error {
+	return postgresgen.GenerateDialectSchemas(destDir, dbConn, cockroachdb.Dialect, schemaPatterns...)
+}
@@ -0,0 +1,597 @@
+package ddl
+
+import (
+	"fmt"
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"sort"
+	"strings"
+)
+
+// Parse parses PostgreSQL DDL script (CREATE TABLE, CREATE TYPE ... AS ENUM, CREATE [MATERIALIZED] VIEW, CREATE SEQUENCE
+// and ALTER TABLE ... ADD PRIMARY KEY statements, for instance output of 'pg_dump --schema-only') into list of schemas meta data.
+// Unqualified object names belong to defaultSchema, unless script changes search_path. Statements not affecting
+// generated files are ignored. Types of view columns are inferred from view query where possible, otherwise text type is used.
+func Parse(script string, defaultSchema string) ([]metadata.SchemaMetaData, error) {
+	statements, err := tokenize(script)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{
+		currentSchema: defaultSchema,
+		enums:         map[qualifiedName][]string{},
+		tables:        map[qualifiedName]*table{},
+	}
+
+	for _, statement := range statements {
+		if err := p.parseStatement(statement); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.schemasMetaData(), nil
+}
+
+type qualifiedName struct {
+	schema string
+	name   string
+}
+
+func (q qualifiedName) String() string {
+	return q.schema + "." + q.name
+}
+
+type column struct {
+	name       string
+	dataType   string
+	typeSchema string
+	isArray    bool
+	notNull    bool
+}
+
+type table struct {
+	qualifiedName
+	isView         bool
+	isMaterialized bool
+	columns        []*column
+	primaryKeys    map[string]bool
+	// search path schema at the moment of table creation, used to resolve unqualified column types
+	searchPathSchema string
+}
+
+func (t *table) column(name string) *column {
+	for _, column := range t.columns {
+		if column.name == name {
+			return column
+		}
+	}
+
+	return nil
+}
+
+func (t *table) primaryKeysNotNull() {
+	for primaryKey := range t.primaryKeys {
+		if primaryKeyColumn := t.column(primaryKey); primaryKeyColumn != nil {
+			primaryKeyColumn.notNull = true
+		}
+	}
+}
+
+type parser struct {
+	currentSchema string
+
+	enumsOrder  []qualifiedName
+	enums       map[qualifiedName][]string
+	tablesOrder []qualifiedName
+	tables      map[qualifiedName]*table
+	sequences   []qualifiedName
+
+	tokens []token
+	pos    int
+}
+
+func (p *parser) parseStatement(tokens []token) error {
+	p.tokens, p.pos = tokens, 0
+
+	switch {
+	case p.acceptKeywords("set", "search_path"):
+		p.acceptKeyword("to")
+		p.acceptSymbol("=")
+		if p.peek().isIdentifier() || p.peek().Type == stringToken {
+			p.currentSchema = p.next().Value
+		}
+		return nil
+
+	case p.acceptKeywords("create"):
+		p.acceptKeywords("or", "replace")
+		p.acceptKeyword("global", "local")
+		p.acceptKeyword("temp", "temporary", "unlogged")
+
+		switch {
+		case p.acceptKeyword("table"):
+			return p.parseCreateTable()
+		case p.acceptKeyword("type"):
+			return p.parseCreateType()
+		case p.acceptKeywords("recursive", "view"), p.acceptKeyword("view"):
+			return p.parseCreateView(false)
+		case p.acceptKeywords("materialized", "view"):
+			return p.parseCreateView(true)
+		case p.acceptKeyword("sequence"):
+			return p.parseCreateSequence()
+		}
+
+	case p.acceptKeywords("alter", "table"):
+		return p.parseAlterTable()
+	}
+
+	return nil
+}
+
+func (p *parser) parseCreateTable() error {
+	p.acceptKeywords("if", "not", "exists")
+
+	name, err := p.parseQualifiedName()
+	if err != nil {
+		return err
+	}
+
+	if !p.acceptSymbol("(") {
+		return nil // CREATE TABLE ... AS, PARTITION OF and OF type statements are not supported
+	}
+
+	newTable := &table{
+		qualifiedName:    name,
+		primaryKeys:      map[string]bool{},
+		searchPathSchema: p.currentSchema,
+	}
+
+	for !p.acceptSymbol(")") {
+		if p.eof() {
+			return p.errorf("unexpected end of CREATE TABLE %s statement", name)
+		}
+
+		if p.peek().isKeyword("constraint", "primary", "unique", "check", "foreign", "exclude", "like") {
+			p.parseTableConstraint(newTable)
+		} else if err := p.parseColumnDefinition(newTable); err != nil {
+			return err
+		}
+
+		p.acceptSymbol(",")
+	}
+
+	newTable.primaryKeysNotNull()
+	p.addTable(newTable)
+
+	return nil
+}
+
+func (p *parser) parseColumnDefinition(newTable *table) error {
+	if !p.peek().isIdentifier() {
+		return p.errorf("column name expected in CREATE TABLE %s statement", newTable.qualifiedName)
+	}
+
+	newColumn := &column{name: p.next().Value}
+
+	p.parseColumnType(newColumn)
+
+	for !p.eof() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") {
+		switch {
+		case p.acceptKeywords("not", "null"):
+			newColumn.notNull = true
+		case p.acceptKeywords("primary", "key"):
+			newColumn.notNull = true
+			newTable.primaryKeys[newColumn.name] = true
+		case p.peek().isSymbol("("):
+			p.skipParentheses()
+		default:
+			p.next()
+		}
+	}
+
+	newTable.columns = append(newTable.columns, newColumn)
+
+	return nil
+}
+
+var columnConstraintKeywords = []string{"not", "null", "default", "primary", "references", "check", "unique",
+	"constraint", "collate", "generated", "deferrable", "initially"}
+
+// parseColumnType parses column type, skipping type modifiers, for instance: varchar(20), timestamp(6) with time zone, int[].
+func (p *parser) parseColumnType(column *column) {
+	var words []string
+
+	for !p.eof() {
+		current := p.peek()
+
+		switch {
+		case current.isSymbol(",") || current.isSymbol(")") || current.isKeyword(columnConstraintKeywords...):
+			column.dataType = normalizeType(words)
+			column.notNull = column.notNull || strings.Contains(strings.Join(words, " "), "serial")
+			return
+		case current.isSymbol("("):
+			p.skipParentheses()
+		case current.isSymbol("["):
+			column.isArray = true
+			p.next()
+		case current.isKeyword("array"):
+			column.isArray = true
+			p.next()
+		case current.isSymbol("."):
+			p.next()
+			if len(words) > 0 && p.peek().isIdentifier() {
+				column.typeSchema = words[len(words)-1]
+				words[len(words)-1] = p.next().Value
+			}
+		case current.isIdentifier():
+			words = append(words, p.next().Value)
+		default:
+			p.next()
+		}
+	}
+
+	column.dataType = normalizeType(words)
+}
+
+func (p *parser) parseTableConstraint(newTable *table) {
+	if p.acceptKeyword("constraint") {
+		p.next()
+	}
+
+	if p.acceptKeywords("primary", "key") {
+		for _, primaryKey := range p.parseNameList() {
+			newTable.primaryKeys[primaryKey] = true
+		}
+	}
+
+	for !p.eof() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") {
+		if p.peek().isSymbol("(") {
+			p.skipParentheses()
+		} else {
+			p.next()
+		}
+	}
+}
+
+func (p *parser) parseCreateType() error {
+	name, err := p.parseQualifiedName()
+	if err != nil {
+		return err
+	}
+
+	if !p.acceptKeywords("as", "enum") || !p.acceptSymbol("(") {
+		return nil // only enum types are supported
+	}
+
+	values := []string{}
+
+	for !p.acceptSymbol(")") {
+		if p.eof() {
+			return p.errorf("unexpected end of CREATE TYPE %s statement", name)
+		}
+
+		current := p.next()
+
+		if current.Type == stringToken {
+			values = append(values, current.Value)
+		}
+	}
+
+	if _, exists := p.enums[name]; !exists {
+		p.enumsOrder = append(p.enumsOrder, name)
+	}
+	p.enums[name] = values
+
+	return nil
+}
+
+func (p *parser) parseAlterTable() error {
+	p.acceptKeywords("if", "exists")
+	p.acceptKeyword("only")
+
+	name, err := p.parseQualifiedName()
+	if err != nil {
+		return err
+	}
+
+	alteredTable, ok := p.tables[name]
+
+	if !ok {
+		return nil
+	}
+
+	for !p.eof() {
+		switch {
+		case p.acceptKeyword("add"):
+			if p.peek().isKeyword("constraint", "primary") {
+				p.parseTableConstraint(alteredTable)
+			}
+		case p.acceptKeyword("alter"):
+			p.acceptKeyword("column")
+			if !p.peek().isIdentifier() {
+				continue
+			}
+			alteredColumn := alteredTable.column(p.next().Value)
+			if alteredColumn != nil && p.acceptKeywords("set", "not", "null") {
+				alteredColumn.notNull = true
+			} else if alteredColumn != nil && p.acceptKeywords("drop", "not", "null") {
+				alteredColumn.notNull = false
+			}
+		default:
+			p.next()
+		}
+	}
+
+	alteredTable.primaryKeysNotNull()
+
+	return nil
+}
+
+func (p *parser) parseCreateSequence() error {
+	p.acceptKeywords("if", "not", "exists")
+
+	name, err := p.parseQualifiedName()
+	if err != nil {
+		return err
+	}
+
+	for _, sequence := range p.sequences {
+		if sequence == name {
+			return nil
+		}
+	}
+
+	p.sequences = append(p.sequences, name)
+
+	return nil
+}
+
+func (p *parser) addTable(newTable *table) {
+	if _, exists := p.tables[newTable.qualifiedName]; !exists {
+		p.tablesOrder = append(p.tablesOrder, newTable.qualifiedName)
+	}
+
+	p.tables[newTable.qualifiedName] = newTable
+}
+
+func (p *parser) parseQualifiedName() (qualifiedName, error) {
+	if !p.peek().isIdentifier() {
+		return qualifiedName{}, p.errorf("object name expected")
+	}
+
+	name := qualifiedName{schema: p.currentSchema, name: p.next().Value}
+
+	if p.acceptSymbol(".") {
+		if !p.peek().isIdentifier() {
+			return qualifiedName{}, p.errorf("object name expected after %s.", name.name)
+		}
+
+		name.schema, name.name = name.name, p.next().Value
+	}
+
+	return name, nil
+}
+
+// parseNameList parses parenthesized list of identifiers, for instance: (film_id, actor_id)
+func (p *parser) parseNameList() []string {
+	var names []string
+
+	if !p.acceptSymbol("(") {
+		return nil
+	}
+
+	for !p.eof() && !p.acceptSymbol(")") {
+		current := p.next()
+
+		if current.isIdentifier() {
+			names = append(names, current.Value)
+		}
+	}
+
+	return names
+}
+
+func (p *parser) skipParentheses() {
+	depth := 0
+
+	for !p.eof() {
+		current := p.next()
+
+		if current.isSymbol("(") {
+			depth++
+		} else if current.isSymbol(")") {
+			depth--
+		}
+
+		if depth == 0 {
+			return
+		}
+	}
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	return p.peekAt(0)
+}
+
+func (p *parser) peekAt(offset int) token {
+	if p.pos+offset >= len(p.tokens) {
+		return token{Type: symbolToken}
+	}
+
+	return p.tokens[p.pos+offset]
+}
+
+func (p *parser) next() token {
+	current := p.peek()
+	p.pos++
+	return current
+}
+
+// acceptKeyword consumes next token if it is one of keywords.
+func (p *parser) acceptKeyword(keywords ...string) bool {
+	if p.peek().isKeyword(keywords...) {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+// acceptKeywords consumes next tokens if they match keywords sequence.
+func (p *parser) acceptKeywords(keywords ...string) bool {
+	for i, keyword := range keywords {
+		if !p.peekAt(i).isKeyword(keyword) {
+			return false
+		}
+	}
+
+	p.pos += len(keywords)
+
+	return true
+}
+
+func (p *parser) acceptSymbol(symbol string) bool {
+	if p.peek().isSymbol(symbol) {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("ddl: "+format, args...)
+}
+
+// schemasMetaData converts parsed tables, views, enums and sequences into schemas meta data, sorted by schema name.
+func (p *parser) schemasMetaData() []metadata.SchemaMetaData {
+	schemas := map[string]*metadata.SchemaMetaData{}
+
+	schema := func(schemaName string) *metadata.SchemaMetaData {
+		if _, ok := schemas[schemaName]; !ok {
+			schemas[schemaName] = &metadata.SchemaMetaData{SchemaName: schemaName}
+		}
+
+		return schemas[schemaName]
+	}
+
+	for _, enumName := range p.enumsOrder {
+		enumSchema := schema(enumName.schema)
+		enumSchema.EnumsMetaData = append(enumSchema.EnumsMetaData, metadata.EnumMetaData{
+			EnumName: enumName.name,
+			Values:   p.enums[enumName],
+		})
+	}
+
+	for _, tableName := range p.tablesOrder {
+		parsedTable := p.tables[tableName]
+		tableSchema := schema(tableName.schema)
+		tableMetaData := metadata.NewTableMetaData(tableName.schema, tableName.name, parsedTable.primaryKeys, p.columnsMetaData(parsedTable))
+
+		switch {
+		case parsedTable.isMaterialized:
+			tableSchema.MaterializedViewsMetaData = append(tableSchema.MaterializedViewsMetaData, tableMetaData)
+		case parsedTable.isView:
+			tableSchema.ViewsMetaData = append(tableSchema.ViewsMetaData, tableMetaData)
+		default:
+			tableSchema.TablesMetaData = append(tableSchema.TablesMetaData, tableMetaData)
+		}
+	}
+
+	for _, sequenceName := range p.sequences {
+		sequenceSchema := schema(sequenceName.schema)
+		sequenceSchema.SequencesMetaData = append(sequenceSchema.SequencesMetaData, metadata.SequenceMetaData{
+			SchemaName:   sequenceName.schema,
+			SequenceName: sequenceName.name,
+		})
+	}
+
+	ret := []metadata.SchemaMetaData{}
+
+	for _, schema := range schemas {
+		ret = append(ret, *schema)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].SchemaName < ret[j].SchemaName
+	})
+
+	return ret
+}
+
+func (p *parser) columnsMetaData(parsedTable *table) []metadata.ColumnMetaData {
+	ret := []metadata.ColumnMetaData{}
+
+	for _, parsedColumn := range parsedTable.columns {
+		dataType, enumName, enumSchema := parsedColumn.dataType, "", ""
+
+		if parsedColumn.isArray {
+			dataType = "ARRAY"
+		} else if enum := p.resolveEnum(parsedColumn, parsedTable.searchPathSchema); enum != nil {
+			dataType, enumName, enumSchema = "USER-DEFINED", enum.name, enum.schema
+		}
+
+		columnMetaData := metadata.NewColumnMetaData(parsedColumn.name, !parsedColumn.notNull, dataType, enumName, false)
+		columnMetaData.EnumSchema = enumSchema
+
+		ret = append(ret, columnMetaData)
+	}
+
+	return ret
+}
+
+func (p *parser) resolveEnum(column *column, searchPathSchema string) *qualifiedName {
+	enumName := qualifiedName{schema: column.typeSchema, name: column.dataType}
+
+	if enumName.schema == "" {
+		enumName.schema = searchPathSchema
+	}
+
+	if _, ok := p.enums[enumName]; ok {
+		return &enumName
+	}
+
+	return nil
+}
+
+// normalizeType converts DDL type name into information_schema.columns data_type name.
+func normalizeType(words []string) string {
+	typeName := strings.Join(words, " ")
+
+	switch typeName {
+	case "int", "int4", "integer", "serial", "serial4":
+		return "integer"
+	case "int2", "smallint", "smallserial", "serial2":
+		return "smallint"
+	case "int8", "bigint", "bigserial", "serial8":
+		return "bigint"
+	case "bool", "boolean":
+		return "boolean"
+	case "varchar", "character varying":
+		return "character varying"
+	case "char", "character", "bpchar":
+		return "character"
+	case "decimal", "numeric":
+		return "numeric"
+	case "float4", "real":
+		return "real"
+	case "float", "float8", "double precision":
+		return "double precision"
+	case "timestamp", "timestamp without time zone":
+		return "timestamp without time zone"
+	case "timestamptz", "timestamp with time zone":
+		return "timestamp with time zone"
+	case "time", "time without time zone":
+		return "time without time zone"
+	case "timetz", "time with time zone":
+		return "time with time zone"
+	case "varbit", "bit varying":
+		return "bit varying"
+	}
+
+	return typeName
+}
@@ -0,0 +1,203 @@
+package ddl
+
+import (
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"gotest.tools/assert"
+	"testing"
+)
+
+const dvdsDDL = `
+--
+-- PostgreSQL database dump
+--
+
+SET statement_timeout = 0;
+SELECT pg_catalog.set_config('search_path', '', false);
+
+CREATE TYPE public.mpaa_rating AS ENUM (
+    'G',
+    'PG',
+    'PG-13'
+);
+
+CREATE FUNCTION public.last_updated() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    NEW.last_update = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END $$;
+
+CREATE TABLE public.actor (
+    actor_id integer DEFAULT nextval('public.actor_actor_id_seq'::regclass) NOT NULL,
+    first_name character varying(45) NOT NULL,
+    last_name character varying(45) NOT NULL,
+    last_update timestamp without time zone DEFAULT now() NOT NULL
+);
+
+CREATE TABLE public.film (
+    film_id serial,
+    title character varying(255) NOT NULL,
+    rating public.mpaa_rating DEFAULT 'G'::public.mpaa_rating,
+    special_features text[],
+    fulltext tsvector NOT NULL,
+    "Rental Rate" numeric(4,2) DEFAULT 4.99 NOT NULL
+);
+
+/* multi line
+   comment; */
+CREATE VIEW public.actor_info AS
+ SELECT a.actor_id,
+    a.first_name AS name,
+    f.rating,
+    count(*) AS film_count,
+    (a.last_name)::text AS last_name_text,
+    f.*
+   FROM ((public.actor a
+     LEFT JOIN public.film f ON ((f.film_id = a.actor_id)))
+     LEFT JOIN (SELECT 1 AS x) sub ON (true))
+  GROUP BY a.actor_id, a.first_name;
+
+ALTER TABLE ONLY public.actor
+    ADD CONSTRAINT actor_pkey PRIMARY KEY (actor_id);
+`
+
+func TestParse(t *testing.T) {
+	schemas, err := Parse(dvdsDDL, "public")
+	assert.NilError(t, err)
+	assert.Equal(t, len(schemas), 1)
+
+	schema := schemas[0]
+	assert.Equal(t, schema.SchemaName, "public")
+	assert.Equal(t, len(schema.TablesMetaData), 2)
+	assert.Equal(t, len(schema.ViewsMetaData), 1)
+	assert.DeepEqual(t, schema.EnumsMetaData, []metadata.MetaData{
+		metadata.EnumMetaData{EnumName: "mpaa_rating", Values: []string{"G", "PG", "PG-13"}},
+	})
+
+	actor := schema.TablesMetaData[0].(metadata.TableMetaData)
+	assert.Equal(t, actor.Name(), "actor")
+	assert.Equal(t, actor.SchemaName, "public")
+	assert.DeepEqual(t, actor.PrimaryKeys, map[string]bool{"actor_id": true})
+	assertColumns(t, actor.Columns,
+		"actor_id integer int32",
+		"first_name character varying string",
+		"last_name character varying string",
+		"last_update timestamp without time zone time.Time",
+	)
+
+	film := schema.TablesMetaData[1].(metadata.TableMetaData)
+	assert.Equal(t, film.Name(), "film")
+	assertColumns(t, film.Columns,
+		"film_id integer int32",
+		"title character varying string",
+		"rating USER-DEFINED *MpaaRating",
+		"special_features ARRAY *string",
+		"fulltext tsvector string",
+		"Rental Rate numeric float64",
+	)
+	assert.Equal(t, film.Columns[2].EnumSchema, "public")
+
+	actorInfo := schema.ViewsMetaData[0].(metadata.TableMetaData)
+	assert.Equal(t, actorInfo.Name(), "actor_info")
+	assertColumns(t, actorInfo.Columns,
+		"actor_id integer *int32",
+		"name character varying *string",
+		"rating USER-DEFINED *MpaaRating",
+		"film_count text *string",
+		"last_name_text text *string",
+		"film_id integer *int32",
+		"title character varying *string",
+		"rating USER-DEFINED *MpaaRating",
+		"special_features ARRAY *string",
+		"fulltext tsvector *string",
+		"Rental Rate numeric *float64",
+	)
+}
+
+func TestParseSearchPath(t *testing.T) {
+	schemas, err := Parse(`
+CREATE TYPE level AS ENUM ('low', 'high');
+CREATE TABLE item (id bigint PRIMARY KEY, level level NOT NULL, created_at TIMESTAMPTZ);
+SET search_path TO sales;
+CREATE TABLE "order" (
+	id int8,
+	item_id int8 REFERENCES public.item (id),
+	item_level public.level,
+	CONSTRAINT order_pk PRIMARY KEY (id)
+);
+`, "public")
+
+	assert.NilError(t, err)
+	assert.Equal(t, len(schemas), 2)
+	assert.Equal(t, schemas[0].SchemaName, "public")
+	assert.Equal(t, schemas[1].SchemaName, "sales")
+
+	item := schemas[0].TablesMetaData[0].(metadata.TableMetaData)
+	assert.DeepEqual(t, item.PrimaryKeys, map[string]bool{"id": true})
+	assertColumns(t, item.Columns,
+		"id bigint int64",
+		"level USER-DEFINED Level",
+		"created_at timestamp with time zone *time.Time",
+	)
+
+	order := schemas[1].TablesMetaData[0].(metadata.TableMetaData)
+	assert.Equal(t, order.Name(), "order")
+	assert.Equal(t, order.SchemaName, "sales")
+	assert.DeepEqual(t, order.PrimaryKeys, map[string]bool{"id": true})
+	assertColumns(t, order.Columns,
+		"id bigint int64",
+		"item_id bigint *int64",
+		"item_level USER-DEFINED *Level",
+	)
+	assert.Equal(t, order.Columns[2].EnumSchema, "public")
+}
+
+func TestParseMaterializedViewAndSequence(t *testing.T) {
+	schemas, err := Parse(`
+CREATE SEQUENCE public.store_store_id_seq
+    START WITH 1
+    INCREMENT BY 1;
+CREATE TABLE store (store_id integer DEFAULT nextval('public.store_store_id_seq'::regclass) NOT NULL, name text);
+CREATE MATERIALIZED VIEW public.store_names AS
+ SELECT s.store_id, s.name
+   FROM public.store s
+  WITH NO DATA;
+`, "public")
+
+	assert.NilError(t, err)
+	assert.Equal(t, len(schemas), 1)
+	assert.Equal(t, len(schemas[0].TablesMetaData), 1)
+	assert.Equal(t, len(schemas[0].ViewsMetaData), 0)
+	assert.DeepEqual(t, schemas[0].SequencesMetaData, []metadata.MetaData{
+		metadata.SequenceMetaData{SchemaName: "public", SequenceName: "store_store_id_seq"},
+	})
+
+	storeNames := schemas[0].MaterializedViewsMetaData[0].(metadata.TableMetaData)
+	assert.Equal(t, storeNames.Name(), "store_names")
+	assertColumns(t, storeNames.Columns,
+		"store_id integer *int32",
+		"name text *string",
+	)
+}
+
+func TestParseError(t *testing.T) {
+	_, err := Parse(`CREATE TABLE actor (actor_id integer`, "public")
+	assert.Error(t, err, "ddl: unexpected end of CREATE TABLE public.actor statement")
+
+	_, err = Parse(`CREATE TABLE 'actor'`, "public")
+	assert.Error(t, err, "ddl: object name expected")
+
+	_, err = Parse(`CREATE TABLE actor (name text DEFAULT 'unterminated)`, "public")
+	assert.Error(t, err, "ddl: unterminated quoted text 'unterminated)")
+}
+
+func assertColumns(t *testing.T, columns []metadata.ColumnMetaData, expected ...string) {
+	var actual []string
+
+	for _, column := range columns {
+		actual = append(actual, column.Name+" "+column.DataType+" "+column.GoModelType)
+	}
+
+	assert.DeepEqual(t, actual, expected)
+}
@@ -0,0 +1,184 @@
+package ddl
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenType int
+
+const (
+	identToken tokenType = iota
+	quotedIdentToken
+	stringToken
+	numberToken
+	symbolToken
+)
+
+type token struct {
+	Type  tokenType
+	Value string
+}
+
+// isKeyword returns true if token is unquoted identifier equal to one of keywords.
+func (t token) isKeyword(keywords ...string) bool {
+	if t.Type != identToken {
+		return false
+	}
+
+	for _, keyword := range keywords {
+		if t.Value == keyword {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t token) isSymbol(symbol string) bool {
+	return t.Type == symbolToken && t.Value == symbol
+}
+
+func (t token) isIdentifier() bool {
+	return t.Type == identToken || t.Type == quotedIdentToken
+}
+
+// tokenize splits sql script into list of statements, each statement being list of tokens.
+// Unquoted identifiers are lowercased, comments are skipped.
+func tokenize(sql string) ([][]token, error) {
+	var statements [][]token
+	var statement []token
+
+	runes := []rune(sql)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := indexRunes(runes, i+2, []rune("*/"))
+			if end < 0 {
+				return nil, fmt.Errorf("ddl: unterminated comment")
+			}
+			i = end + 2
+
+		case r == '\'' || ((r == 'e' || r == 'E') && i+1 < len(runes) && runes[i+1] == '\''):
+			if r != '\'' {
+				i++
+			}
+			value, next, err := readQuoted(runes, i, '\'')
+			if err != nil {
+				return nil, err
+			}
+			statement = append(statement, token{Type: stringToken, Value: value})
+			i = next
+
+		case r == '"':
+			value, next, err := readQuoted(runes, i, '"')
+			if err != nil {
+				return nil, err
+			}
+			statement = append(statement, token{Type: quotedIdentToken, Value: value})
+			i = next
+
+		case r == '$' && i+1 < len(runes) && (runes[i+1] == '$' || unicode.IsLetter(runes[i+1])):
+			tagEnd := i + 1
+			for tagEnd < len(runes) && runes[tagEnd] != '$' && isIdentRune(runes[tagEnd]) {
+				tagEnd++
+			}
+			if tagEnd >= len(runes) || runes[tagEnd] != '$' {
+				statement = append(statement, token{Type: symbolToken, Value: "$"})
+				i++
+				continue
+			}
+			tag := runes[i : tagEnd+1]
+			end := indexRunes(runes, tagEnd+1, tag)
+			if end < 0 {
+				return nil, fmt.Errorf("ddl: unterminated dollar quoted string")
+			}
+			statement = append(statement, token{Type: stringToken, Value: string(runes[tagEnd+1 : end])})
+			i = end + len(tag)
+
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			statement = append(statement, token{Type: numberToken, Value: string(runes[start:i])})
+
+		case isIdentRune(r):
+			start := i
+			for i < len(runes) && (isIdentRune(runes[i]) || runes[i] == '$') {
+				i++
+			}
+			statement = append(statement, token{Type: identToken, Value: strings.ToLower(string(runes[start:i]))})
+
+		case r == ';':
+			if len(statement) > 0 {
+				statements = append(statements, statement)
+			}
+			statement = nil
+			i++
+
+		case r == ':' && i+1 < len(runes) && runes[i+1] == ':':
+			statement = append(statement, token{Type: symbolToken, Value: "::"})
+			i += 2
+
+		default:
+			statement = append(statement, token{Type: symbolToken, Value: string(r)})
+			i++
+		}
+	}
+
+	if len(statement) > 0 {
+		statements = append(statements, statement)
+	}
+
+	return statements, nil
+}
+
+// readQuoted reads quoted text starting at runes[start], where quote character is escaped by doubling it.
+func readQuoted(runes []rune, start int, quote rune) (string, int, error) {
+	var value []rune
+
+	for i := start + 1; i < len(runes); i++ {
+		if runes[i] != quote {
+			value = append(value, runes[i])
+			continue
+		}
+
+		if i+1 < len(runes) && runes[i+1] == quote {
+			value = append(value, quote)
+			i++
+			continue
+		}
+
+		return string(value), i + 1, nil
+	}
+
+	return "", 0, fmt.Errorf("ddl: unterminated quoted text %s", string(runes[start:]))
+}
+
+// indexRunes returns index of the first occurrence of sub in runes starting from index from, or -1 if not present.
+func indexRunes(runes []rune, from int, sub []rune) int {
+	for i := from; i+len(sub) <= len(runes); i++ {
+		if string(runes[i:i+len(sub)]) == string(sub) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
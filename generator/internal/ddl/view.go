@@ -0,0 +1,299 @@
+package ddl
+
+import (
+	"fmt"
+)
+
+var fromClauseEndKeywords = []string{"where", "group", "having", "window", "order", "limit", "offset", "fetch",
+	"for", "union", "intersect", "except", "with"}
+
+var reservedKeywords = []string{"as", "end", "null", "true", "false", "and", "or", "not", "is", "in", "between",
+	"like", "ilike", "then", "else", "when", "case", "from", "distinct", "all"}
+
+var joinKeywords = []string{"join", "on", "using", "left", "right", "full", "inner", "outer", "cross", "natural",
+	"lateral"}
+
+// parseCreateView parses CREATE [MATERIALIZED] VIEW statement. View columns names and types are inferred from view
+// select list, view columns are always nullable.
+func (p *parser) parseCreateView(materialized bool) error {
+	if materialized {
+		p.acceptKeywords("if", "not", "exists")
+	}
+
+	name, err := p.parseQualifiedName()
+	if err != nil {
+		return err
+	}
+
+	columnNames := p.parseNameList()
+
+	for !p.eof() && !p.acceptKeyword("as") {
+		p.next()
+	}
+
+	newView := &table{
+		qualifiedName:    name,
+		isView:           true,
+		isMaterialized:   materialized,
+		primaryKeys:      map[string]bool{},
+		searchPathSchema: p.currentSchema,
+	}
+
+	if p.peek().isSymbol("(") {
+		p.next() // parenthesized query, closing parenthesis is ignored by select list parser
+	}
+
+	if !p.acceptKeyword("select") {
+		fmt.Println("- [DDL        ] Unsupported query of view '" + name.String() + "', view skipped.")
+		return nil
+	}
+
+	p.acceptKeyword("all")
+	if p.acceptKeyword("distinct") && p.acceptKeyword("on") {
+		p.skipParentheses()
+	}
+
+	selectList := p.splitUntil(func(t token) bool { return t.isKeyword("from") || t.isKeyword(fromClauseEndKeywords...) })
+	sources := p.parseFromClause()
+
+	for _, item := range selectList {
+		newView.columns = append(newView.columns, p.selectListItemColumns(item, sources)...)
+	}
+
+	for i, columnName := range columnNames {
+		if i < len(newView.columns) {
+			newView.columns[i].name = columnName
+		}
+	}
+
+	for _, column := range newView.columns {
+		column.notNull = false
+	}
+
+	p.addTable(newView)
+
+	return nil
+}
+
+// splitUntil splits tokens separated with top level commas, until top level token for which isEnd returns true.
+func (p *parser) splitUntil(isEnd func(t token) bool) [][]token {
+	var items [][]token
+	var item []token
+	depth := 0
+
+	for ; !p.eof(); p.pos++ {
+		current := p.peek()
+
+		if depth == 0 && isEnd(current) {
+			break
+		}
+
+		switch {
+		case current.isSymbol("("):
+			depth++
+		case current.isSymbol(")"):
+			depth--
+		}
+
+		if depth < 0 {
+			break
+		}
+
+		if depth == 0 && current.isSymbol(",") {
+			items = append(items, item)
+			item = nil
+			continue
+		}
+
+		item = append(item, current)
+	}
+
+	if len(item) > 0 {
+		items = append(items, item)
+	}
+
+	return items
+}
+
+type viewSource struct {
+	alias string
+	table *table
+}
+
+type viewSources []viewSource
+
+func (v viewSources) source(alias string) *table {
+	for _, source := range v {
+		if source.alias == alias {
+			return source.table
+		}
+	}
+
+	return nil
+}
+
+// parseFromClause returns tables and views referenced in FROM clause, in order of appearance.
+func (p *parser) parseFromClause() viewSources {
+	var sources viewSources
+
+	if !p.acceptKeyword("from") {
+		return sources
+	}
+
+	expectTable := true
+
+	for !p.eof() && !p.peek().isKeyword(fromClauseEndKeywords...) {
+		current := p.peek()
+
+		switch {
+		case current.isSymbol("(") && p.peekAt(1).isKeyword("select", "values", "with"):
+			p.skipParentheses() // sub query
+			expectTable = false
+		case current.isSymbol(",") || current.isKeyword("join"):
+			expectTable = true
+			p.next()
+		case expectTable && current.isIdentifier() && !current.isKeyword(joinKeywords...):
+			expectTable = false
+
+			name, err := p.parseQualifiedName()
+			if err != nil {
+				return sources
+			}
+
+			alias := name.name
+			p.acceptKeyword("as")
+			if p.peek().isIdentifier() && !p.peek().isKeyword(joinKeywords...) && !p.peek().isKeyword(fromClauseEndKeywords...) {
+				alias = p.next().Value
+			}
+
+			if source := p.tables[name]; source != nil {
+				sources = append(sources, viewSource{alias: alias, table: source})
+			}
+		default:
+			p.next()
+		}
+	}
+
+	return sources
+}
+
+// selectListItemColumns returns view columns for one select list item. Column type is taken from the referenced
+// table column or from the trailing type cast, otherwise text type is used.
+func (p *parser) selectListItemColumns(item []token, sources viewSources) []*column {
+	if len(item) == 0 {
+		return nil
+	}
+
+	if len(item) == 1 && item[0].isSymbol("*") {
+		var columns []*column
+		for _, source := range sources {
+			columns = append(columns, copyColumns(source.table.columns)...)
+		}
+		return columns
+	}
+
+	if len(item) == 3 && item[1].isSymbol(".") && item[2].isSymbol("*") {
+		if source := sources.source(item[0].Value); source != nil {
+			return copyColumns(source.columns)
+		}
+		return nil
+	}
+
+	alias := ""
+	if n := len(item); n >= 2 && isAlias(item[n-1]) &&
+		(item[n-2].isKeyword("as") || isAlias(item[n-2]) || item[n-2].isSymbol(")") || item[n-2].Type == stringToken) {
+		alias = item[n-1].Value
+		item = item[:n-1]
+		if item[len(item)-1].isKeyword("as") {
+			item = item[:len(item)-1]
+		}
+	}
+
+	newColumn := &column{name: alias, dataType: "text"}
+
+	expression, castType := splitTypeCast(item)
+
+	if referenced := referencedColumn(expression, sources); referenced != nil {
+		*newColumn = *referenced
+		if alias != "" {
+			newColumn.name = alias
+		}
+	} else if newColumn.name == "" {
+		newColumn.name = expressionName(expression)
+	}
+
+	if len(castType) > 0 {
+		p.tokens, p.pos = append(castType, token{Type: symbolToken, Value: ","}), 0
+		newColumn.typeSchema, newColumn.isArray = "", false
+		p.parseColumnType(newColumn)
+	}
+
+	return []*column{newColumn}
+}
+
+// isAlias returns true if token can be used as select list item alias without AS keyword.
+func isAlias(t token) bool {
+	return t.Type == quotedIdentToken || (t.Type == identToken && !t.isKeyword(reservedKeywords...))
+}
+
+// splitTypeCast splits expression from the type of the last top level '::' type cast.
+func splitTypeCast(item []token) (expression []token, castType []token) {
+	depth := 0
+
+	for i := len(item) - 1; i >= 0; i-- {
+		switch {
+		case item[i].isSymbol(")"):
+			depth++
+		case item[i].isSymbol("("):
+			depth--
+		case depth == 0 && item[i].isSymbol("::"):
+			return item[:i], item[i+1:]
+		}
+	}
+
+	return item, nil
+}
+
+func referencedColumn(expression []token, sources viewSources) *column {
+	switch {
+	case len(expression) == 1 && expression[0].isIdentifier():
+		for _, source := range sources {
+			if column := source.table.column(expression[0].Value); column != nil {
+				return column
+			}
+		}
+	case len(expression) == 3 && expression[0].isIdentifier() && expression[1].isSymbol(".") && expression[2].isIdentifier():
+		if source := sources.source(expression[0].Value); source != nil {
+			return source.column(expression[2].Value)
+		}
+	}
+
+	return nil
+}
+
+// expressionName returns PostgreSQL default column name for expression, column name for column references and
+// function name for function calls.
+func expressionName(expression []token) string {
+	for i := len(expression) - 1; i >= 0; i-- {
+		if expression[i].isIdentifier() && (i+1 == len(expression) || expression[i+1].isSymbol("(")) {
+			return expression[i].Value
+		}
+	}
+
+	if len(expression) > 0 && expression[0].isIdentifier() {
+		return expression[0].Value
+	}
+
+	return "?column?"
+}
+
+func copyColumns(columns []*column) []*column {
+	var ret []*column
+
+	for _, column := range columns {
+		columnCopy := *column
+		ret = append(ret, &columnCopy)
+	}
+
+	return ret
+}
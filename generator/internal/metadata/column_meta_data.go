@@ -13,11 +13,14 @@ type ColumnMetaData struct {
 	IsNullable bool
 	DataType   string
 	EnumName   string
+	EnumSchema string
 	IsUnsigned bool
 
 	SqlBuilderColumnType string
 	GoBaseType           string
 	GoModelType          string
+	// GoModelImport is model import needed for GoBaseType declared outside of current package
+	GoModelImport string
 }
 
 // NewColumnMetaData create new column meta data that describes one column in SQL database
@@ -60,7 +63,8 @@ func (c ColumnMetaData) getSqlBuilderColumnType() string {
 	case "USER-DEFINED", "enum", "text", "character", "character varying", "bytea", "uuid",
 		"tsvector", "bit", "bit varying", "money", "json", "jsonb", "xml", "point", "interval", "line", "ARRAY",
 		"char", "varchar", "binary", "varbinary",
-		"tinyblob", "blob", "mediumblob", "longblob", "tinytext", "mediumtext", "longtext": // MySQL
+		"tinyblob", "blob", "mediumblob", "longblob", "tinytext", "mediumtext", "longtext", // MySQL
+		"inet4", "inet6": // MariaDB
 		return "String"
 	case "real", "numeric", "decimal", "double precision", "float",
 		"double": // MySQL
@@ -96,7 +100,8 @@ func (c ColumnMetaData) getGoBaseType() string {
 		return "[]byte"
 	case "text", "character", "character varying", "tsvector", "bit", "bit varying", "money", "json", "jsonb",
 		"xml", "point", "interval", "line", "ARRAY",
-		"char", "varchar", "tinytext", "mediumtext", "longtext": // MySQL
+		"char", "varchar", "tinytext", "mediumtext", "longtext", // MySQL
+		"inet4", "inet6": // MariaDB
 		return "string"
 	case "real":
 		return "float32"
@@ -127,6 +132,13 @@ func (c ColumnMetaData) getGoModelType() string {
 	return typeStr
 }
 
+// importEnumFrom makes column model type reference enum declared in model package of another schema.
+func (c *ColumnMetaData) importEnumFrom(packageAlias, importPath string) {
+	c.GoBaseType = packageAlias + "." + utils.ToGoIdentifier(c.EnumName)
+	c.GoModelType = c.getGoModelType()
+	c.GoModelImport = packageAlias + ` "` + importPath + `"`
+}
+
 // GoModelTag returns model field tag for column
 func (c ColumnMetaData) GoModelTag(isPrimaryKey bool) string {
 	tags := []string{}
@@ -151,12 +163,15 @@ func getColumnsMetaData(db *sql.DB, querySet DialectQuerySet, schemaName, tableN
 	ret := []ColumnMetaData{}
 
 	for rows.Next() {
-		var name, isNullable, dataType, enumName string
+		var name, isNullable, dataType, enumName, enumSchema string
 		var isUnsigned bool
-		err := rows.Scan(&name, &isNullable, &dataType, &enumName, &isUnsigned)
+		err := rows.Scan(&name, &isNullable, &dataType, &enumName, &isUnsigned, &enumSchema)
 		utils.PanicOnError(err)
 
-		ret = append(ret, NewColumnMetaData(name, isNullable == "YES", dataType, enumName, isUnsigned))
+		columnMetaData := NewColumnMetaData(name, isNullable == "YES", dataType, enumName, isUnsigned)
+		columnMetaData.EnumSchema = enumSchema
+
+		ret = append(ret, columnMetaData)
 	}
 
 	err = rows.Err()
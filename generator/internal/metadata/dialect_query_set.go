@@ -12,4 +12,6 @@ type DialectQuerySet interface {
 	ListOfEnumsQuery() string
 
 	GetEnumsMetaData(db *sql.DB, schemaName string) []MetaData
+	GetFunctionsMetaData(db *sql.DB, schemaName string) []MetaData
+	GetSequencesMetaData(db *sql.DB, schemaName string) []MetaData
 }
@@ -0,0 +1,57 @@
+package metadata
+
+import (
+	"github.com/go-jet/jet/internal/utils"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// FunctionMetaData is meta data of table returning function. Function result columns are stored as table columns.
+type FunctionMetaData struct {
+	TableMetaData
+
+	Params []ColumnMetaData
+	// SpecificName is set for overloaded functions, and is used instead of function name for Go identifiers
+	SpecificName string
+}
+
+// NewFunctionMetaData creates new function meta data
+func NewFunctionMetaData(schemaName, name string, params []ColumnMetaData, columns []ColumnMetaData) FunctionMetaData {
+	return FunctionMetaData{
+		TableMetaData: NewTableMetaData(schemaName, name, map[string]bool{}, columns),
+		Params:        params,
+	}
+}
+
+// UniqueName returns function specific name for overloaded functions, and function name otherwise
+func (f FunctionMetaData) UniqueName() string {
+	if f.SpecificName != "" {
+		return f.SpecificName
+	}
+
+	return f.Name()
+}
+
+// GoStructName returns go struct name for sql builder of function call
+func (f FunctionMetaData) GoStructName() string {
+	return utils.ToGoIdentifier(f.UniqueName()) + "Table"
+}
+
+// GoParamName returns go function parameter name for function parameter at index i
+func (f FunctionMetaData) GoParamName(i int) string {
+	name := f.Params[i].Name
+
+	if name == "" {
+		return "arg" + strconv.Itoa(i+1)
+	}
+
+	identifier := utils.ToGoIdentifier(name)
+	identifier = strings.ToLower(identifier[:1]) + identifier[1:]
+
+	if token.IsKeyword(identifier) {
+		return identifier + "_"
+	}
+
+	return identifier
+}
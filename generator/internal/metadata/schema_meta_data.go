@@ -4,34 +4,53 @@ import (
 	"database/sql"
 	"fmt"
 	"github.com/go-jet/jet/internal/utils"
+	"sort"
+	"strings"
 )
 
 // SchemaMetaData struct
 type SchemaMetaData struct {
-	TablesMetaData []MetaData
-	ViewsMetaData  []MetaData
-	EnumsMetaData  []MetaData
+	SchemaName string
+
+	TablesMetaData            []MetaData
+	ViewsMetaData             []MetaData
+	MaterializedViewsMetaData []MetaData
+	EnumsMetaData             []MetaData
+	FunctionsMetaData         []MetaData
+	SequencesMetaData         []MetaData
 }
 
-// IsEmpty returns true if schema info does not contain any table, views or enums metadata
+// IsEmpty returns true if schema info does not contain any table, views, enums, functions or sequences metadata
 func (s SchemaMetaData) IsEmpty() bool {
-	return len(s.TablesMetaData) == 0 && len(s.ViewsMetaData) == 0 && len(s.EnumsMetaData) == 0
+	return len(s.TablesMetaData) == 0 && len(s.ViewsMetaData) == 0 && len(s.MaterializedViewsMetaData) == 0 &&
+		len(s.EnumsMetaData) == 0 && len(s.FunctionsMetaData) == 0 && len(s.SequencesMetaData) == 0
 }
 
 const (
-	baseTable = "BASE TABLE"
-	view      = "VIEW"
+	baseTable        = "BASE TABLE"
+	view             = "VIEW"
+	materializedView = "MATERIALIZED VIEW"
 )
 
 // GetSchemaMetaData returns schema information from db connection.
 func GetSchemaMetaData(db *sql.DB, schemaName string, querySet DialectQuerySet) (schemaInfo SchemaMetaData) {
 
+	schemaInfo.SchemaName = schemaName
+
 	schemaInfo.TablesMetaData = getTablesMetaData(db, querySet, schemaName, baseTable)
 	schemaInfo.ViewsMetaData = getTablesMetaData(db, querySet, schemaName, view)
+	schemaInfo.MaterializedViewsMetaData = getTablesMetaData(db, querySet, schemaName, materializedView)
 	schemaInfo.EnumsMetaData = querySet.GetEnumsMetaData(db, schemaName)
+	schemaInfo.FunctionsMetaData = querySet.GetFunctionsMetaData(db, schemaName)
+	schemaInfo.SequencesMetaData = querySet.GetSequencesMetaData(db, schemaName)
+
+	sort.Slice(schemaInfo.EnumsMetaData, func(i, j int) bool {
+		return schemaInfo.EnumsMetaData[i].Name() < schemaInfo.EnumsMetaData[j].Name()
+	})
 
 	fmt.Println("	FOUND", len(schemaInfo.TablesMetaData), "table(s),", len(schemaInfo.ViewsMetaData), "view(s),",
-		len(schemaInfo.EnumsMetaData), "enum(s)")
+		len(schemaInfo.MaterializedViewsMetaData), "materialized view(s),", len(schemaInfo.EnumsMetaData), "enum(s),",
+		len(schemaInfo.FunctionsMetaData), "function(s),", len(schemaInfo.SequencesMetaData), "sequence(s)")
 
 	return
 }
@@ -59,3 +78,177 @@ func getTablesMetaData(db *sql.DB, querySet DialectQuerySet, schemaName, tableTy
 
 	return ret
 }
+
+func (s SchemaMetaData) enum(enumName string) MetaData {
+	for _, enum := range s.EnumsMetaData {
+		if enum.Name() == enumName {
+			return enum
+		}
+	}
+
+	return nil
+}
+
+// LinkSchemasEnums resolves table, view and function columns and function parameters referencing enum types declared
+// in other schema. If modelImportPath returns import path of enum schema model package, column model type is imported
+// from that package, otherwise enum is copied into referencing schema. Enum is copied as well, if importing it would
+// create import cycle between schema model packages. Referenced enums are looked up in schemas and enumSchemas list.
+func LinkSchemasEnums(schemas []SchemaMetaData, enumSchemas []SchemaMetaData, modelImportPath func(schemaName string) string) {
+	lookup := map[string]SchemaMetaData{}
+
+	for _, schema := range append(enumSchemas, schemas...) {
+		lookup[schema.SchemaName] = schema
+	}
+
+	imports := modelImports(schemas, modelImportPath)
+
+	for i := range schemas {
+		schemaName := schemas[i].SchemaName
+
+		schemaImportPath := func(enumSchemaName string) string {
+			if imports.reaches(enumSchemaName, schemaName) {
+				return "" // enum schema model package imports this schema model package
+			}
+
+			return modelImportPath(enumSchemaName)
+		}
+
+		for _, enumSchemaName := range imports[schemaName] {
+			if imports.reaches(enumSchemaName, schemaName) {
+				fmt.Println("- [Model      ] Schemas '" + schemaName + "' and '" + enumSchemaName + "' reference each " +
+					"other's enums, enums of schema '" + enumSchemaName + "' are copied into schema '" + schemaName + "' model.")
+			}
+		}
+
+		schemas[i].TablesMetaData = linkTablesEnums(&schemas[i], schemas[i].TablesMetaData, lookup, schemaImportPath)
+		schemas[i].ViewsMetaData = linkTablesEnums(&schemas[i], schemas[i].ViewsMetaData, lookup, schemaImportPath)
+		schemas[i].MaterializedViewsMetaData = linkTablesEnums(&schemas[i], schemas[i].MaterializedViewsMetaData, lookup, schemaImportPath)
+		schemas[i].FunctionsMetaData = linkTablesEnums(&schemas[i], schemas[i].FunctionsMetaData, lookup, schemaImportPath)
+	}
+}
+
+// schemaImports maps schema name to names of schemas whose model packages are imported by schema model package
+type schemaImports map[string][]string
+
+func modelImports(schemas []SchemaMetaData, modelImportPath func(schemaName string) string) schemaImports {
+	imports := schemaImports{}
+
+	for _, schema := range schemas {
+		for _, enumSchemaName := range schema.ReferencedEnumSchemaNames() {
+			if enumSchemaName != schema.SchemaName && modelImportPath(enumSchemaName) != "" {
+				imports[schema.SchemaName] = append(imports[schema.SchemaName], enumSchemaName)
+			}
+		}
+	}
+
+	return imports
+}
+
+// reaches returns true if model package of schema from imports, directly or indirectly, model package of schema to
+func (s schemaImports) reaches(from, to string) bool {
+	visited := map[string]bool{}
+	queue := []string{from}
+
+	for len(queue) > 0 {
+		schemaName := queue[0]
+		queue = queue[1:]
+
+		if schemaName == to {
+			return true
+		}
+
+		if visited[schemaName] {
+			continue
+		}
+
+		visited[schemaName] = true
+		queue = append(queue, s[schemaName]...)
+	}
+
+	return false
+}
+
+// ReferencedEnumSchemaNames returns names of schemas with enums referenced from table, view and function columns and
+// function parameters.
+func (s SchemaMetaData) ReferencedEnumSchemaNames() []string {
+	var ret []string
+
+	tables := append(append([]MetaData{}, s.TablesMetaData...), s.ViewsMetaData...)
+	tables = append(append(tables, s.MaterializedViewsMetaData...), s.FunctionsMetaData...)
+
+	for _, metaData := range tables {
+		var columns []ColumnMetaData
+
+		switch table := metaData.(type) {
+		case TableMetaData:
+			columns = table.Columns
+		case FunctionMetaData:
+			columns = append(append(columns, table.Columns...), table.Params...)
+		}
+
+		for _, column := range columns {
+			if column.EnumSchema != "" && !utils.StringSliceContains(ret, column.EnumSchema) {
+				ret = append(ret, column.EnumSchema)
+			}
+		}
+	}
+
+	return ret
+}
+
+func linkTablesEnums(schema *SchemaMetaData, tables []MetaData, lookup map[string]SchemaMetaData,
+	modelImportPath func(schemaName string) string) []MetaData {
+
+	ret := []MetaData{}
+
+	for _, metaData := range tables {
+		switch table := metaData.(type) {
+		case TableMetaData:
+			table.Columns = linkColumnsEnums(schema, table.Name(), table.Columns, lookup, modelImportPath)
+			metaData = table
+		case FunctionMetaData:
+			table.Columns = linkColumnsEnums(schema, table.Name(), table.Columns, lookup, modelImportPath)
+			table.Params = linkColumnsEnums(schema, table.Name(), table.Params, lookup, modelImportPath)
+			metaData = table
+		}
+
+		ret = append(ret, metaData)
+	}
+
+	return ret
+}
+
+func linkColumnsEnums(schema *SchemaMetaData, tableName string, columns []ColumnMetaData, lookup map[string]SchemaMetaData,
+	modelImportPath func(schemaName string) string) []ColumnMetaData {
+
+	columns = append([]ColumnMetaData{}, columns...)
+
+	for i, column := range columns {
+		if column.EnumSchema == "" || column.EnumSchema == schema.SchemaName {
+			continue
+		}
+
+		enumSchema, ok := lookup[column.EnumSchema]
+		enum := enumSchema.enum(column.EnumName)
+
+		if !ok || enum == nil {
+			fmt.Println("- [Model      ] Enum '" + column.EnumSchema + "." + column.EnumName + "' not found, column '" +
+				tableName + "." + column.Name + "' will not compile.")
+			continue
+		}
+
+		if importPath := modelImportPath(column.EnumSchema); importPath != "" {
+			columns[i].importEnumFrom(enumSchemaPackageAlias(column.EnumSchema), importPath)
+		} else if schema.enum(column.EnumName) == nil {
+			schema.EnumsMetaData = append(schema.EnumsMetaData, enum)
+		}
+	}
+
+	return columns
+}
+
+func enumSchemaPackageAlias(schemaName string) string {
+	identifier := utils.ToGoIdentifier(schemaName)
+
+	return strings.ToLower(identifier[:1]) + identifier[1:] + "Model"
+}
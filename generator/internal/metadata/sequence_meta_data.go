@@ -0,0 +1,12 @@
+package metadata
+
+// SequenceMetaData struct
+type SequenceMetaData struct {
+	SchemaName   string
+	SequenceName string
+}
+
+// Name returns sequence name
+func (s SequenceMetaData) Name() string {
+	return s.SequenceName
+}
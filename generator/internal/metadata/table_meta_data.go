@@ -3,6 +3,7 @@ package metadata
 import (
 	"database/sql"
 	"github.com/go-jet/jet/internal/utils"
+	"sort"
 )
 
 // TableMetaData metadata struct
@@ -13,6 +14,16 @@ type TableMetaData struct {
 	Columns     []ColumnMetaData
 }
 
+// NewTableMetaData creates new table meta data
+func NewTableMetaData(schemaName, name string, primaryKeys map[string]bool, columns []ColumnMetaData) TableMetaData {
+	return TableMetaData{
+		SchemaName:  schemaName,
+		name:        name,
+		PrimaryKeys: primaryKeys,
+		Columns:     columns,
+	}
+}
+
 // Name returns table info name
 func (t TableMetaData) Name() string {
 	return t.name
@@ -47,9 +58,13 @@ func (t TableMetaData) GetImports() []string {
 
 		switch columnType {
 		case "time.Time":
-			imports["time.Time"] = "time"
+			imports["time.Time"] = `"time"`
 		case "uuid.UUID":
-			imports["uuid.UUID"] = "github.com/google/uuid"
+			imports["uuid.UUID"] = `"github.com/google/uuid"`
+		}
+
+		if column.GoModelImport != "" {
+			imports[columnType] = column.GoModelImport
 		}
 	}
 
@@ -59,6 +74,8 @@ func (t TableMetaData) GetImports() []string {
 		ret = append(ret, packageImport)
 	}
 
+	sort.Strings(ret)
+
 	return ret
 }
 
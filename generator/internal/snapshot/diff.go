@@ -0,0 +1,188 @@
+package snapshot
+
+import (
+	"fmt"
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"github.com/go-jet/jet/internal/utils"
+	"sort"
+	"strings"
+)
+
+// Diff compares schema meta data with files generated at schema dirPath, and returns human readable list of tables,
+// views, materialized views, columns and enum values added (+), removed (-) or changed (~) in schema meta data since files were generated.
+// Empty list is returned if generated files are up to date.
+func Diff(schemaMetaData metadata.SchemaMetaData, dirPath string) ([]string, error) {
+	generated, err := readGeneratedSchema(dirPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	expected := &generatedSchema{
+		tables: expectedTables(schemaMetaData.TablesMetaData),
+		views:  expectedTables(schemaMetaData.ViewsMetaData),
+		enums:  map[string][]string{},
+
+		materializedViews: expectedTables(schemaMetaData.MaterializedViewsMetaData),
+	}
+
+	for _, metaData := range schemaMetaData.EnumsMetaData {
+		enumMetaData := metaData.(metadata.EnumMetaData)
+		expected.enums[utils.ToGoIdentifier(enumMetaData.EnumName)] = enumMetaData.Values
+	}
+
+	prefix := schemaMetaData.SchemaName + "."
+
+	var diff []string
+	diff = append(diff, diffTables("table", prefix, expected.tables, generated.tables)...)
+	diff = append(diff, diffTables("view", prefix, expected.views, generated.views)...)
+	diff = append(diff, diffTables("materialized view", prefix, expected.materializedViews, generated.materializedViews)...)
+	diff = append(diff, diffEnums(prefix, expected.enums, generated.enums)...)
+
+	return diff, nil
+}
+
+func expectedTables(tablesMetaData []metadata.MetaData) map[string]*generatedTable {
+	tables := map[string]*generatedTable{}
+
+	for _, metaData := range tablesMetaData {
+		tableMetaData := metaData.(metadata.TableMetaData)
+		table := &generatedTable{name: tableMetaData.Name()}
+
+		for _, column := range tableMetaData.Columns {
+			table.columns = append(table.columns, generatedColumn{
+				name:         column.Name,
+				builderType:  column.SqlBuilderColumnType,
+				modelType:    column.GoModelType,
+				isPrimaryKey: tableMetaData.IsPrimaryKey(column.Name),
+			})
+		}
+
+		tables[table.name] = table
+	}
+
+	return tables
+}
+
+func diffTables(kind, prefix string, expected, generated map[string]*generatedTable) []string {
+	var diff []string
+
+	for _, name := range sortedKeys(expected, generated) {
+		expectedTable, generatedTable := expected[name], generated[name]
+
+		switch {
+		case generatedTable == nil:
+			diff = append(diff, fmt.Sprintf("+ %s %s%s", kind, prefix, name))
+		case expectedTable == nil:
+			diff = append(diff, fmt.Sprintf("- %s %s%s", kind, prefix, name))
+		default:
+			if columnsDiff := diffColumns(expectedTable, generatedTable); len(columnsDiff) > 0 {
+				diff = append(diff, fmt.Sprintf("~ %s %s%s", kind, prefix, name))
+				diff = append(diff, columnsDiff...)
+			}
+		}
+	}
+
+	return diff
+}
+
+func diffColumns(expected, generated *generatedTable) []string {
+	var diff []string
+
+	for _, column := range expected.columns {
+		generatedColumn := generated.column(column.name)
+
+		switch {
+		case generatedColumn == nil:
+			diff = append(diff, fmt.Sprintf("    + column %s %s", column.name, column))
+		case *generatedColumn != column:
+			diff = append(diff, fmt.Sprintf("    ~ column %s %s -> %s", column.name, *generatedColumn, column))
+		}
+	}
+
+	for _, column := range generated.columns {
+		if expected.column(column.name) == nil {
+			diff = append(diff, fmt.Sprintf("    - column %s %s", column.name, column))
+		}
+	}
+
+	return diff
+}
+
+func (g generatedColumn) String() string {
+	ret := g.builderType + " " + g.modelType
+
+	if g.isPrimaryKey {
+		ret += " primary_key"
+	}
+
+	return "(" + ret + ")"
+}
+
+func diffEnums(prefix string, expected, generated map[string][]string) []string {
+	var diff []string
+
+	for _, name := range sortedKeys(expected, generated) {
+		expectedValues, expectedOk := expected[name]
+		generatedValues, generatedOk := generated[name]
+
+		switch {
+		case !generatedOk:
+			diff = append(diff, fmt.Sprintf("+ enum %s%s", prefix, name))
+		case !expectedOk:
+			diff = append(diff, fmt.Sprintf("- enum %s%s", prefix, name))
+		default:
+			var valuesDiff []string
+
+			for _, value := range expectedValues {
+				if !utils.StringSliceContains(generatedValues, value) {
+					valuesDiff = append(valuesDiff, "    + value "+value)
+				}
+			}
+
+			for _, value := range generatedValues {
+				if !utils.StringSliceContains(expectedValues, value) {
+					valuesDiff = append(valuesDiff, "    - value "+value)
+				}
+			}
+
+			if len(valuesDiff) == 0 && strings.Join(expectedValues, ",") != strings.Join(generatedValues, ",") {
+				valuesDiff = append(valuesDiff, "    ~ values order "+strings.Join(generatedValues, ", ")+" -> "+strings.Join(expectedValues, ", "))
+			}
+
+			if len(valuesDiff) > 0 {
+				diff = append(diff, fmt.Sprintf("~ enum %s%s", prefix, name))
+				diff = append(diff, valuesDiff...)
+			}
+		}
+	}
+
+	return diff
+}
+
+// sortedKeys returns sorted union of keys of two maps
+func sortedKeys(maps ...interface{}) []string {
+	keys := map[string]bool{}
+
+	for _, m := range maps {
+		switch m := m.(type) {
+		case map[string]*generatedTable:
+			for key := range m {
+				keys[key] = true
+			}
+		case map[string][]string:
+			for key := range m {
+				keys[key] = true
+			}
+		}
+	}
+
+	ret := []string{}
+	for key := range keys {
+		ret = append(ret, key)
+	}
+
+	sort.Strings(ret)
+
+	return ret
+}
@@ -0,0 +1,259 @@
+package snapshot
+
+import (
+	"github.com/go-jet/jet/internal/utils"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// generatedSchema is a set of tables, views, materialized views and enums as seen by generated Go files
+type generatedSchema struct {
+	tables            map[string]*generatedTable
+	views             map[string]*generatedTable
+	materializedViews map[string]*generatedTable
+	// enum values keyed by enum Go identifier
+	enums map[string][]string
+}
+
+type generatedTable struct {
+	name         string
+	materialized bool
+	columns      []generatedColumn
+}
+
+func (g *generatedTable) column(name string) *generatedColumn {
+	for i := range g.columns {
+		if g.columns[i].name == name {
+			return &g.columns[i]
+		}
+	}
+
+	return nil
+}
+
+type generatedColumn struct {
+	name         string
+	builderType  string
+	modelType    string
+	isPrimaryKey bool
+}
+
+type modelField struct {
+	goType string
+	tag    reflect.StructTag
+}
+
+// readGeneratedSchema reads tables, views and enums from Go files generated in schema dirPath.
+func readGeneratedSchema(dirPath string) (*generatedSchema, error) {
+	schema := &generatedSchema{
+		tables: map[string]*generatedTable{},
+		views:  map[string]*generatedTable{},
+		enums:  map[string][]string{},
+
+		materializedViews: map[string]*generatedTable{},
+	}
+
+	models, err := readModels(filepath.Join(dirPath, "model"))
+	if err != nil {
+		return nil, err
+	}
+
+	for folder, tables := range map[string]map[string]*generatedTable{"table": schema.tables, "view": schema.views} {
+		err := forEachGoFile(filepath.Join(dirPath, folder), func(file *ast.File) {
+			table := readTable(file, models)
+
+			switch {
+			case table == nil:
+			case table.materialized:
+				schema.materializedViews[table.name] = table
+			default:
+				tables[table.name] = table
+			}
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = forEachGoFile(filepath.Join(dirPath, "enum"), func(file *ast.File) {
+		readEnum(file, schema.enums)
+	})
+
+	return schema, err
+}
+
+// readTable reads table name and columns from table or materialized view sql builder file. Columns are read from column constructor
+// calls, for instance: ActorIDColumn = postgres.IntegerColumn("actor_id")
+func readTable(file *ast.File, models map[string]map[string]modelField) *generatedTable {
+	var table *generatedTable
+	var columns []generatedColumn
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		function, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		switch {
+		case function.Sel.Name == "NewTable" && len(call.Args) >= 2:
+			table = &generatedTable{name: stringLiteral(call.Args[1])}
+		case function.Sel.Name == "NewMaterializedView" && len(call.Args) >= 2:
+			table = &generatedTable{name: stringLiteral(call.Args[1]), materialized: true}
+		case strings.HasSuffix(function.Sel.Name, "Column") && len(call.Args) == 1 && stringLiteral(call.Args[0]) != "":
+			columns = append(columns, generatedColumn{
+				name:        stringLiteral(call.Args[0]),
+				builderType: strings.TrimSuffix(function.Sel.Name, "Column"),
+			})
+		}
+
+		return true
+	})
+
+	if table == nil {
+		return nil
+	}
+
+	model := models[utils.ToGoIdentifier(table.name)]
+
+	for _, column := range columns {
+		if field, ok := model[utils.ToGoIdentifier(column.name)]; ok {
+			column.modelType = field.goType
+			column.isPrimaryKey = field.tag.Get("sql") == "primary_key"
+		}
+
+		table.columns = append(table.columns, column)
+	}
+
+	return table
+}
+
+// readEnum reads enum values from enum sql builder file, for instance: G: postgres.NewEnumValue("G")
+func readEnum(file *ast.File, enums map[string][]string) {
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+
+			if len(valueSpec.Names) != 1 || len(valueSpec.Values) != 1 {
+				continue
+			}
+
+			values := []string{}
+
+			ast.Inspect(valueSpec.Values[0], func(node ast.Node) bool {
+				if call, ok := node.(*ast.CallExpr); ok {
+					if function, ok := call.Fun.(*ast.SelectorExpr); ok && function.Sel.Name == "NewEnumValue" && len(call.Args) == 1 {
+						values = append(values, stringLiteral(call.Args[0]))
+					}
+				}
+				return true
+			})
+
+			enums[valueSpec.Names[0].Name] = values
+		}
+	}
+}
+
+// readModels reads model struct fields, keyed by struct name and field name.
+func readModels(dirPath string) (map[string]map[string]modelField, error) {
+	models := map[string]map[string]modelField{}
+
+	err := forEachGoFile(dirPath, func(file *ast.File) {
+		ast.Inspect(file, func(node ast.Node) bool {
+			typeSpec, ok := node.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				return false
+			}
+
+			fields := map[string]modelField{}
+
+			for _, field := range structType.Fields.List {
+				var tag reflect.StructTag
+				if field.Tag != nil {
+					tagValue, _ := strconv.Unquote(field.Tag.Value)
+					tag = reflect.StructTag(tagValue)
+				}
+
+				for _, name := range field.Names {
+					fields[name.Name] = modelField{goType: types.ExprString(field.Type), tag: tag}
+				}
+			}
+
+			models[typeSpec.Name.Name] = fields
+
+			return false
+		})
+	})
+
+	return models, err
+}
+
+// forEachGoFile parses each go file in dirPath and calls fn with parsed file. Non existing dirPath is treated as empty.
+func forEachGoFile(dirPath string, fn func(file *ast.File)) error {
+	fileInfos, err := ioutil.ReadDir(dirPath)
+
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fileSet := token.NewFileSet()
+
+	for _, fileInfo := range fileInfos {
+		if fileInfo.IsDir() || filepath.Ext(fileInfo.Name()) != ".go" {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, filepath.Join(dirPath, fileInfo.Name()), nil, 0)
+
+		if err != nil {
+			return err
+		}
+
+		fn(file)
+	}
+
+	return nil
+}
+
+func stringLiteral(expr ast.Expr) string {
+	literal, ok := expr.(*ast.BasicLit)
+
+	if !ok || literal.Kind != token.STRING {
+		return ""
+	}
+
+	value, err := strconv.Unquote(literal.Value)
+
+	if err != nil {
+		return ""
+	}
+
+	return value
+}
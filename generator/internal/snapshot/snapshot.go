@@ -0,0 +1,192 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"io/ioutil"
+	"sort"
+)
+
+// Snapshot is JSON serializable database schemas meta data
+type Snapshot struct {
+	Dialect  string   `json:"dialect"`
+	Database string   `json:"database"`
+	Schemas  []Schema `json:"schemas"`
+}
+
+// Schema is snapshot of schema tables, views, materialized views and enums
+type Schema struct {
+	Name              string  `json:"name"`
+	Tables            []Table `json:"tables"`
+	Views             []Table `json:"views"`
+	MaterializedViews []Table `json:"materialized_views,omitempty"`
+	Enums             []Enum  `json:"enums"`
+}
+
+// Table is snapshot of table, view or materialized view
+type Table struct {
+	Name    string   `json:"name"`
+	Columns []Column `json:"columns"`
+}
+
+// Column is snapshot of table or view column
+type Column struct {
+	Name         string `json:"name"`
+	DataType     string `json:"data_type"`
+	EnumName     string `json:"enum_name,omitempty"`
+	EnumSchema   string `json:"enum_schema,omitempty"`
+	IsNullable   bool   `json:"is_nullable"`
+	IsUnsigned   bool   `json:"is_unsigned,omitempty"`
+	IsPrimaryKey bool   `json:"is_primary_key,omitempty"`
+}
+
+// Enum is snapshot of enum type
+type Enum struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// New creates new snapshot from schemas meta data. Tables, views, materialized views and enums are sorted by name.
+func New(dialect, database string, schemasMetaData []metadata.SchemaMetaData) Snapshot {
+	snapshot := Snapshot{
+		Dialect:  dialect,
+		Database: database,
+		Schemas:  []Schema{},
+	}
+
+	for _, schemaMetaData := range schemasMetaData {
+		schema := Schema{
+			Name:   schemaMetaData.SchemaName,
+			Tables: newTables(schemaMetaData.TablesMetaData),
+			Views:  newTables(schemaMetaData.ViewsMetaData),
+			Enums:  []Enum{},
+		}
+
+		if len(schemaMetaData.MaterializedViewsMetaData) > 0 {
+			schema.MaterializedViews = newTables(schemaMetaData.MaterializedViewsMetaData)
+		}
+
+		for _, metaData := range schemaMetaData.EnumsMetaData {
+			enumMetaData := metaData.(metadata.EnumMetaData)
+			schema.Enums = append(schema.Enums, Enum{Name: enumMetaData.EnumName, Values: enumMetaData.Values})
+		}
+
+		sort.Slice(schema.Enums, func(i, j int) bool {
+			return schema.Enums[i].Name < schema.Enums[j].Name
+		})
+
+		snapshot.Schemas = append(snapshot.Schemas, schema)
+	}
+
+	sort.Slice(snapshot.Schemas, func(i, j int) bool {
+		return snapshot.Schemas[i].Name < snapshot.Schemas[j].Name
+	})
+
+	return snapshot
+}
+
+func newTables(tablesMetaData []metadata.MetaData) []Table {
+	tables := []Table{}
+
+	for _, metaData := range tablesMetaData {
+		tableMetaData := metaData.(metadata.TableMetaData)
+		table := Table{Name: tableMetaData.Name(), Columns: []Column{}}
+
+		for _, column := range tableMetaData.Columns {
+			table.Columns = append(table.Columns, Column{
+				Name:         column.Name,
+				DataType:     column.DataType,
+				EnumName:     column.EnumName,
+				EnumSchema:   column.EnumSchema,
+				IsNullable:   column.IsNullable,
+				IsUnsigned:   column.IsUnsigned,
+				IsPrimaryKey: tableMetaData.IsPrimaryKey(column.Name),
+			})
+		}
+
+		tables = append(tables, table)
+	}
+
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].Name < tables[j].Name
+	})
+
+	return tables
+}
+
+// SchemasMetaData converts snapshot back to schemas meta data.
+func (s Snapshot) SchemasMetaData() []metadata.SchemaMetaData {
+	ret := []metadata.SchemaMetaData{}
+
+	for _, schema := range s.Schemas {
+		schemaMetaData := metadata.SchemaMetaData{
+			SchemaName:     schema.Name,
+			TablesMetaData: tablesMetaData(schema.Name, schema.Tables),
+			ViewsMetaData:  tablesMetaData(schema.Name, schema.Views),
+			EnumsMetaData:  []metadata.MetaData{},
+
+			MaterializedViewsMetaData: tablesMetaData(schema.Name, schema.MaterializedViews),
+		}
+
+		for _, enum := range schema.Enums {
+			schemaMetaData.EnumsMetaData = append(schemaMetaData.EnumsMetaData, metadata.EnumMetaData{
+				EnumName: enum.Name,
+				Values:   enum.Values,
+			})
+		}
+
+		ret = append(ret, schemaMetaData)
+	}
+
+	return ret
+}
+
+func tablesMetaData(schemaName string, tables []Table) []metadata.MetaData {
+	ret := []metadata.MetaData{}
+
+	for _, table := range tables {
+		primaryKeys := map[string]bool{}
+		columns := []metadata.ColumnMetaData{}
+
+		for _, column := range table.Columns {
+			columnMetaData := metadata.NewColumnMetaData(column.Name, column.IsNullable, column.DataType, column.EnumName, column.IsUnsigned)
+			columnMetaData.EnumSchema = column.EnumSchema
+
+			columns = append(columns, columnMetaData)
+
+			if column.IsPrimaryKey {
+				primaryKeys[column.Name] = true
+			}
+		}
+
+		ret = append(ret, metadata.NewTableMetaData(schemaName, table.Name, primaryKeys, columns))
+	}
+
+	return ret
+}
+
+// Save saves snapshot as indented JSON file at filePath.
+func Save(filePath string, snapshot Snapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filePath, append(data, '\n'), 0644)
+}
+
+// Load loads snapshot from JSON file at filePath.
+func Load(filePath string) (Snapshot, error) {
+	snapshot := Snapshot{}
+
+	data, err := ioutil.ReadFile(filePath)
+
+	if err != nil {
+		return snapshot, err
+	}
+
+	err = json.Unmarshal(data, &snapshot)
+
+	return snapshot, err
+}
@@ -0,0 +1,119 @@
+package snapshot
+
+import (
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"github.com/go-jet/jet/generator/internal/template"
+	"github.com/go-jet/jet/postgres"
+	"gotest.tools/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var dvdsSnapshot = Snapshot{
+	Dialect:  "PostgreSQL",
+	Database: "jetdb",
+	Schemas: []Schema{
+		{
+			Name: "dvds",
+			Tables: []Table{
+				{
+					Name: "actor",
+					Columns: []Column{
+						{Name: "actor_id", DataType: "integer", IsPrimaryKey: true},
+						{Name: "first_name", DataType: "character varying"},
+						{Name: "last_update", DataType: "timestamp without time zone", IsNullable: true},
+					},
+				},
+				{
+					Name: "film",
+					Columns: []Column{
+						{Name: "film_id", DataType: "integer", IsPrimaryKey: true},
+						{Name: "rating", DataType: "USER-DEFINED", EnumName: "mpaa_rating", EnumSchema: "dvds", IsNullable: true},
+					},
+				},
+			},
+			Views: []Table{
+				{
+					Name: "actor_info",
+					Columns: []Column{
+						{Name: "actor_id", DataType: "integer", IsNullable: true},
+					},
+				},
+			},
+			MaterializedViews: []Table{
+				{
+					Name: "sales_by_store",
+					Columns: []Column{
+						{Name: "store", DataType: "text", IsNullable: true},
+					},
+				},
+			},
+			Enums: []Enum{
+				{Name: "mpaa_rating", Values: []string{"G", "PG", "PG-13"}},
+			},
+		},
+	},
+}
+
+func TestSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jet")
+	assert.NilError(t, err)
+	defer os.RemoveAll(dir)
+
+	snapshotPath := filepath.Join(dir, "snapshot.json")
+
+	err = Save(snapshotPath, New(dvdsSnapshot.Dialect, dvdsSnapshot.Database, dvdsSnapshot.SchemasMetaData()))
+	assert.NilError(t, err)
+
+	loaded, err := Load(snapshotPath)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, loaded, dvdsSnapshot)
+}
+
+func TestDiff(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jet")
+	assert.NilError(t, err)
+	defer os.RemoveAll(dir)
+
+	template.GenerateFiles(dir, dvdsSnapshot.SchemasMetaData()[0], postgres.Dialect)
+
+	diff, err := Diff(dvdsSnapshot.SchemasMetaData()[0], dir)
+	assert.NilError(t, err)
+	assert.Equal(t, len(diff), 0)
+
+	changed := New("PostgreSQL", "jetdb", dvdsSnapshot.SchemasMetaData())
+	changed.Schemas[0].Tables[0].Columns = []Column{
+		{Name: "actor_id", DataType: "bigint", IsPrimaryKey: true},
+		{Name: "first_name", DataType: "character varying"},
+		{Name: "last_name", DataType: "character varying"},
+	}
+	changed.Schemas[0].Tables = append(changed.Schemas[0].Tables[:1], Table{Name: "language"})
+	changed.Schemas[0].Views = nil
+	changed.Schemas[0].MaterializedViews[0].Columns[0].DataType = "integer"
+	changed.Schemas[0].Enums[0].Values = []string{"G", "PG", "NC-17"}
+
+	diff, err = Diff(changed.SchemasMetaData()[0], dir)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, diff, []string{
+		"~ table dvds.actor",
+		"    ~ column actor_id (Integer int32 primary_key) -> (Integer int64 primary_key)",
+		"    + column last_name (String string)",
+		"    - column last_update (Timestamp *time.Time)",
+		"- table dvds.film",
+		"+ table dvds.language",
+		"- view dvds.actor_info",
+		"~ materialized view dvds.sales_by_store",
+		"    ~ column store (String *string) -> (Integer *int32)",
+		"~ enum dvds.MpaaRating",
+		"    + value NC-17",
+		"    - value PG-13",
+	})
+}
+
+func TestDiffEmptyDir(t *testing.T) {
+	diff, err := Diff(metadata.SchemaMetaData{SchemaName: "dvds"}, "./non_existing_dir")
+	assert.NilError(t, err)
+	assert.Equal(t, len(diff), 0)
+}
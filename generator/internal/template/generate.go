@@ -6,50 +6,62 @@ import (
 	"github.com/go-jet/jet/generator/internal/metadata"
 	"github.com/go-jet/jet/internal/jet"
 	"github.com/go-jet/jet/internal/utils"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"text/template"
-	"time"
 )
 
-// GenerateFiles generates Go files from tables and enums metadata
-func GenerateFiles(destDir string, schemaInfo metadata.SchemaMetaData, dialect jet.Dialect) {
-	if schemaInfo.IsEmpty() {
-		return
-	}
+const autoGenMarker = "// Code generated by go-jet DO NOT EDIT."
 
+// generatedFilePackages are destination dir sub folders (packages) of generated files
+var generatedFilePackages = []string{"table", "view", "enum", "function", "sequence", "model"}
+
+// GenerateFiles generates Go files from tables, views, enums, functions and sequences metadata. Only files with changed content are written,
+// and previously generated files of tables, views and enums no longer in schema are removed.
+func GenerateFiles(destDir string, schemaInfo metadata.SchemaMetaData, dialect jet.Dialect) {
 	fmt.Println("Destination directory:", destDir)
-	fmt.Println("Cleaning up destination directory...")
-	err := utils.CleanUpGeneratedFiles(destDir)
-	utils.PanicOnError(err)
 
-	generateSQLBuilderFiles(destDir, "table", tableSQLBuilderTemplate, schemaInfo.TablesMetaData, dialect)
-	generateSQLBuilderFiles(destDir, "view", tableSQLBuilderTemplate, schemaInfo.ViewsMetaData, dialect)
-	generateSQLBuilderFiles(destDir, "enum", enumSQLBuilderTemplate, schemaInfo.EnumsMetaData, dialect)
+	generatedFiles := map[string]bool{}
+
+	generateSQLBuilderFiles(destDir, "table", tableSQLBuilderTemplate, schemaInfo.TablesMetaData, dialect, generatedFiles)
+	generateSQLBuilderFiles(destDir, "view", tableSQLBuilderTemplate, schemaInfo.ViewsMetaData, dialect, generatedFiles)
+	generateSQLBuilderFiles(destDir, "view", materializedViewSQLBuilderTemplate, schemaInfo.MaterializedViewsMetaData, dialect, generatedFiles)
+	generateSQLBuilderFiles(destDir, "enum", enumSQLBuilderTemplate, schemaInfo.EnumsMetaData, dialect, generatedFiles)
+	generateSQLBuilderFiles(destDir, "function", functionSQLBuilderTemplate, schemaInfo.FunctionsMetaData, dialect, generatedFiles)
+	generateSQLBuilderFiles(destDir, "sequence", sequenceSQLBuilderTemplate, schemaInfo.SequencesMetaData, dialect, generatedFiles)
+
+	generateModelFiles(destDir, "table", tableModelTemplate, schemaInfo.TablesMetaData, dialect, generatedFiles)
+	generateModelFiles(destDir, "view", tableModelTemplate, schemaInfo.ViewsMetaData, dialect, generatedFiles)
+	generateModelFiles(destDir, "view", tableModelTemplate, schemaInfo.MaterializedViewsMetaData, dialect, generatedFiles)
+	generateModelFiles(destDir, "enum", enumModelTemplate, schemaInfo.EnumsMetaData, dialect, generatedFiles)
+	generateModelFiles(destDir, "function", tableModelTemplate, functionModelsMetaData(schemaInfo), dialect, generatedFiles)
 
-	generateModelFiles(destDir, "table", tableModelTemplate, schemaInfo.TablesMetaData, dialect)
-	generateModelFiles(destDir, "view", tableModelTemplate, schemaInfo.ViewsMetaData, dialect)
-	generateModelFiles(destDir, "enum", enumModelTemplate, schemaInfo.EnumsMetaData, dialect)
+	removeStaleFiles(destDir, generatedFiles)
 
 	fmt.Println("Done")
 }
 
-func generateSQLBuilderFiles(destDir, fileTypes, sqlBuilderTemplate string, metaData []metadata.MetaData, dialect jet.Dialect) {
+func generateSQLBuilderFiles(destDir, fileTypes, sqlBuilderTemplate string, metaData []metadata.MetaData,
+	dialect jet.Dialect, generatedFiles map[string]bool) {
 	if len(metaData) == 0 {
 		return
 	}
 	fmt.Printf("Generating %s sql builder files...\n", fileTypes)
-	generateGoFiles(destDir, fileTypes, sqlBuilderTemplate, metaData, dialect)
+	generateGoFiles(destDir, fileTypes, sqlBuilderTemplate, metaData, dialect, generatedFiles)
 }
 
-func generateModelFiles(destDir, fileTypes, modelTemplate string, metaData []metadata.MetaData, dialect jet.Dialect) {
+func generateModelFiles(destDir, fileTypes, modelTemplate string, metaData []metadata.MetaData,
+	dialect jet.Dialect, generatedFiles map[string]bool) {
 	if len(metaData) == 0 {
 		return
 	}
 	fmt.Printf("Generating %s model files...\n", fileTypes)
-	generateGoFiles(destDir, "model", modelTemplate, metaData, dialect)
+	generateGoFiles(destDir, "model", modelTemplate, metaData, dialect, generatedFiles)
 }
 
-func generateGoFiles(dirPath, packageName string, template string, metaDataList []metadata.MetaData, dialect jet.Dialect) {
+func generateGoFiles(dirPath, packageName string, template string, metaDataList []metadata.MetaData,
+	dialect jet.Dialect, generatedFiles map[string]bool) {
 	modelDirPath := filepath.Join(dirPath, packageName)
 
 	err := utils.EnsureDirPath(modelDirPath)
@@ -62,21 +74,112 @@ func generateGoFiles(dirPath, packageName string, template string, metaDataList
 		text, err := GenerateTemplate(template, metaData, dialect, map[string]interface{}{"package": packageName})
 		utils.PanicOnError(err)
 
-		err = utils.SaveGoFile(modelDirPath, utils.ToGoFileName(metaData.Name()), append(autoGenWarning, text...))
+		fileName := utils.ToGoFileName(goFileName(packageName, metaData))
+
+		err = utils.SaveGoFile(modelDirPath, fileName, append(autoGenWarning, text...))
 		utils.PanicOnError(err)
+
+		generatedFiles[filepath.Join(modelDirPath, fileName+".go")] = true
 	}
 
 	return
 }
 
+// goFileName returns generated file name of metaData. Sql builder files of overloaded functions are named by function
+// specific name, and function model files are prefixed with "function_", so they don't overwrite table model files.
+func goFileName(packageName string, metaData metadata.MetaData) string {
+	function, ok := metaData.(metadata.FunctionMetaData)
+
+	switch {
+	case !ok:
+		return metaData.Name()
+	case packageName == "model":
+		return "function_" + function.Name()
+	default:
+		return function.UniqueName()
+	}
+}
+
+// functionModelsMetaData returns functions meta data for model files generation. Model type of function is named
+// as function (without specific name), so query result can be mapped by function column aliases. Functions with
+// the same model type name as table, view, enum or other function overload model are skipped.
+func functionModelsMetaData(schemaInfo metadata.SchemaMetaData) []metadata.MetaData {
+	modelNames := map[string]string{}
+
+	for _, metaDataList := range [][]metadata.MetaData{schemaInfo.TablesMetaData, schemaInfo.ViewsMetaData,
+		schemaInfo.MaterializedViewsMetaData, schemaInfo.EnumsMetaData} {
+		for _, metaData := range metaDataList {
+			modelNames[utils.ToGoIdentifier(metaData.Name())] = metaData.Name()
+		}
+	}
+
+	ret := []metadata.MetaData{}
+
+	for _, metaData := range schemaInfo.FunctionsMetaData {
+		modelName := utils.ToGoIdentifier(metaData.Name())
+
+		if name, ok := modelNames[modelName]; ok {
+			fmt.Println("- [Model      ] Function '" + metaData.Name() + "' model skipped, model type '" + modelName +
+				"' already generated for '" + name + "'.")
+			continue
+		}
+
+		modelNames[modelName] = metaData.Name()
+		ret = append(ret, metaData)
+	}
+
+	return ret
+}
+
+// removeStaleFiles removes go-jet generated files from destination dir packages, not generated in this run.
+// Package folders left empty are removed as well.
+func removeStaleFiles(destDir string, generatedFiles map[string]bool) {
+	for _, packageName := range generatedFilePackages {
+		packageDirPath := filepath.Join(destDir, packageName)
+
+		fileInfos, err := ioutil.ReadDir(packageDirPath)
+
+		if os.IsNotExist(err) {
+			continue
+		}
+		utils.PanicOnError(err)
+
+		remaining := len(fileInfos)
+
+		for _, fileInfo := range fileInfos {
+			filePath := filepath.Join(packageDirPath, fileInfo.Name())
+
+			if fileInfo.IsDir() || generatedFiles[filePath] || !isGeneratedFile(filePath) {
+				continue
+			}
+
+			fmt.Println("Removing stale file", filePath)
+			err := os.Remove(filePath)
+			utils.PanicOnError(err)
+
+			remaining--
+		}
+
+		if remaining == 0 {
+			err := os.Remove(packageDirPath)
+			utils.PanicOnError(err)
+		}
+	}
+}
+
+// isGeneratedFile returns true if file at filePath contains go-jet auto generated warning
+func isGeneratedFile(filePath string) bool {
+	content, err := ioutil.ReadFile(filePath)
+	utils.PanicOnError(err)
+
+	return bytes.Contains(content, []byte(autoGenMarker))
+}
+
 // GenerateTemplate generates template with template text and template data.
 func GenerateTemplate(templateText string, templateData interface{}, dialect jet.Dialect, params ...map[string]interface{}) ([]byte, error) {
 
 	t, err := template.New("sqlBuilderTableTemplate").Funcs(template.FuncMap{
 		"ToGoIdentifier": utils.ToGoIdentifier,
-		"now": func() string {
-			return time.Now().Format(time.RFC850)
-		},
 		"dialect": func() jet.Dialect {
 			return dialect
 		},
@@ -0,0 +1,288 @@
+package template
+
+import (
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"github.com/go-jet/jet/postgres"
+	"gotest.tools/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateFilesIncremental(t *testing.T) {
+	destDir, err := ioutil.TempDir("", "jet")
+	assert.NilError(t, err)
+	defer os.RemoveAll(destDir)
+
+	actor := metadata.NewTableMetaData("dvds", "actor", map[string]bool{"actor_id": true}, []metadata.ColumnMetaData{
+		metadata.NewColumnMetaData("actor_id", false, "integer", "", false),
+	})
+	film := metadata.NewTableMetaData("dvds", "film", map[string]bool{}, []metadata.ColumnMetaData{
+		metadata.NewColumnMetaData("title", false, "text", "", false),
+	})
+	rating := metadata.EnumMetaData{EnumName: "mpaa_rating", Values: []string{"G", "PG"}}
+
+	GenerateFiles(destDir, metadata.SchemaMetaData{
+		TablesMetaData: []metadata.MetaData{actor, film},
+		EnumsMetaData:  []metadata.MetaData{rating},
+	}, postgres.Dialect)
+
+	assertDirFiles(t, filepath.Join(destDir, "table"), "actor.go", "film.go")
+	assertDirFiles(t, filepath.Join(destDir, "enum"), "mpaa_rating.go")
+	assertDirFiles(t, filepath.Join(destDir, "model"), "actor.go", "film.go", "mpaa_rating.go")
+
+	userFile := filepath.Join(destDir, "model", "film_ext.go")
+	err = ioutil.WriteFile(userFile, []byte("package model\n"), 0644)
+	assert.NilError(t, err)
+
+	unchangedTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	actorFile := filepath.Join(destDir, "table", "actor.go")
+	err = os.Chtimes(actorFile, unchangedTime, unchangedTime)
+	assert.NilError(t, err)
+
+	GenerateFiles(destDir, metadata.SchemaMetaData{
+		TablesMetaData: []metadata.MetaData{actor},
+	}, postgres.Dialect)
+
+	assertDirFiles(t, filepath.Join(destDir, "table"), "actor.go")
+	assertDirFiles(t, filepath.Join(destDir, "model"), "actor.go", "film_ext.go")
+
+	_, err = os.Stat(filepath.Join(destDir, "enum"))
+	assert.Assert(t, os.IsNotExist(err))
+
+	actorFileInfo, err := os.Stat(actorFile)
+	assert.NilError(t, err)
+	assert.Equal(t, actorFileInfo.ModTime(), unchangedTime)
+}
+
+func assertDirFiles(t *testing.T, dirPath string, fileNames ...string) {
+	fileInfos, err := ioutil.ReadDir(dirPath)
+	assert.NilError(t, err)
+
+	var actual []string
+	for _, fileInfo := range fileInfos {
+		actual = append(actual, fileInfo.Name())
+	}
+
+	assert.DeepEqual(t, actual, fileNames)
+}
+
+func TestGenerateFilesPostgresObjects(t *testing.T) {
+	destDir, err := ioutil.TempDir("", "jet")
+	assert.NilError(t, err)
+	defer os.RemoveAll(destDir)
+
+	salesByStore := metadata.NewTableMetaData("dvds", "sales_by_store", map[string]bool{}, []metadata.ColumnMetaData{
+		metadata.NewColumnMetaData("store", true, "text", "", false),
+		metadata.NewColumnMetaData("total_sales", true, "numeric", "", false),
+	})
+	filmInStock := metadata.NewFunctionMetaData("dvds", "film_in_stock",
+		[]metadata.ColumnMetaData{
+			metadata.NewColumnMetaData("p_film_id", false, "integer", "", false),
+			metadata.NewColumnMetaData("type", false, "text", "", false),
+			metadata.NewColumnMetaData("", false, "integer", "", false),
+		},
+		[]metadata.ColumnMetaData{
+			metadata.NewColumnMetaData("p_film_count", true, "integer", "", false),
+		})
+	actorSeq := metadata.SequenceMetaData{SchemaName: "dvds", SequenceName: "actor_actor_id_seq"}
+
+	GenerateFiles(destDir, metadata.SchemaMetaData{
+		MaterializedViewsMetaData: []metadata.MetaData{salesByStore},
+		FunctionsMetaData:         []metadata.MetaData{filmInStock},
+		SequencesMetaData:         []metadata.MetaData{actorSeq},
+	}, postgres.Dialect)
+
+	assertDirFiles(t, filepath.Join(destDir, "view"), "sales_by_store.go")
+	assertDirFiles(t, filepath.Join(destDir, "function"), "film_in_stock.go")
+	assertDirFiles(t, filepath.Join(destDir, "sequence"), "actor_actor_id_seq.go")
+	assertDirFiles(t, filepath.Join(destDir, "model"), "function_film_in_stock.go", "sales_by_store.go")
+
+	assertFileContains(t, filepath.Join(destDir, "view", "sales_by_store.go"),
+		`MaterializedView: postgres.NewMaterializedView("dvds", "sales_by_store", StoreColumn, TotalSalesColumn),`)
+	assertFileContains(t, filepath.Join(destDir, "function", "film_in_stock.go"),
+		`func FilmInStock(pFilmID postgres.IntegerExpression, type_ postgres.StringExpression, arg3 postgres.IntegerExpression) *FilmInStockTable {`,
+		`[]postgres.Expression{pFilmID, type_, arg3},`)
+	assertFileContains(t, filepath.Join(destDir, "sequence", "actor_actor_id_seq.go"),
+		`var ActorActorIDSeq = postgres.NewSequence("dvds", "actor_actor_id_seq")`)
+	assertFileContains(t, filepath.Join(destDir, "model", "function_film_in_stock.go"),
+		`PFilmCount *int32`)
+}
+
+func assertFileContains(t *testing.T, filePath string, texts ...string) {
+	content, err := ioutil.ReadFile(filePath)
+	assert.NilError(t, err)
+
+	for _, text := range texts {
+		assert.Assert(t, strings.Contains(string(content), text), "%s does not contain %s\n%s", filePath, text, content)
+	}
+}
+
+func TestGenerateFunctionWithEnumOfOtherSchema(t *testing.T) {
+	destDir, err := ioutil.TempDir("", "jet")
+	assert.NilError(t, err)
+	defer os.RemoveAll(destDir)
+
+	ratingColumn := metadata.NewColumnMetaData("rating", true, "USER-DEFINED", "mpaa_rating", false)
+	ratingColumn.EnumSchema = "common"
+	ratingParam := metadata.NewColumnMetaData("p_rating", false, "USER-DEFINED", "mpaa_rating", false)
+	ratingParam.EnumSchema = "common"
+
+	filmsByRating := metadata.NewFunctionMetaData("dvds", "films_by_rating",
+		[]metadata.ColumnMetaData{ratingParam},
+		[]metadata.ColumnMetaData{ratingColumn})
+
+	schemas := []metadata.SchemaMetaData{{
+		SchemaName:        "dvds",
+		FunctionsMetaData: []metadata.MetaData{filmsByRating},
+	}}
+	enumSchemas := []metadata.SchemaMetaData{{
+		SchemaName:    "common",
+		EnumsMetaData: []metadata.MetaData{metadata.EnumMetaData{EnumName: "mpaa_rating", Values: []string{"G", "PG"}}},
+	}}
+
+	assert.DeepEqual(t, schemas[0].ReferencedEnumSchemaNames(), []string{"common"})
+
+	metadata.LinkSchemasEnums(schemas, enumSchemas, func(schemaName string) string {
+		return "github.com/go-jet/jet/gen/jetdb/" + schemaName + "/model"
+	})
+
+	GenerateFiles(destDir, schemas[0], postgres.Dialect)
+
+	assertFileContains(t, filepath.Join(destDir, "model", "function_films_by_rating.go"),
+		`commonModel "github.com/go-jet/jet/gen/jetdb/common/model"`,
+		`Rating *commonModel.MpaaRating`)
+	assertFileContains(t, filepath.Join(destDir, "function", "films_by_rating.go"),
+		`func FilmsByRating(pRating postgres.StringExpression) *FilmsByRatingTable {`)
+}
+
+func TestGenerateSchemasWithMutualEnumReferences(t *testing.T) {
+	destDir, err := ioutil.TempDir("", "jet")
+	assert.NilError(t, err)
+	defer os.RemoveAll(destDir)
+
+	enumColumn := func(name, enumSchema, enumName string) metadata.ColumnMetaData {
+		column := metadata.NewColumnMetaData(name, false, "USER-DEFINED", enumName, false)
+		column.EnumSchema = enumSchema
+		return column
+	}
+
+	schemas := []metadata.SchemaMetaData{
+		{
+			SchemaName: "store",
+			TablesMetaData: []metadata.MetaData{metadata.NewTableMetaData("store", "item", map[string]bool{},
+				[]metadata.ColumnMetaData{enumColumn("status", "billing", "payment_status")})},
+			EnumsMetaData: []metadata.MetaData{metadata.EnumMetaData{EnumName: "item_kind", Values: []string{"BOOK"}}},
+		},
+		{
+			SchemaName: "billing",
+			TablesMetaData: []metadata.MetaData{metadata.NewTableMetaData("billing", "invoice", map[string]bool{},
+				[]metadata.ColumnMetaData{enumColumn("kind", "store", "item_kind")})},
+			EnumsMetaData: []metadata.MetaData{metadata.EnumMetaData{EnumName: "payment_status", Values: []string{"PAID"}}},
+		},
+		{
+			SchemaName: "report",
+			TablesMetaData: []metadata.MetaData{metadata.NewTableMetaData("report", "sales", map[string]bool{},
+				[]metadata.ColumnMetaData{enumColumn("kind", "store", "item_kind")})},
+		},
+	}
+
+	metadata.LinkSchemasEnums(schemas, nil, func(schemaName string) string {
+		return "github.com/go-jet/jet/gen/jetdb/" + schemaName + "/model"
+	})
+
+	for _, schema := range schemas {
+		GenerateFiles(filepath.Join(destDir, schema.SchemaName), schema, postgres.Dialect)
+	}
+
+	// store and billing model packages would import each other, so referenced enums are copied instead
+	assertDirFiles(t, filepath.Join(destDir, "store", "model"), "item.go", "item_kind.go", "payment_status.go")
+	assertDirFiles(t, filepath.Join(destDir, "billing", "model"), "invoice.go", "item_kind.go", "payment_status.go")
+	assertFileContains(t, filepath.Join(destDir, "store", "model", "item.go"), "Status PaymentStatus")
+	assertFileContains(t, filepath.Join(destDir, "billing", "model", "invoice.go"), "Kind ItemKind")
+	assertFileNotContains(t, filepath.Join(destDir, "store", "model", "item.go"), "billingModel")
+	assertFileNotContains(t, filepath.Join(destDir, "billing", "model", "invoice.go"), "storeModel")
+
+	// report model package is not imported by store model package, so enum is imported
+	assertFileContains(t, filepath.Join(destDir, "report", "model", "sales.go"),
+		`storeModel "github.com/go-jet/jet/gen/jetdb/store/model"`,
+		"Kind storeModel.ItemKind")
+}
+
+func assertFileNotContains(t *testing.T, filePath string, text string) {
+	content, err := ioutil.ReadFile(filePath)
+	assert.NilError(t, err)
+
+	assert.Assert(t, !strings.Contains(string(content), text), "%s contains %s\n%s", filePath, text, content)
+}
+
+func TestGenerateOverloadedFunctions(t *testing.T) {
+	destDir, err := ioutil.TempDir("", "jet")
+	assert.NilError(t, err)
+	defer os.RemoveAll(destDir)
+
+	countColumn := metadata.NewColumnMetaData("p_film_count", true, "integer", "", false)
+
+	filmInStock := metadata.NewFunctionMetaData("dvds", "film_in_stock",
+		[]metadata.ColumnMetaData{metadata.NewColumnMetaData("p_film_id", false, "integer", "", false)},
+		[]metadata.ColumnMetaData{countColumn})
+	filmInStock.SpecificName = "film_in_stock_16423"
+
+	filmInStockOfStore := metadata.NewFunctionMetaData("dvds", "film_in_stock",
+		[]metadata.ColumnMetaData{
+			metadata.NewColumnMetaData("p_film_id", false, "integer", "", false),
+			metadata.NewColumnMetaData("p_store_id", false, "integer", "", false),
+		},
+		[]metadata.ColumnMetaData{countColumn})
+	filmInStockOfStore.SpecificName = "film_in_stock_16424"
+
+	GenerateFiles(destDir, metadata.SchemaMetaData{
+		FunctionsMetaData: []metadata.MetaData{filmInStock, filmInStockOfStore},
+	}, postgres.Dialect)
+
+	assertDirFiles(t, filepath.Join(destDir, "function"), "film_in_stock_16423.go", "film_in_stock_16424.go")
+	assertDirFiles(t, filepath.Join(destDir, "model"), "function_film_in_stock.go")
+
+	assertFileContains(t, filepath.Join(destDir, "function", "film_in_stock_16423.go"),
+		`func FilmInStock16423(pFilmID postgres.IntegerExpression) *FilmInStock16423Table {`,
+		`postgres.NewTableFunction("dvds", "film_in_stock",`)
+	assertFileContains(t, filepath.Join(destDir, "function", "film_in_stock_16424.go"),
+		`func FilmInStock16424(pFilmID postgres.IntegerExpression, pStoreID postgres.IntegerExpression) *FilmInStock16424Table {`,
+		`postgres.NewTableFunction("dvds", "film_in_stock",`)
+	assertFileContains(t, filepath.Join(destDir, "model", "function_film_in_stock.go"),
+		`type FilmInStock struct {`)
+}
+
+func TestGenerateFunctionNamedAsTable(t *testing.T) {
+	destDir, err := ioutil.TempDir("", "jet")
+	assert.NilError(t, err)
+	defer os.RemoveAll(destDir)
+
+	filmColumns := []metadata.ColumnMetaData{
+		metadata.NewColumnMetaData("film_id", false, "integer", "", false),
+		metadata.NewColumnMetaData("title", false, "text", "", false),
+	}
+	filmTable := metadata.NewTableMetaData("dvds", "film", map[string]bool{"film_id": true}, filmColumns)
+	filmFunction := metadata.NewFunctionMetaData("dvds", "film",
+		[]metadata.ColumnMetaData{metadata.NewColumnMetaData("p_title", false, "text", "", false)},
+		[]metadata.ColumnMetaData{metadata.NewColumnMetaData("title", true, "text", "", false)})
+	actorFunction := metadata.NewFunctionMetaData("dvds", "actor", nil,
+		[]metadata.ColumnMetaData{metadata.NewColumnMetaData("first_name", true, "text", "", false)})
+
+	GenerateFiles(destDir, metadata.SchemaMetaData{
+		TablesMetaData:    []metadata.MetaData{filmTable},
+		FunctionsMetaData: []metadata.MetaData{filmFunction, actorFunction},
+	}, postgres.Dialect)
+
+	assertDirFiles(t, filepath.Join(destDir, "function"), "actor.go", "film.go")
+	assertDirFiles(t, filepath.Join(destDir, "model"), "film.go", "function_actor.go")
+
+	assertFileContains(t, filepath.Join(destDir, "model", "film.go"),
+		`FilmID int32 `+"`"+`sql:"primary_key"`+"`",
+		`Title  string`)
+	assertFileContains(t, filepath.Join(destDir, "model", "function_actor.go"),
+		`type Actor struct {`)
+}
@@ -3,7 +3,6 @@ package template
 var autoGenWarningTemplate = `
 //
 // Code generated by go-jet DO NOT EDIT.
-// Generated at {{now}}
 //
 // WARNING: Changes to this file may cause incorrect behavior  
 // and will be lost if the code is regenerated
@@ -69,12 +68,134 @@ func new{{.GoStructName}}() *{{.GoStructName}} {
 
 `
 
+var materializedViewSQLBuilderTemplate = ` 
+{{define "column-list" -}}
+	{{- range $i, $c := . }}
+		{{- if gt $i 0 }}, {{end}}{{ToGoIdentifier $c.Name}}Column
+	{{- end}}
+{{- end}}
+
+package {{param "package"}}
+
+import (
+	"github.com/go-jet/jet/{{dialect.PackageName}}"
+)
+
+var {{ToGoIdentifier .Name}} = new{{.GoStructName}}()
+
+type {{.GoStructName}} struct {
+	{{dialect.PackageName}}.MaterializedView
+	
+	//Columns
+{{- range .Columns}}
+	{{ToGoIdentifier .Name}} {{dialect.PackageName}}.Column{{.SqlBuilderColumnType}}
+{{- end}}
+
+	AllColumns {{dialect.PackageName}}.ColumnList
+}
+
+// creates new {{.GoStructName}} with assigned alias
+func (a *{{.GoStructName}}) AS(alias string) *{{.GoStructName}} {
+	aliasTable := new{{.GoStructName}}()
+
+	aliasTable.MaterializedView.AS(alias)
+
+	return aliasTable
+}
+
+func new{{.GoStructName}}() *{{.GoStructName}} {
+	var (
+	{{- range .Columns}}
+		{{ToGoIdentifier .Name}}Column = {{dialect.PackageName}}.{{.SqlBuilderColumnType}}Column("{{.Name}}")
+	{{- end}}
+	)
+
+	return &{{.GoStructName}}{
+		MaterializedView: {{dialect.PackageName}}.NewMaterializedView("{{.SchemaName}}", "{{.Name}}", {{template "column-list" .Columns}}),
+
+		//Columns
+{{- range .Columns}}
+		{{ToGoIdentifier .Name}}: {{ToGoIdentifier .Name}}Column,
+{{- end}}
+
+		AllColumns: {{dialect.PackageName}}.ColumnList{ {{template "column-list" .Columns}} },
+	}
+}
+
+`
+
+var functionSQLBuilderTemplate = ` 
+{{define "column-list" -}}
+	{{- range $i, $c := . }}
+		{{- if gt $i 0 }}, {{end}}{{ToGoIdentifier $c.Name}}Column
+	{{- end}}
+{{- end}}
+
+package {{param "package"}}
+
+import (
+	"github.com/go-jet/jet/{{dialect.PackageName}}"
+)
+
+type {{.GoStructName}} struct {
+	{{dialect.PackageName}}.TableFunction
+	
+	//Columns
+{{- range .Columns}}
+	{{ToGoIdentifier .Name}} {{dialect.PackageName}}.Column{{.SqlBuilderColumnType}}
+{{- end}}
+
+	AllColumns {{dialect.PackageName}}.ColumnList
+}
+
+// assigns alias to {{.GoStructName}}
+func (a *{{.GoStructName}}) AS(alias string) *{{.GoStructName}} {
+	a.TableFunction.AS(alias)
+
+	return a
+}
+
+// {{ToGoIdentifier .UniqueName}} creates new call of {{.SchemaName}}.{{.Name}} table function
+func {{ToGoIdentifier .UniqueName}}(
+{{- range $i, $p := .Params}}
+	{{- if gt $i 0 }}, {{end}}{{$.GoParamName $i}} {{dialect.PackageName}}.{{$p.SqlBuilderColumnType}}Expression
+{{- end -}}
+) *{{.GoStructName}} {
+	var (
+	{{- range .Columns}}
+		{{ToGoIdentifier .Name}}Column = {{dialect.PackageName}}.{{.SqlBuilderColumnType}}Column("{{.Name}}")
+	{{- end}}
+	)
+
+	return &{{.GoStructName}}{
+		TableFunction: {{dialect.PackageName}}.NewTableFunction("{{.SchemaName}}", "{{.Name}}", 
+			[]{{dialect.PackageName}}.Expression{ {{- range $i, $p := .Params}}{{if gt $i 0 }}, {{end}}{{$.GoParamName $i}}{{end -}} }, 
+			{{template "column-list" .Columns}}),
+
+		//Columns
+{{- range .Columns}}
+		{{ToGoIdentifier .Name}}: {{ToGoIdentifier .Name}}Column,
+{{- end}}
+
+		AllColumns: {{dialect.PackageName}}.ColumnList{ {{template "column-list" .Columns}} },
+	}
+}
+
+`
+
+var sequenceSQLBuilderTemplate = `package {{param "package"}}
+
+import "github.com/go-jet/jet/{{dialect.PackageName}}"
+
+var {{ToGoIdentifier .Name}} = {{dialect.PackageName}}.NewSequence("{{.SchemaName}}", "{{.Name}}")
+`
+
 var tableModelTemplate = `package model
 
 {{ if .GetImports }}
 import (
 {{- range .GetImports}}
-	"{{.}}"
+	{{.}}
 {{- end}}
 )
 {{end}}
@@ -0,0 +1,56 @@
+package mysql
+
+import (
+	"fmt"
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"github.com/go-jet/jet/generator/internal/snapshot"
+	"github.com/go-jet/jet/internal/utils"
+	"github.com/go-jet/jet/mysql"
+	"path"
+)
+
+// SaveSnapshot saves JSON snapshot of database tables, views and enums meta data to snapshot file path.
+func SaveSnapshot(snapshotFilePath string, dbConn DBConnection) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	db := openConnection(dbConn)
+	defer utils.DBClose(db)
+
+	fmt.Println("Retrieving database information...")
+	dbInfo := metadata.GetSchemaMetaData(db, dbConn.DBName, &mySqlQuerySet{})
+
+	fmt.Println("Saving snapshot to " + snapshotFilePath + "...")
+
+	return snapshot.Save(snapshotFilePath, snapshot.New(mysql.Dialect.Name(), dbConn.DBName, []metadata.SchemaMetaData{dbInfo}))
+}
+
+// Check compares database with files previously generated at destination dir, and returns human readable list of
+// tables, views, columns and enum values added (+), removed (-) or changed (~) in database since files were
+// generated. Empty list is returned if generated files are up to date.
+func Check(destDir string, dbConn DBConnection) (diff []string, err error) {
+	defer utils.ErrorCatch(&err)
+
+	db := openConnection(dbConn)
+	defer utils.DBClose(db)
+
+	fmt.Println("Retrieving database information...")
+	dbInfo := metadata.GetSchemaMetaData(db, dbConn.DBName, &mySqlQuerySet{})
+
+	return snapshot.Diff(dbInfo, path.Join(destDir, dbConn.DBName))
+}
+
+// CheckSnapshot compares database snapshot, saved with SaveSnapshot, with files previously generated at destination
+// dir. Returns the same list of differences as Check.
+func CheckSnapshot(destDir string, snapshotFilePath string) (diff []string, err error) {
+	dbSnapshot, err := snapshot.Load(snapshotFilePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if dbSnapshot.Dialect != mysql.Dialect.Name() || len(dbSnapshot.Schemas) != 1 {
+		return nil, fmt.Errorf("jet: snapshot %s is not %s snapshot", snapshotFilePath, mysql.Dialect.Name())
+	}
+
+	return snapshot.Diff(dbSnapshot.SchemasMetaData()[0], path.Join(destDir, dbSnapshot.Database))
+}
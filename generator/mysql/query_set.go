@@ -14,7 +14,8 @@ func (m *mySqlQuerySet) ListOfTablesQuery() string {
 	return `
 SELECT table_name
 FROM INFORMATION_SCHEMA.tables
-WHERE table_schema = ? and table_type = ?;
+WHERE table_schema = ? and table_type = ?
+ORDER BY table_name;
 `
 }
 
@@ -35,7 +36,8 @@ func (m *mySqlQuerySet) ListOfColumnsQuery() string {
 SELECT COLUMN_NAME, 
 	IS_NULLABLE, IF(COLUMN_TYPE = 'tinyint(1)', 'boolean', DATA_TYPE), 
 	IF(DATA_TYPE = 'enum',  CONCAT(TABLE_NAME, '_', COLUMN_NAME), ''), 
-	COLUMN_TYPE LIKE '%unsigned%'
+	COLUMN_TYPE LIKE '%unsigned%',
+	''
 FROM information_schema.columns 
 WHERE table_schema = ? and table_name = ?
 ORDER BY ordinal_position;
@@ -47,7 +49,8 @@ func (m *mySqlQuerySet) ListOfEnumsQuery() string {
 SELECT (CASE c.DATA_TYPE WHEN 'enum' then CONCAT(c.TABLE_NAME, '_', c.COLUMN_NAME) ELSE '' END ), SUBSTRING(c.COLUMN_TYPE,5)
 FROM information_schema.columns as c
 	INNER JOIN information_schema.tables  as t on (t.table_schema = c.table_schema AND t.table_name = c.table_name)
-WHERE c.table_schema = ? AND DATA_TYPE = 'enum';
+WHERE c.table_schema = ? AND DATA_TYPE = 'enum'
+ORDER BY c.TABLE_NAME, c.COLUMN_NAME;
 `
 }
 
@@ -79,3 +82,13 @@ func (m *mySqlQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metada
 	return ret
 
 }
+
+// MySQL functions can not return tables
+func (m *mySqlQuerySet) GetFunctionsMetaData(db *sql.DB, schemaName string) []metadata.MetaData {
+	return []metadata.MetaData{}
+}
+
+// MySQL does not support sequences
+func (m *mySqlQuerySet) GetSequencesMetaData(db *sql.DB, schemaName string) []metadata.MetaData {
+	return []metadata.MetaData{}
+}
@@ -3,12 +3,16 @@ package postgres
 import (
 	"database/sql"
 	"fmt"
+	"github.com/go-jet/jet/generator/internal/ddl"
 	"github.com/go-jet/jet/generator/internal/metadata"
 	"github.com/go-jet/jet/generator/internal/template"
+	"github.com/go-jet/jet/internal/jet"
 	"github.com/go-jet/jet/internal/utils"
 	"github.com/go-jet/jet/postgres"
+	"io/ioutil"
 	"path"
 	"strconv"
+	"strings"
 )
 
 // DBConnection contains postgres connection details
@@ -32,15 +36,201 @@ func Generate(destDir string, dbConn DBConnection) (err error) {
 	utils.PanicOnError(err)
 	defer utils.DBClose(db)
 
-	fmt.Println("Retrieving schema information...")
-	schemaInfo := metadata.GetSchemaMetaData(db, dbConn.SchemaName, &postgresQuerySet{})
+	generateSchemas(db, destDir, dbConn.DBName, []string{dbConn.SchemaName}, postgres.Dialect)
 
-	genPath := path.Join(destDir, dbConn.DBName, dbConn.SchemaName)
-	template.GenerateFiles(genPath, schemaInfo, postgres.Dialect)
+	return
+}
+
+// GenerateSchemas generates jet files at destination dir for each database schema matching any of schema patterns.
+// Schema pattern is either schema name or shell file name pattern (for instance 'sales_*'). Every schema is generated
+// into its own destDir/dbName/schemaName folder. Model types of enums referenced from other schemas are imported from
+// enum schema model package, if generated package import path can be resolved from go.mod file, otherwise enum
+// is generated in referencing schema as well. DBConnection SchemaName is ignored.
+func GenerateSchemas(destDir string, dbConn DBConnection, schemaPatterns ...string) error {
+	return GenerateDialectSchemas(destDir, dbConn, postgres.Dialect, schemaPatterns...)
+}
+
+// GenerateDialectSchemas is GenerateSchemas for PostgreSQL wire compatible databases. Generated files use dialect
+// package (for instance cockroachdb) instead of postgres package.
+func GenerateDialectSchemas(destDir string, dbConn DBConnection, dialect jet.Dialect, schemaPatterns ...string) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	db, err := openConnection(dbConn)
+	utils.PanicOnError(err)
+	defer utils.DBClose(db)
+
+	schemaNames := matchSchemaNames(db, schemaPatterns)
+
+	if len(schemaNames) == 0 {
+		return fmt.Errorf("jet: no schema matching %s found", strings.Join(schemaPatterns, ", "))
+	}
+
+	generateSchemas(db, destDir, dbConn.DBName, schemaNames, dialect)
+
+	return
+}
+
+// GenerateFromDDL generates jet files at destination dir from PostgreSQL DDL script file (for instance output of
+// 'pg_dump --schema-only'), without database connection. Files are generated for each schema matching any of schema
+// patterns into destDir/dbName/schemaName folder. Unqualified names in DDL script belong to 'public' schema.
+func GenerateFromDDL(destDir string, ddlFilePath string, dbName string, schemaPatterns ...string) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	script, err := ioutil.ReadFile(ddlFilePath)
+	utils.PanicOnError(err)
+
+	fmt.Println("Parsing DDL file " + ddlFilePath + "...")
+	parsedSchemas, err := ddl.Parse(string(script), "public")
+	utils.PanicOnError(err)
+
+	schemasInfo := []metadata.SchemaMetaData{}
+
+	for _, schemaInfo := range parsedSchemas {
+		matched, err := matchSchemaName(schemaInfo.SchemaName, schemaPatterns)
+		utils.PanicOnError(err)
+
+		if matched {
+			fmt.Println("	FOUND", len(schemaInfo.TablesMetaData), "table(s),", len(schemaInfo.ViewsMetaData), "view(s),",
+				len(schemaInfo.MaterializedViewsMetaData), "materialized view(s),", len(schemaInfo.EnumsMetaData), "enum(s),",
+				len(schemaInfo.SequencesMetaData), "sequence(s) in schema "+schemaInfo.SchemaName)
+			schemasInfo = append(schemasInfo, schemaInfo)
+		}
+	}
+
+	if len(schemasInfo) == 0 {
+		return fmt.Errorf("jet: no schema matching %s found", strings.Join(schemaPatterns, ", "))
+	}
+
+	generateSchemasFiles(destDir, dbName, schemasInfo, parsedSchemas, postgres.Dialect)
 
 	return
 }
 
+func generateSchemas(db *sql.DB, destDir, dbName string, schemaNames []string, dialect jet.Dialect) {
+	schemasInfo := getSchemasMetaData(db, schemaNames)
+
+	generateSchemasFiles(destDir, dbName, schemasInfo, referencedEnumSchemas(db, schemasInfo), dialect)
+}
+
+func getSchemasMetaData(db *sql.DB, schemaNames []string) []metadata.SchemaMetaData {
+	schemasInfo := []metadata.SchemaMetaData{}
+
+	for _, schemaName := range schemaNames {
+		fmt.Println("Retrieving schema information for " + schemaName + "...")
+		schemasInfo = append(schemasInfo, metadata.GetSchemaMetaData(db, schemaName, &postgresQuerySet{}))
+	}
+
+	return schemasInfo
+}
+
+func generateSchemasFiles(destDir, dbName string, schemasInfo, enumSchemas []metadata.SchemaMetaData, dialect jet.Dialect) {
+	linkSchemasEnums(destDir, dbName, schemasInfo, enumSchemas)
+
+	for _, schemaInfo := range schemasInfo {
+		genPath := path.Join(destDir, dbName, schemaInfo.SchemaName)
+		template.GenerateFiles(genPath, schemaInfo, dialect)
+	}
+}
+
+func linkSchemasEnums(destDir, dbName string, schemasInfo, enumSchemas []metadata.SchemaMetaData) {
+	modelImportPath := func(schemaName string) string {
+		for _, schemaInfo := range schemasInfo {
+			if schemaInfo.SchemaName != schemaName {
+				continue
+			}
+
+			importPath, err := utils.GoImportPath(path.Join(destDir, dbName, schemaName, "model"))
+			utils.PanicOnError(err)
+
+			return importPath
+		}
+
+		return ""
+	}
+
+	metadata.LinkSchemasEnums(schemasInfo, enumSchemas, modelImportPath)
+}
+
+// referencedEnumSchemas returns enums of schemas referenced from schemasInfo columns, but not part of schemasInfo.
+func referencedEnumSchemas(db *sql.DB, schemasInfo []metadata.SchemaMetaData) []metadata.SchemaMetaData {
+	schemaNames := map[string]bool{}
+
+	for _, schemaInfo := range schemasInfo {
+		schemaNames[schemaInfo.SchemaName] = true
+	}
+
+	ret := []metadata.SchemaMetaData{}
+
+	for _, schemaInfo := range schemasInfo {
+		for _, enumSchema := range schemaInfo.ReferencedEnumSchemaNames() {
+			if schemaNames[enumSchema] {
+				continue
+			}
+
+			schemaNames[enumSchema] = true
+
+			ret = append(ret, metadata.SchemaMetaData{
+				SchemaName:    enumSchema,
+				EnumsMetaData: (&postgresQuerySet{}).GetEnumsMetaData(db, enumSchema),
+			})
+		}
+	}
+
+	return ret
+}
+
+func matchSchemaNames(db *sql.DB, schemaPatterns []string) []string {
+	rows, err := db.Query(listOfSchemasQuery)
+	utils.PanicOnError(err)
+	defer rows.Close()
+
+	ret := []string{}
+
+	for rows.Next() {
+		var schemaName string
+		err := rows.Scan(&schemaName)
+		utils.PanicOnError(err)
+
+		matched, err := matchSchemaName(schemaName, schemaPatterns)
+		utils.PanicOnError(err)
+
+		if matched {
+			ret = append(ret, schemaName)
+		}
+	}
+
+	err = rows.Err()
+	utils.PanicOnError(err)
+
+	return ret
+}
+
+// matchSchemaName returns true if schema name is equal to any of schema patterns, or if non system schema name
+// matches any of shell file name patterns.
+func matchSchemaName(schemaName string, schemaPatterns []string) (bool, error) {
+	for _, schemaPattern := range schemaPatterns {
+		if schemaPattern == schemaName {
+			return true, nil
+		}
+
+		if isSystemSchema(schemaName) {
+			continue
+		}
+
+		matched, err := path.Match(schemaPattern, schemaName)
+
+		if err != nil || matched {
+			return matched, err
+		}
+	}
+
+	return false, nil
+}
+
+func isSystemSchema(schemaName string) bool {
+	return schemaName == "information_schema" || strings.HasPrefix(schemaName, "pg_")
+}
+
 func openConnection(dbConn DBConnection) (*sql.DB, error) {
 	connectionString := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s %s",
 		dbConn.Host, strconv.Itoa(dbConn.Port), dbConn.User, dbConn.Password, dbConn.DBName, dbConn.SslMode, dbConn.Params)
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"github.com/go-jet/jet/generator/internal/snapshot"
+	"github.com/go-jet/jet/internal/utils"
+	"github.com/go-jet/jet/postgres"
+	"path"
+	"strings"
+)
+
+// SaveSnapshot saves JSON snapshot of tables, views and enums meta data of each database schema matching any of
+// schema patterns, to snapshot file path. DBConnection SchemaName is ignored.
+func SaveSnapshot(snapshotFilePath string, dbConn DBConnection, schemaPatterns ...string) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	db, err := openConnection(dbConn)
+	utils.PanicOnError(err)
+	defer utils.DBClose(db)
+
+	schemaNames := matchSchemaNames(db, schemaPatterns)
+
+	if len(schemaNames) == 0 {
+		return fmt.Errorf("jet: no schema matching %s found", strings.Join(schemaPatterns, ", "))
+	}
+
+	schemasSnapshot := snapshot.New(postgres.Dialect.Name(), dbConn.DBName, getSchemasMetaData(db, schemaNames))
+
+	fmt.Println("Saving snapshot to " + snapshotFilePath + "...")
+
+	return snapshot.Save(snapshotFilePath, schemasSnapshot)
+}
+
+// Check compares each database schema matching any of schema patterns with files previously generated at destination
+// dir, and returns human readable list of tables, views, columns and enum values added (+), removed (-) or
+// changed (~) in database since files were generated. Empty list is returned if generated files are up to date.
+// DBConnection SchemaName is ignored.
+func Check(destDir string, dbConn DBConnection, schemaPatterns ...string) (diff []string, err error) {
+	defer utils.ErrorCatch(&err)
+
+	db, err := openConnection(dbConn)
+	utils.PanicOnError(err)
+	defer utils.DBClose(db)
+
+	schemaNames := matchSchemaNames(db, schemaPatterns)
+
+	if len(schemaNames) == 0 {
+		return nil, fmt.Errorf("jet: no schema matching %s found", strings.Join(schemaPatterns, ", "))
+	}
+
+	schemasInfo := getSchemasMetaData(db, schemaNames)
+
+	return checkSchemas(destDir, dbConn.DBName, schemasInfo, referencedEnumSchemas(db, schemasInfo)), nil
+}
+
+// CheckSnapshot compares schemas snapshot, saved with SaveSnapshot, with files previously generated at destination
+// dir. Returns the same list of differences as Check.
+func CheckSnapshot(destDir string, snapshotFilePath string) (diff []string, err error) {
+	defer utils.ErrorCatch(&err)
+
+	schemasSnapshot, err := snapshot.Load(snapshotFilePath)
+	utils.PanicOnError(err)
+
+	if schemasSnapshot.Dialect != postgres.Dialect.Name() {
+		return nil, fmt.Errorf("jet: snapshot %s is not %s snapshot", snapshotFilePath, postgres.Dialect.Name())
+	}
+
+	schemasInfo := schemasSnapshot.SchemasMetaData()
+
+	return checkSchemas(destDir, schemasSnapshot.Database, schemasInfo, schemasInfo), nil
+}
+
+func checkSchemas(destDir, dbName string, schemasInfo, enumSchemas []metadata.SchemaMetaData) []string {
+	linkSchemasEnums(destDir, dbName, schemasInfo, enumSchemas)
+
+	diff := []string{}
+
+	for _, schemaInfo := range schemasInfo {
+		schemaDiff, err := snapshot.Diff(schemaInfo, path.Join(destDir, dbName, schemaInfo.SchemaName))
+		utils.PanicOnError(err)
+
+		diff = append(diff, schemaDiff...)
+	}
+
+	return diff
+}
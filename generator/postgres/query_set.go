@@ -2,18 +2,32 @@ package postgres
 
 import (
 	"database/sql"
+	"fmt"
 	"github.com/go-jet/jet/generator/internal/metadata"
 	"github.com/go-jet/jet/internal/utils"
 )
 
+const listOfSchemasQuery = `
+SELECT schema_name
+FROM information_schema.schemata
+ORDER BY schema_name;
+`
+
 // postgresQuerySet is dialect query set for PostgreSQL
 type postgresQuerySet struct{}
 
 func (p *postgresQuerySet) ListOfTablesQuery() string {
 	return `
-SELECT table_name 
-FROM information_schema.tables
-where table_schema = $1 and table_type = $2;
+SELECT table_name
+FROM (
+	SELECT table_schema::text, table_name::text, table_type::text
+	FROM information_schema.tables
+	UNION ALL
+	SELECT schemaname::text, matviewname::text, 'MATERIALIZED VIEW'
+	FROM pg_catalog.pg_matviews
+) AS t
+where table_schema = $1 and table_type = $2
+order by table_name;
 `
 }
 
@@ -27,12 +41,32 @@ WHERE t.table_schema = $1 AND t.table_name = $2 AND t.constraint_type = 'PRIMARY
 `
 }
 
+// ListOfColumnsQuery returns columns of tables and views from information_schema, and columns of materialized views
+// (not listed in information_schema) from pg_catalog.
 func (p *postgresQuerySet) ListOfColumnsQuery() string {
 	return `
-SELECT column_name, is_nullable, data_type, udt_name, FALSE
-FROM information_schema.columns
+SELECT column_name, is_nullable, data_type, udt_name, FALSE, enum_schema
+FROM (
+	SELECT table_schema::text, table_name::text, column_name::text, is_nullable::text, data_type::text, udt_name::text,
+		(CASE data_type WHEN 'USER-DEFINED' THEN udt_schema ELSE '' END)::text AS enum_schema,
+		ordinal_position::int AS position
+	FROM information_schema.columns
+	UNION ALL
+	SELECT n.nspname::text, c.relname::text, a.attname::text,
+		(CASE WHEN a.attnotnull THEN 'NO' ELSE 'YES' END),
+		(CASE WHEN t.typtype = 'e' THEN 'USER-DEFINED' WHEN t.typcategory = 'A' THEN 'ARRAY' ELSE format_type(a.atttypid, NULL) END),
+		t.typname::text,
+		(CASE WHEN t.typtype = 'e' THEN tn.nspname ELSE '' END)::text,
+		a.attnum::int
+	FROM pg_catalog.pg_attribute a
+		JOIN pg_catalog.pg_class c ON c.oid = a.attrelid
+		JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+		JOIN pg_catalog.pg_type t ON t.oid = a.atttypid
+		JOIN pg_catalog.pg_namespace tn ON tn.oid = t.typnamespace
+	WHERE c.relkind = 'm' AND a.attnum > 0 AND NOT a.attisdropped
+) AS columns
 where table_schema = $1 and table_name = $2
-order by ordinal_position;`
+order by position;`
 }
 
 func (p *postgresQuerySet) ListOfEnumsQuery() string {
@@ -79,3 +113,150 @@ func (p *postgresQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []met
 
 	return ret
 }
+
+const listOfFunctionsQuery = `
+SELECT p.proname, r.specific_name, r.data_type, r.type_udt_name, r.type_udt_schema, COALESCE(c.relname, '')
+FROM information_schema.routines r
+	JOIN pg_catalog.pg_proc p ON r.specific_name = p.proname || '_' || p.oid
+	JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace AND n.nspname = r.specific_schema
+	LEFT JOIN pg_catalog.pg_type t ON t.oid = p.prorettype
+	LEFT JOIN pg_catalog.pg_class c ON c.oid = t.typrelid
+WHERE r.specific_schema = $1 AND p.proretset
+ORDER BY p.proname, p.oid;
+`
+
+const listOfFunctionParametersQuery = `
+SELECT COALESCE(parameter_name, ''), parameter_mode, data_type, udt_name,
+	(CASE data_type WHEN 'USER-DEFINED' THEN udt_schema ELSE '' END)
+FROM information_schema.parameters
+WHERE specific_schema = $1 AND specific_name = $2
+ORDER BY ordinal_position;
+`
+
+const listOfSequencesQuery = `
+SELECT sequence_name
+FROM information_schema.sequences
+WHERE sequence_schema = $1
+ORDER BY sequence_name;
+`
+
+type functionInfo struct {
+	name, specificName                         string
+	returnType, returnUdtName, returnUdtSchema string
+	returnTable                                string
+}
+
+// GetFunctionsMetaData returns meta data of set returning functions. Function result columns are OUT parameters,
+// columns of returned table type, or single column named as function for functions returning set of scalar values.
+// Overloaded functions are distinguished by specific name, and functions returning set of records without OUT
+// parameters are skipped.
+func (p *postgresQuerySet) GetFunctionsMetaData(db *sql.DB, schemaName string) []metadata.MetaData {
+	rows, err := db.Query(listOfFunctionsQuery, schemaName)
+	utils.PanicOnError(err)
+	defer rows.Close()
+
+	functions := []functionInfo{}
+	overloads := map[string]int{}
+
+	for rows.Next() {
+		var function functionInfo
+		err = rows.Scan(&function.name, &function.specificName, &function.returnType, &function.returnUdtName,
+			&function.returnUdtSchema, &function.returnTable)
+		utils.PanicOnError(err)
+
+		functions = append(functions, function)
+		overloads[function.name]++
+	}
+
+	err = rows.Err()
+	utils.PanicOnError(err)
+
+	ret := []metadata.MetaData{}
+
+	for _, function := range functions {
+		params, columns := p.getFunctionParameters(db, schemaName, function.specificName)
+
+		if len(columns) == 0 {
+			switch {
+			case function.returnTable != "":
+				columns = metadata.GetTableMetaData(db, p, function.returnUdtSchema, function.returnTable).Columns
+			case function.returnType != "record":
+				column := metadata.NewColumnMetaData(function.name, true, function.returnType, function.returnUdtName, false)
+				if function.returnType == "USER-DEFINED" {
+					column.EnumSchema = function.returnUdtSchema
+				}
+				columns = []metadata.ColumnMetaData{column}
+			default:
+				fmt.Println("- [SQL Builder] Function '" + schemaName + "." + function.name + "' result columns unknown, skipped.")
+				continue
+			}
+		}
+
+		functionMetaData := metadata.NewFunctionMetaData(schemaName, function.name, params, columns)
+
+		if overloads[function.name] > 1 {
+			fmt.Println("- [SQL Builder] Function '" + schemaName + "." + function.name + "' is overloaded, overload " +
+				"with specific name '" + function.specificName + "' is generated as '" +
+				utils.ToGoIdentifier(function.specificName) + "'.")
+			functionMetaData.SpecificName = function.specificName
+		}
+
+		ret = append(ret, functionMetaData)
+	}
+
+	return ret
+}
+
+// getFunctionParameters returns function input parameters and output columns
+func (p *postgresQuerySet) getFunctionParameters(db *sql.DB, schemaName, specificName string) (params, columns []metadata.ColumnMetaData) {
+	rows, err := db.Query(listOfFunctionParametersQuery, schemaName, specificName)
+	utils.PanicOnError(err)
+	defer rows.Close()
+
+	for rows.Next() {
+		var name, mode, dataType, udtName, enumSchema string
+		err = rows.Scan(&name, &mode, &dataType, &udtName, &enumSchema)
+		utils.PanicOnError(err)
+
+		if mode == "IN" || mode == "INOUT" {
+			param := metadata.NewColumnMetaData(name, false, dataType, udtName, false)
+			param.EnumSchema = enumSchema
+			params = append(params, param)
+		}
+
+		if mode == "OUT" || mode == "INOUT" {
+			column := metadata.NewColumnMetaData(name, true, dataType, udtName, false)
+			column.EnumSchema = enumSchema
+			columns = append(columns, column)
+		}
+	}
+
+	err = rows.Err()
+	utils.PanicOnError(err)
+
+	return
+}
+
+func (p *postgresQuerySet) GetSequencesMetaData(db *sql.DB, schemaName string) []metadata.MetaData {
+	rows, err := db.Query(listOfSequencesQuery, schemaName)
+	utils.PanicOnError(err)
+	defer rows.Close()
+
+	ret := []metadata.MetaData{}
+
+	for rows.Next() {
+		var sequenceName string
+		err = rows.Scan(&sequenceName)
+		utils.PanicOnError(err)
+
+		ret = append(ret, metadata.SequenceMetaData{
+			SchemaName:   schemaName,
+			SequenceName: sequenceName,
+		})
+	}
+
+	err = rows.Err()
+	utils.PanicOnError(err)
+
+	return ret
+}
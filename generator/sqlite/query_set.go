@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"database/sql"
+	"github.com/go-jet/jet/generator/internal/metadata"
+)
+
+// sqliteQuerySet is dialect query set for SQLite.
+// Queries use numbered parameters, so that the schema name, always bound as first parameter,
+// can be left unused where SQLite does not accept it.
+type sqliteQuerySet struct{}
+
+func (s *sqliteQuerySet) ListOfTablesQuery() string {
+	return `
+SELECT name
+FROM sqlite_master
+WHERE type = (CASE ?2 WHEN 'BASE TABLE' THEN 'table' WHEN 'VIEW' THEN 'view' ELSE '' END)
+	AND name NOT LIKE 'sqlite_%'
+ORDER BY name;
+`
+}
+
+func (s *sqliteQuerySet) PrimaryKeysQuery() string {
+	return `
+SELECT name
+FROM pragma_table_info(?2, ?1)
+WHERE pk > 0
+ORDER BY pk;
+`
+}
+
+// ListOfColumnsQuery maps declared column types to generator types using SQLite type affinity rules
+func (s *sqliteQuerySet) ListOfColumnsQuery() string {
+	return `
+SELECT name,
+	(CASE WHEN "notnull" = 1 OR pk > 0 THEN 'NO' ELSE 'YES' END),
+	(CASE
+		WHEN upper(type) IN ('BOOL', 'BOOLEAN') THEN 'boolean'
+		WHEN upper(type) = 'DATE' THEN 'date'
+		WHEN upper(type) IN ('DATETIME', 'TIMESTAMP') THEN 'timestamp'
+		WHEN upper(type) = 'TIME' THEN 'time'
+		WHEN upper(type) LIKE '%INT%' THEN 'bigint'
+		WHEN upper(type) LIKE '%CHAR%' OR upper(type) LIKE '%CLOB%' OR upper(type) LIKE '%TEXT%' THEN 'text'
+		WHEN upper(type) LIKE '%BLOB%' OR type = '' THEN 'blob'
+		WHEN upper(type) LIKE '%REAL%' OR upper(type) LIKE '%FLOA%' OR upper(type) LIKE '%DOUB%' THEN 'double'
+		ELSE 'numeric'
+	END),
+	'',
+	0,
+	''
+FROM pragma_table_info(?2, ?1)
+ORDER BY cid;
+`
+}
+
+func (s *sqliteQuerySet) ListOfEnumsQuery() string {
+	return ""
+}
+
+// SQLite does not support enums
+func (s *sqliteQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metadata.MetaData {
+	return []metadata.MetaData{}
+}
+
+// SQLite does not support stored functions
+func (s *sqliteQuerySet) GetFunctionsMetaData(db *sql.DB, schemaName string) []metadata.MetaData {
+	return []metadata.MetaData{}
+}
+
+// SQLite does not support sequences
+func (s *sqliteQuerySet) GetSequencesMetaData(db *sql.DB, schemaName string) []metadata.MetaData {
+	return []metadata.MetaData{}
+}
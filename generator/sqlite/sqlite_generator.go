@@ -0,0 +1,39 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"github.com/go-jet/jet/generator/internal/template"
+	"github.com/go-jet/jet/internal/utils"
+	"github.com/go-jet/jet/sqlite"
+)
+
+// SQLite database file is always attached as main schema
+const mainSchema = "main"
+
+// Generate generates jet files at destination dir from SQLite database file
+func Generate(destDir string, dbFilePath string) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	db := openConnection(dbFilePath)
+	defer utils.DBClose(db)
+
+	fmt.Println("Retrieving database information...")
+	dbInfo := metadata.GetSchemaMetaData(db, mainSchema, &sqliteQuerySet{})
+
+	template.GenerateFiles(destDir, dbInfo, sqlite.Dialect)
+
+	return nil
+}
+
+func openConnection(dbFilePath string) *sql.DB {
+	fmt.Println("Connecting to SQLite database: " + dbFilePath)
+	db, err := sql.Open("sqlite3", dbFilePath)
+	utils.PanicOnError(err)
+
+	err = db.Ping()
+	utils.PanicOnError(err)
+
+	return db
+}
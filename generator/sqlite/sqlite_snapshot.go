@@ -0,0 +1,55 @@
+package sqlite
+
+import (
+	"fmt"
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"github.com/go-jet/jet/generator/internal/snapshot"
+	"github.com/go-jet/jet/internal/utils"
+	"github.com/go-jet/jet/sqlite"
+)
+
+// SaveSnapshot saves JSON snapshot of database tables and views meta data to snapshot file path.
+func SaveSnapshot(snapshotFilePath string, dbFilePath string) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	db := openConnection(dbFilePath)
+	defer utils.DBClose(db)
+
+	fmt.Println("Retrieving database information...")
+	dbInfo := metadata.GetSchemaMetaData(db, mainSchema, &sqliteQuerySet{})
+
+	fmt.Println("Saving snapshot to " + snapshotFilePath + "...")
+
+	return snapshot.Save(snapshotFilePath, snapshot.New(sqlite.Dialect.Name(), mainSchema, []metadata.SchemaMetaData{dbInfo}))
+}
+
+// Check compares database with files previously generated at destination dir, and returns human readable list of
+// tables, views and columns added (+), removed (-) or changed (~) in database since files were generated.
+// Empty list is returned if generated files are up to date.
+func Check(destDir string, dbFilePath string) (diff []string, err error) {
+	defer utils.ErrorCatch(&err)
+
+	db := openConnection(dbFilePath)
+	defer utils.DBClose(db)
+
+	fmt.Println("Retrieving database information...")
+	dbInfo := metadata.GetSchemaMetaData(db, mainSchema, &sqliteQuerySet{})
+
+	return snapshot.Diff(dbInfo, destDir)
+}
+
+// CheckSnapshot compares database snapshot, saved with SaveSnapshot, with files previously generated at destination
+// dir. Returns the same list of differences as Check.
+func CheckSnapshot(destDir string, snapshotFilePath string) (diff []string, err error) {
+	dbSnapshot, err := snapshot.Load(snapshotFilePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if dbSnapshot.Dialect != sqlite.Dialect.Name() || len(dbSnapshot.Schemas) != 1 {
+		return nil, fmt.Errorf("jet: snapshot %s is not %s snapshot", snapshotFilePath, sqlite.Dialect.Name())
+	}
+
+	return snapshot.Diff(dbSnapshot.SchemasMetaData()[0], destDir)
+}
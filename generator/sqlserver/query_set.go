@@ -0,0 +1,102 @@
+package sqlserver
+
+import (
+	"database/sql"
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"github.com/go-jet/jet/internal/utils"
+)
+
+// sqlServerQuerySet is dialect query set for SQL Server
+type sqlServerQuerySet struct{}
+
+func (s *sqlServerQuerySet) ListOfTablesQuery() string {
+	return `
+SELECT TABLE_NAME
+FROM INFORMATION_SCHEMA.TABLES
+WHERE TABLE_SCHEMA = @p1 AND TABLE_TYPE = @p2
+ORDER BY TABLE_NAME;
+`
+}
+
+func (s *sqlServerQuerySet) PrimaryKeysQuery() string {
+	return `
+SELECT k.COLUMN_NAME
+FROM INFORMATION_SCHEMA.TABLE_CONSTRAINTS t
+	JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE k ON k.CONSTRAINT_SCHEMA = t.CONSTRAINT_SCHEMA
+		AND k.CONSTRAINT_NAME = t.CONSTRAINT_NAME
+WHERE t.CONSTRAINT_TYPE = 'PRIMARY KEY' AND t.TABLE_SCHEMA = @p1 AND t.TABLE_NAME = @p2
+ORDER BY k.ORDINAL_POSITION;
+`
+}
+
+// ListOfColumnsQuery maps SQL Server data types to generator types. tinyint is the only unsigned SQL Server type.
+func (s *sqlServerQuerySet) ListOfColumnsQuery() string {
+	return `
+SELECT COLUMN_NAME,
+	IS_NULLABLE,
+	(CASE
+		WHEN DATA_TYPE = 'bit' THEN 'boolean'
+		WHEN DATA_TYPE = 'int' THEN 'integer'
+		WHEN DATA_TYPE IN ('decimal', 'numeric', 'money', 'smallmoney') THEN 'numeric'
+		WHEN DATA_TYPE = 'float' THEN 'double precision'
+		WHEN DATA_TYPE IN ('datetime', 'datetime2', 'smalldatetime') THEN 'timestamp'
+		WHEN DATA_TYPE = 'datetimeoffset' THEN 'timestamp with time zone'
+		WHEN DATA_TYPE IN ('char', 'varchar', 'nchar', 'nvarchar', 'text', 'ntext') THEN 'text'
+		WHEN DATA_TYPE IN ('binary', 'varbinary', 'image', 'timestamp', 'rowversion') THEN 'bytea'
+		WHEN DATA_TYPE = 'uniqueidentifier' THEN 'uuid'
+		ELSE DATA_TYPE
+	END),
+	'',
+	CAST((CASE DATA_TYPE WHEN 'tinyint' THEN 1 ELSE 0 END) AS BIT),
+	''
+FROM INFORMATION_SCHEMA.COLUMNS
+WHERE TABLE_SCHEMA = @p1 AND TABLE_NAME = @p2
+ORDER BY ORDINAL_POSITION;
+`
+}
+
+func (s *sqlServerQuerySet) ListOfEnumsQuery() string {
+	return ""
+}
+
+// SQL Server does not support enums
+func (s *sqlServerQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metadata.MetaData {
+	return []metadata.MetaData{}
+}
+
+// SQL Server table valued functions are not supported
+func (s *sqlServerQuerySet) GetFunctionsMetaData(db *sql.DB, schemaName string) []metadata.MetaData {
+	return []metadata.MetaData{}
+}
+
+const listOfSequencesQuery = `
+SELECT seq.name
+FROM sys.sequences seq
+	JOIN sys.schemas s ON s.schema_id = seq.schema_id
+WHERE s.name = @p1
+ORDER BY seq.name;
+`
+
+func (s *sqlServerQuerySet) GetSequencesMetaData(db *sql.DB, schemaName string) []metadata.MetaData {
+	rows, err := db.Query(listOfSequencesQuery, schemaName)
+	utils.PanicOnError(err)
+	defer rows.Close()
+
+	ret := []metadata.MetaData{}
+
+	for rows.Next() {
+		var sequenceName string
+		err = rows.Scan(&sequenceName)
+		utils.PanicOnError(err)
+
+		ret = append(ret, metadata.SequenceMetaData{
+			SchemaName:   schemaName,
+			SequenceName: sequenceName,
+		})
+	}
+
+	err = rows.Err()
+	utils.PanicOnError(err)
+
+	return ret
+}
@@ -0,0 +1,68 @@
+package sqlserver
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"github.com/go-jet/jet/generator/internal/template"
+	"github.com/go-jet/jet/internal/utils"
+	"github.com/go-jet/jet/sqlserver"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+// DBConnection contains SQL Server connection details
+type DBConnection struct {
+	Host     string
+	Port     int
+	User     string
+	Password string
+	Params   string
+
+	DBName     string
+	SchemaName string
+}
+
+// Generate generates jet files at destination dir from database connection details.
+// Files are generated into destDir/dbName/schemaName folder.
+func Generate(destDir string, dbConn DBConnection) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	db := openConnection(dbConn)
+	defer utils.DBClose(db)
+
+	fmt.Println("Retrieving schema information for " + dbConn.SchemaName + "...")
+	schemaInfo := metadata.GetSchemaMetaData(db, dbConn.SchemaName, &sqlServerQuerySet{})
+
+	genPath := path.Join(destDir, dbConn.DBName, dbConn.SchemaName)
+
+	template.GenerateFiles(genPath, schemaInfo, sqlserver.Dialect)
+
+	return nil
+}
+
+func openConnection(dbConn DBConnection) *sql.DB {
+	query := url.Values{}
+	query.Add("database", dbConn.DBName)
+
+	connectionURL := url.URL{
+		Scheme:   "sqlserver",
+		User:     url.UserPassword(dbConn.User, dbConn.Password),
+		Host:     dbConn.Host + ":" + strconv.Itoa(dbConn.Port),
+		RawQuery: query.Encode(),
+	}
+
+	if dbConn.Params != "" {
+		connectionURL.RawQuery += "&" + dbConn.Params
+	}
+
+	fmt.Println("Connecting to SQL Server database: " + connectionURL.Redacted())
+	db, err := sql.Open("sqlserver", connectionURL.String())
+	utils.PanicOnError(err)
+
+	err = db.Ping()
+	utils.PanicOnError(err)
+
+	return db
+}
@@ -0,0 +1,58 @@
+package sqlserver
+
+import (
+	"fmt"
+	"github.com/go-jet/jet/generator/internal/metadata"
+	"github.com/go-jet/jet/generator/internal/snapshot"
+	"github.com/go-jet/jet/internal/utils"
+	"github.com/go-jet/jet/sqlserver"
+	"path"
+)
+
+// SaveSnapshot saves JSON snapshot of schema tables, views and sequences meta data to snapshot file path.
+func SaveSnapshot(snapshotFilePath string, dbConn DBConnection) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	db := openConnection(dbConn)
+	defer utils.DBClose(db)
+
+	fmt.Println("Retrieving schema information for " + dbConn.SchemaName + "...")
+	schemaInfo := metadata.GetSchemaMetaData(db, dbConn.SchemaName, &sqlServerQuerySet{})
+
+	fmt.Println("Saving snapshot to " + snapshotFilePath + "...")
+
+	return snapshot.Save(snapshotFilePath, snapshot.New(sqlserver.Dialect.Name(), dbConn.DBName, []metadata.SchemaMetaData{schemaInfo}))
+}
+
+// Check compares database schema with files previously generated at destination dir, and returns human readable
+// list of tables, views and columns added (+), removed (-) or changed (~) in database since files were generated.
+// Empty list is returned if generated files are up to date.
+func Check(destDir string, dbConn DBConnection) (diff []string, err error) {
+	defer utils.ErrorCatch(&err)
+
+	db := openConnection(dbConn)
+	defer utils.DBClose(db)
+
+	fmt.Println("Retrieving schema information for " + dbConn.SchemaName + "...")
+	schemaInfo := metadata.GetSchemaMetaData(db, dbConn.SchemaName, &sqlServerQuerySet{})
+
+	return snapshot.Diff(schemaInfo, path.Join(destDir, dbConn.DBName, dbConn.SchemaName))
+}
+
+// CheckSnapshot compares schema snapshot, saved with SaveSnapshot, with files previously generated at destination
+// dir. Returns the same list of differences as Check.
+func CheckSnapshot(destDir string, snapshotFilePath string) (diff []string, err error) {
+	dbSnapshot, err := snapshot.Load(snapshotFilePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if dbSnapshot.Dialect != sqlserver.Dialect.Name() || len(dbSnapshot.Schemas) != 1 {
+		return nil, fmt.Errorf("jet: snapshot %s is not %s snapshot", snapshotFilePath, sqlserver.Dialect.Name())
+	}
+
+	schemaInfo := dbSnapshot.SchemasMetaData()[0]
+
+	return snapshot.Diff(schemaInfo, path.Join(destDir, dbSnapshot.Database, schemaInfo.SchemaName))
+}
@@ -0,0 +1,160 @@
+package jet
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-jet/jet/internal/utils"
+	"github.com/go-jet/jet/qrm"
+)
+
+// BatchStatement is list of statements serialized into single query, and executed in one database round trip.
+// Result set of each statement that returns rows is mapped into its own destination. Statements without projections,
+// for instance UPDATE without RETURNING, do not have destination, because database drivers skip their results.
+// Database driver has to support multiple statements in one parametrized query, for instance MySQL driver with
+// multiStatements=true and interpolateParams=true option, or SQL Server driver. PostgreSQL and SQLite drivers do
+// not support it.
+type BatchStatement interface {
+	// Sql returns parametrized sql query of all the statements, with list of arguments.
+	// Sql panics if any of the statements is not valid.
+	Sql(options ...FormatOption) (query string, args []interface{})
+	// SqlErr returns parametrized sql query of all the statements with list of arguments, or SerializeError
+	// if any of the statements is not valid.
+	SqlErr(options ...FormatOption) (query string, args []interface{}, err error)
+	// DebugSql returns debug query where every parametrized placeholder is replaced with its argument.
+	// Do not use it in production. Use it only for debug purposes.
+	DebugSql(options ...FormatOption) (query string)
+
+	// Query executes batch over database connection db, and stores result sets of statements that return rows
+	// into destinations, in the order of statements. Destinations are the same as destinations of statement Query.
+	Query(db qrm.DB, destinations ...interface{}) error
+	// QueryContext executes batch with a context over database connection db, and stores result sets of statements
+	// that return rows into destinations, in the order of statements.
+	QueryContext(context context.Context, db qrm.DB, destinations ...interface{}) error
+}
+
+// batchableStatement is implemented by statements that can be serialized into batch of statements
+type batchableStatement interface {
+	HasProjections
+	serializeStatement(out *SQLBuilder)
+}
+
+// NewBatchStatement creates new batch of statements
+func NewBatchStatement(dialect Dialect, statements ...Statement) BatchStatement {
+	return &batchStatementImpl{
+		dialect:    dialect,
+		statements: statements,
+	}
+}
+
+type batchStatementImpl struct {
+	dialect    Dialect
+	statements []Statement
+}
+
+func (b *batchStatementImpl) Sql(options ...FormatOption) (query string, args []interface{}) {
+	query, args, err := b.sql(false, options)
+
+	if err != nil {
+		panic(err.recovered)
+	}
+
+	return
+}
+
+func (b *batchStatementImpl) SqlErr(options ...FormatOption) (query string, args []interface{}, err error) {
+	query, args, serializeErr := b.sql(false, options)
+
+	if serializeErr != nil {
+		return "", nil, serializeErr
+	}
+
+	return
+}
+
+func (b *batchStatementImpl) DebugSql(options ...FormatOption) (query string) {
+	query, _, err := b.sql(true, options)
+
+	if err != nil {
+		panic(err.recovered)
+	}
+
+	return
+}
+
+func (b *batchStatementImpl) sql(debug bool, options []FormatOption) (string, []interface{}, *SerializeError) {
+	sqlBuilder := newSQLBuilder(b.dialect, debug, options)
+
+	if len(b.statements) == 0 {
+		sqlBuilder.errors = append(sqlBuilder.errors, newBatchError("jet: batch has no statements"))
+	}
+
+	for i, statement := range b.statements {
+		if i > 0 {
+			sqlBuilder.Buff.WriteByte(';')
+			sqlBuilder.lastChar = ';'
+		}
+
+		if utils.IsNil(statement) {
+			sqlBuilder.errors = append(sqlBuilder.errors, newBatchError("jet: batch statement is nil"))
+			continue
+		}
+
+		if _, ok := statement.(CompiledStatement); ok {
+			// compiled query and bound arguments can not be serialized again into another query
+			sqlBuilder.errors = append(sqlBuilder.errors, newBatchError("jet: compiled statement can not be used in batch"))
+			continue
+		}
+
+		batchable, ok := statement.(batchableStatement)
+
+		if !ok {
+			sqlBuilder.errors = append(sqlBuilder.errors, newBatchError("jet: statement can not be used in batch"))
+			continue
+		}
+
+		batchable.serializeStatement(sqlBuilder)
+	}
+
+	query, args := sqlBuilder.finalize()
+
+	return query, args, sqlBuilder.err()
+}
+
+func newBatchError(message string) *SerializeError {
+	return &SerializeError{Message: message, recovered: message}
+}
+
+func (b *batchStatementImpl) Query(db qrm.DB, destinations ...interface{}) error {
+	return b.QueryContext(context.Background(), db, destinations...)
+}
+
+func (b *batchStatementImpl) QueryContext(context context.Context, db qrm.DB, destinations ...interface{}) (err error) {
+	query, args, err := statementSql(b)
+
+	if err != nil {
+		return err
+	}
+
+	if rowStatements := b.rowStatementsCount(); rowStatements != len(destinations) {
+		return fmt.Errorf("jet: batch has %d statements that return rows, but %d destinations", rowStatements, len(destinations))
+	}
+
+	if !isStrictMode() {
+		defer catchMisusePanic(&err)
+	}
+
+	return qrm.QueryBatch(context, db, query, args, destinations...)
+}
+
+// rowStatementsCount returns number of statements with projections, whose result sets are mapped into destinations
+func (b *batchStatementImpl) rowStatementsCount() int {
+	count := 0
+
+	for _, statement := range b.statements {
+		if len(statement.(batchableStatement).projections()) > 0 {
+			count++
+		}
+	}
+
+	return count
+}
@@ -19,6 +19,7 @@ type ClauseWithProjections interface {
 // ClauseSelect struct
 type ClauseSelect struct {
 	Distinct    bool
+	DistinctOn  []Expression
 	Projections []Projection
 }
 
@@ -35,6 +36,13 @@ func (s *ClauseSelect) Serialize(statementType StatementType, out *SQLBuilder) {
 		out.WriteString("DISTINCT")
 	}
 
+	if len(s.DistinctOn) > 0 {
+		out.RequireCapability(CapabilityDistinctOn)
+		out.WriteString("ON (")
+		serializeExpressionList(statementType, s.DistinctOn, ", ", out)
+		out.WriteString(")")
+	}
+
 	if len(s.Projections) == 0 {
 		panic("jet: SELECT clause has to have at least one projection")
 	}
@@ -140,7 +148,15 @@ type ClauseOrderBy struct {
 
 // Serialize serializes clause into SQLBuilder
 func (o *ClauseOrderBy) Serialize(statementType StatementType, out *SQLBuilder) {
-	if o.List == nil {
+	list := []OrderByClause{}
+
+	for _, value := range o.List {
+		if !utils.IsNil(value) { // nil is skipped, so that order by clauses can be added conditionally
+			list = append(list, value)
+		}
+	}
+
+	if len(list) == 0 {
 		return
 	}
 
@@ -151,7 +167,7 @@ func (o *ClauseOrderBy) Serialize(statementType StatementType, out *SQLBuilder)
 
 	out.IncreaseIdent()
 
-	for i, value := range o.List {
+	for i, value := range list {
 		if i > 0 {
 			out.WriteString(", ")
 		}
@@ -214,6 +230,8 @@ type ClauseSetStmtOperator struct {
 	OrderBy  ClauseOrderBy
 	Limit    ClauseLimit
 	Offset   ClauseOffset
+	// NoSelectWrap serializes selects without surrounding parentheses
+	NoSelectWrap bool
 }
 
 func (s *ClauseSetStmtOperator) projections() ProjectionList {
@@ -230,21 +248,32 @@ func (s *ClauseSetStmtOperator) Serialize(statementType StatementType, out *SQLB
 	}
 
 	for i, selectStmt := range s.Selects {
-		out.NewLine()
+		if i > 0 || !s.NoSelectWrap {
+			out.NewLine()
+		}
+
 		if i > 0 {
 			out.WriteString(s.Operator)
 
 			if s.All {
 				out.WriteString("ALL")
 			}
-			out.NewLine()
+
+			if !s.NoSelectWrap {
+				out.NewLine()
+			}
 		}
 
 		if selectStmt == nil {
 			panic("jet: select statement of '" + s.Operator + "' is nil")
 		}
 
-		selectStmt.serialize(statementType, out)
+		if s.NoSelectWrap {
+			// unwrapped select starts with a new line
+			selectStmt.serialize(statementType, out, noWrap)
+		} else {
+			selectStmt.serialize(statementType, out)
+		}
 	}
 
 	s.OrderBy.Serialize(statementType, out)
@@ -308,6 +337,10 @@ func (s *ClauseSet) Serialize(statementType StatementType, out *SQLBuilder) {
 type ClauseInsert struct {
 	Table   SerializerTable
 	Columns []Column
+	// Modifier is written between INSERT and INTO, for instance "OR REPLACE"
+	Modifier string
+	// Upsert replaces INSERT with UPSERT
+	Upsert bool
 }
 
 // GetColumns gets list of columns for insert
@@ -322,7 +355,19 @@ func (i *ClauseInsert) GetColumns() []Column {
 // Serialize serializes clause into SQLBuilder
 func (i *ClauseInsert) Serialize(statementType StatementType, out *SQLBuilder) {
 	out.NewLine()
-	out.WriteString("INSERT INTO")
+
+	if i.Upsert {
+		out.RequireCapability(CapabilityUpsert)
+		out.WriteString("UPSERT")
+	} else {
+		out.WriteString("INSERT")
+	}
+
+	if i.Modifier != "" {
+		out.WriteString(i.Modifier)
+	}
+
+	out.WriteString("INTO")
 
 	if utils.IsNil(i.Table) {
 		panic("jet: table is nil for INSERT clause")
@@ -390,7 +435,7 @@ func (v *ClauseValues) Serialize(statementType StatementType, out *SQLBuilder) {
 
 // ClauseQuery struct
 type ClauseQuery struct {
-	Query SerializerStatement
+	Query Serializer
 }
 
 // Serialize serializes clause into SQLBuilder
@@ -506,3 +551,26 @@ func (i *ClauseWindow) Serialize(statementType StatementType, out *SQLBuilder) {
 		def.Window.serialize(statementType, out)
 	}
 }
+
+// ClauseReturning struct
+type ClauseReturning struct {
+	Projections []Projection
+}
+
+func (r *ClauseReturning) projections() ProjectionList {
+	return r.Projections
+}
+
+// Serialize serializes clause into SQLBuilder
+func (r *ClauseReturning) Serialize(statementType StatementType, out *SQLBuilder) {
+	if len(r.Projections) == 0 {
+		return
+	}
+
+	out.RequireCapability(CapabilityReturning)
+
+	out.NewLine()
+	out.WriteString("RETURNING")
+	out.IncreaseIdent()
+	out.WriteProjections(statementType, r.Projections)
+}
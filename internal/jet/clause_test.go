@@ -2,6 +2,7 @@ package jet
 
 import (
 	"gotest.tools/assert"
+	"runtime"
 	"testing"
 )
 
@@ -14,3 +15,36 @@ func TestClauseSelect_Serialize(t *testing.T) {
 	selectClause := &ClauseSelect{}
 	selectClause.Serialize(SelectStatementType, &SQLBuilder{})
 }
+
+type panicClause struct {
+	serialize func()
+}
+
+func (p panicClause) Serialize(statementType StatementType, out *SQLBuilder) {
+	p.serialize()
+}
+
+func TestSerializeClauseMisusePanic(t *testing.T) {
+	out := SQLBuilder{Dialect: defaultDialect}
+
+	out.serializeClause(SelectStatementType, panicClause{func() { panic("jet: invalid clause") }})
+	out.serializeClause(SelectStatementType, panicClause{func() { out.RequireCapability("UNKNOWN") }})
+
+	assert.Equal(t, len(out.errors), 2)
+	assert.Equal(t, out.errors[0].Message, "jet: invalid clause")
+	assert.Equal(t, out.errors[1].Message, "jet: UNKNOWN is not supported by "+defaultDialect.Name()+" dialect")
+}
+
+func TestSerializeClauseRuntimeError(t *testing.T) {
+	defer func() {
+		_, isRuntimeError := recover().(runtime.Error)
+		assert.Assert(t, isRuntimeError)
+	}()
+
+	out := SQLBuilder{Dialect: defaultDialect}
+
+	out.serializeClause(SelectStatementType, panicClause{func() {
+		var projections ProjectionList
+		_ = projections[1]
+	}})
+}
@@ -0,0 +1,169 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"github.com/go-jet/jet/qrm"
+)
+
+// CompiledStatement is statement serialized only once. Every execution reuses compiled sql query and arguments,
+// without serializing statement again. Literal values are frozen at compile time, values of named parameters
+// are bound with Params.
+type CompiledStatement interface {
+	Statement
+
+	// Params returns compiled statement with named parameters bound to values from params. Params can be
+	// map[string]interface{}, or struct whose field name or alias tag matches parameter name.
+	Params(params interface{}) CompiledStatement
+}
+
+func newCompiledStatement(statement Statement) CompiledStatement {
+	query, args, err := statementSql(statement)
+
+	if err != nil {
+		return &compiledStatementImpl{err: err}
+	}
+
+	compiled := &compiledStatementImpl{
+		query:        query,
+		queries:      map[queryFormat]string{},
+		debugQueries: map[queryFormat]string{},
+		args:         args,
+	}
+
+	// statement can be modified after compilation, so queries in every format are serialized upfront,
+	// to stay consistent with compiled arguments
+	for _, format := range queryFormats {
+		compiled.queries[format], _, _ = statement.SqlErr(format.options()...)
+		compiled.debugQueries[format] = statement.DebugSql(format.options()...)
+	}
+
+	return compiled
+}
+
+// queryFormat is the combination of format options that affects serialized query
+type queryFormat struct {
+	compact    bool
+	normalized bool
+}
+
+var queryFormats = []queryFormat{{false, false}, {true, false}, {false, true}, {true, true}}
+
+func newQueryFormat(options []FormatOption) queryFormat {
+	builder := newSQLBuilder(nil, false, options)
+
+	return queryFormat{compact: builder.compact, normalized: builder.normalized}
+}
+
+func (f queryFormat) options() []FormatOption {
+	options := []FormatOption{Pretty}
+
+	if f.compact {
+		options = append(options, Compact)
+	}
+
+	if f.normalized {
+		options = append(options, Normalized)
+	}
+
+	return options
+}
+
+type compiledStatementImpl struct {
+	query        string
+	queries      map[queryFormat]string // query in every format, serialized at compile time
+	debugQueries map[queryFormat]string
+	args         []interface{}
+
+	err error // serialization or parameter binding error
+}
+
+func (c *compiledStatementImpl) Params(params interface{}) CompiledStatement {
+	if c.err != nil {
+		return c
+	}
+
+	args, err := BindArgs(c.args, params)
+
+	return &compiledStatementImpl{
+		query:        c.query,
+		queries:      c.queries,
+		debugQueries: c.debugQueries,
+		args:         args,
+		err:          err,
+	}
+}
+
+// Sql returns compiled query and arguments. Query in every format is serialized at compile time.
+func (c *compiledStatementImpl) Sql(options ...FormatOption) (query string, args []interface{}) {
+	if serializeErr, ok := c.err.(*SerializeError); ok {
+		panic(serializeErr.recovered)
+	}
+
+	if c.err != nil {
+		panic(c.err.Error())
+	}
+
+	query = c.query
+
+	if len(options) > 0 {
+		query = c.queries[newQueryFormat(options)]
+	}
+
+	return query, append([]interface{}{}, c.args...)
+}
+
+func (c *compiledStatementImpl) SqlErr(options ...FormatOption) (query string, args []interface{}, err error) {
+	if c.err != nil {
+		return "", nil, c.err
+	}
+
+	query, args = c.Sql(options...)
+
+	return query, args, nil
+}
+
+// DebugSql returns debug query compiled together with statement. Named parameters are printed as :name.
+func (c *compiledStatementImpl) DebugSql(options ...FormatOption) (query string) {
+	return c.debugQueries[newQueryFormat(options)]
+}
+
+func (c *compiledStatementImpl) Query(db qrm.DB, destination interface{}) error {
+	return c.QueryContext(context.Background(), db, destination)
+}
+
+func (c *compiledStatementImpl) QueryContext(context context.Context, db qrm.DB, destination interface{}) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	return runQuery(context, db, c.query, c.args, destination)
+}
+
+func (c *compiledStatementImpl) Exec(db qrm.DB) (res sql.Result, err error) {
+	return c.ExecContext(context.Background(), db)
+}
+
+func (c *compiledStatementImpl) ExecContext(context context.Context, db qrm.DB) (res sql.Result, err error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return db.ExecContext(context, c.query, c.args...)
+}
+
+func (c *compiledStatementImpl) Prepare(db qrm.Preparer) (PreparedStatement, error) {
+	return c.PrepareContext(context.Background(), db)
+}
+
+func (c *compiledStatementImpl) PrepareContext(context context.Context, db qrm.Preparer) (PreparedStatement, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return newPreparedStatement(context, db, c)
+}
+
+func (c *compiledStatementImpl) Compile() CompiledStatement {
+	return c
+}
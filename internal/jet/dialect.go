@@ -9,8 +9,23 @@ type Dialect interface {
 	AliasQuoteChar() byte
 	IdentifierQuoteChar() byte
 	ArgumentPlaceholder() QueryPlaceholderFunc
+	ArgumentToString(value interface{}) (string, bool)
+	Supports(capability Capability) bool
 }
 
+// Capability is SQL feature not supported by every dialect
+type Capability string
+
+// Dialect capabilities
+const (
+	CapabilityReturning         Capability = "RETURNING"
+	CapabilityUpsert            Capability = "UPSERT"
+	CapabilityDistinctOn        Capability = "DISTINCT ON"
+	CapabilityLateral           Capability = "LATERAL"
+	CapabilityWindowGroupsFrame Capability = "GROUPS window frame"
+	CapabilityAsOfSystemTime    Capability = "AS OF SYSTEM TIME"
+)
+
 // SerializeFunc func
 type SerializeFunc func(statement StatementType, out *SQLBuilder, options ...SerializeOption)
 
@@ -20,6 +35,10 @@ type SerializeOverride func(expressions ...Expression) SerializeFunc
 // QueryPlaceholderFunc func
 type QueryPlaceholderFunc func(ord int) string
 
+// ArgumentToStringFunc converts argument to dialect specific SQL literal. It returns false if dialect does not
+// have specific literal for argument type.
+type ArgumentToStringFunc func(value interface{}) (string, bool)
+
 // DialectParams struct
 type DialectParams struct {
 	Name                       string
@@ -29,10 +48,18 @@ type DialectParams struct {
 	AliasQuoteChar             byte
 	IdentifierQuoteChar        byte
 	ArgumentPlaceholder        QueryPlaceholderFunc
+	ArgumentToString           ArgumentToStringFunc
+	Capabilities               []Capability
 }
 
 // NewDialect creates new dialect with params
 func NewDialect(params DialectParams) Dialect {
+	capabilities := map[Capability]bool{}
+
+	for _, capability := range params.Capabilities {
+		capabilities[capability] = true
+	}
+
 	return &dialectImpl{
 		name:                       params.Name,
 		packageName:                params.PackageName,
@@ -41,6 +68,8 @@ func NewDialect(params DialectParams) Dialect {
 		aliasQuoteChar:             params.AliasQuoteChar,
 		identifierQuoteChar:        params.IdentifierQuoteChar,
 		argumentPlaceholder:        params.ArgumentPlaceholder,
+		argumentToString:           params.ArgumentToString,
+		capabilities:               capabilities,
 	}
 }
 
@@ -52,8 +81,8 @@ type dialectImpl struct {
 	aliasQuoteChar             byte
 	identifierQuoteChar        byte
 	argumentPlaceholder        QueryPlaceholderFunc
-
-	supportsReturning bool
+	argumentToString           ArgumentToStringFunc
+	capabilities               map[Capability]bool
 }
 
 func (d *dialectImpl) Name() string {
@@ -89,3 +118,14 @@ func (d *dialectImpl) IdentifierQuoteChar() byte {
 func (d *dialectImpl) ArgumentPlaceholder() QueryPlaceholderFunc {
 	return d.argumentPlaceholder
 }
+
+func (d *dialectImpl) ArgumentToString(value interface{}) (string, bool) {
+	if d.argumentToString == nil {
+		return "", false
+	}
+	return d.argumentToString(value)
+}
+
+func (d *dialectImpl) Supports(capability Capability) bool {
+	return d.capabilities[capability]
+}
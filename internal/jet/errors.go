@@ -0,0 +1,125 @@
+package jet
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"unicode"
+)
+
+// SerializeError is returned when statement can not be serialized, because of invalid statement or invalid arguments
+type SerializeError struct {
+	// Clause is SQL clause that failed to serialize, for instance WHERE
+	Clause string
+	// Message describes the error
+	Message string
+
+	recovered interface{}
+}
+
+func (e *SerializeError) Error() string {
+	if e.Clause == "" {
+		return e.Message
+	}
+
+	return e.Message + ", in " + e.Clause + " clause"
+}
+
+func newSerializeError(clause Clause, recovered interface{}) *SerializeError {
+	return &SerializeError{
+		Clause:    clauseName(clause),
+		Message:   fmt.Sprintf("%v", recovered),
+		recovered: recovered,
+	}
+}
+
+// isMisusePanic returns true if recovered panic value is raised by jet because of invalid statement or invalid
+// arguments. jet raises misuse panics with string or error values. Runtime errors, like nil pointer dereference,
+// are bugs, and they are not converted to SerializeError.
+func isMisusePanic(recovered interface{}) bool {
+	switch recovered.(type) {
+	case runtime.Error:
+		return false
+	case string, error:
+		return true
+	}
+
+	return false
+}
+
+// catchMisusePanic is used in defer to return misuse panic as err. Runtime errors are bugs, and they are raised again.
+func catchMisusePanic(err *error) {
+	recovered := recover()
+
+	if recovered == nil {
+		return
+	}
+
+	if !isMisusePanic(recovered) {
+		panic(recovered)
+	}
+
+	if recoveredErr, isError := recovered.(error); isError {
+		*err = recoveredErr
+	} else {
+		*err = fmt.Errorf("%v", recovered)
+	}
+}
+
+var strictMode int32
+
+// SetStrictMode turns on or off strict mode. By default, statement Query, Exec, Prepare and Compile return invalid
+// statement and destination mapping errors. In strict mode these errors are raised as panics instead.
+// Strict mode can be changed while statements are executed, and the change applies to subsequent calls.
+func SetStrictMode(strict bool) {
+	var value int32
+
+	if strict {
+		value = 1
+	}
+
+	atomic.StoreInt32(&strictMode, value)
+}
+
+func isStrictMode() bool {
+	return atomic.LoadInt32(&strictMode) == 1
+}
+
+// clauseName returns SQL name of the clause from clause type name, for instance ClauseGroupBy becomes GROUP BY
+func clauseName(clause Clause) string {
+	clauseType := reflect.TypeOf(clause)
+
+	if clauseType.Kind() == reflect.Ptr {
+		clauseType = clauseType.Elem()
+	}
+
+	name := strings.TrimPrefix(strings.TrimPrefix(clauseType.Name(), "Clause"), "clause")
+
+	if name == "ValuesQuery" {
+		return "VALUES"
+	}
+
+	var words []string
+	wordStart := 0
+
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			words = append(words, name[wordStart:i])
+			wordStart = i
+		}
+	}
+	words = append(words, name[wordStart:])
+
+	return strings.ToUpper(strings.Join(words, " "))
+}
+
+// invalidSerializer defers panic of invalid builder argument until statement serialization
+type invalidSerializer struct {
+	recovered interface{}
+}
+
+func (i invalidSerializer) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	panic(i.recovered)
+}
@@ -0,0 +1,41 @@
+package jet
+
+import (
+	"gotest.tools/assert"
+	"sync"
+	"testing"
+)
+
+type validStatement struct{}
+
+func (validStatement) Sql(options ...FormatOption) (query string, args []interface{}) {
+	return "SELECT 1;", nil
+}
+
+func (validStatement) SqlErr(options ...FormatOption) (query string, args []interface{}, err error) {
+	return "SELECT 1;", nil, nil
+}
+
+func TestSetStrictModeConcurrently(t *testing.T) {
+	defer SetStrictMode(false)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func(strict bool) {
+			defer wg.Done()
+			SetStrictMode(strict)
+		}(i%2 == 0)
+
+		go func() {
+			defer wg.Done()
+			query, _, err := statementSql(validStatement{})
+			assert.NilError(t, err)
+			assert.Equal(t, query, "SELECT 1;")
+		}()
+	}
+
+	wg.Wait()
+}
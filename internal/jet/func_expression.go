@@ -14,7 +14,7 @@ func ABSf(floatExpression FloatExpression) FloatExpression {
 
 // ABSi calculates absolute value from int expression
 func ABSi(integerExpression IntegerExpression) IntegerExpression {
-	return newIntegerFunc("ABS", integerExpression)
+	return NewIntegerFunc("ABS", integerExpression)
 }
 
 // POW calculates power of base with exponent
@@ -245,133 +245,133 @@ func leadLagImpl(name string, expr Expression, offsetAndDefault ...interface{})
 
 // BIT_LENGTH returns number of bits in string expression
 func BIT_LENGTH(stringExpression StringExpression) IntegerExpression {
-	return newIntegerFunc("BIT_LENGTH", stringExpression)
+	return NewIntegerFunc("BIT_LENGTH", stringExpression)
 }
 
 // CHAR_LENGTH returns number of characters in string expression
 func CHAR_LENGTH(stringExpression StringExpression) IntegerExpression {
-	return newIntegerFunc("CHAR_LENGTH", stringExpression)
+	return NewIntegerFunc("CHAR_LENGTH", stringExpression)
 }
 
 // OCTET_LENGTH returns number of bytes in string expression
 func OCTET_LENGTH(stringExpression StringExpression) IntegerExpression {
-	return newIntegerFunc("OCTET_LENGTH", stringExpression)
+	return NewIntegerFunc("OCTET_LENGTH", stringExpression)
 }
 
 // LOWER returns string expression in lower case
 func LOWER(stringExpression StringExpression) StringExpression {
-	return newStringFunc("LOWER", stringExpression)
+	return NewStringFunc("LOWER", stringExpression)
 }
 
 // UPPER returns string expression in upper case
 func UPPER(stringExpression StringExpression) StringExpression {
-	return newStringFunc("UPPER", stringExpression)
+	return NewStringFunc("UPPER", stringExpression)
 }
 
 // BTRIM removes the longest string consisting only of characters
 // in characters (a space by default) from the start and end of string
 func BTRIM(stringExpression StringExpression, trimChars ...StringExpression) StringExpression {
 	if len(trimChars) > 0 {
-		return newStringFunc("BTRIM", stringExpression, trimChars[0])
+		return NewStringFunc("BTRIM", stringExpression, trimChars[0])
 	}
-	return newStringFunc("BTRIM", stringExpression)
+	return NewStringFunc("BTRIM", stringExpression)
 }
 
 // LTRIM removes the longest string containing only characters
 // from characters (a space by default) from the start of string
 func LTRIM(str StringExpression, trimChars ...StringExpression) StringExpression {
 	if len(trimChars) > 0 {
-		return newStringFunc("LTRIM", str, trimChars[0])
+		return NewStringFunc("LTRIM", str, trimChars[0])
 	}
-	return newStringFunc("LTRIM", str)
+	return NewStringFunc("LTRIM", str)
 }
 
 // RTRIM removes the longest string containing only characters
 // from characters (a space by default) from the end of string
 func RTRIM(str StringExpression, trimChars ...StringExpression) StringExpression {
 	if len(trimChars) > 0 {
-		return newStringFunc("RTRIM", str, trimChars[0])
+		return NewStringFunc("RTRIM", str, trimChars[0])
 	}
-	return newStringFunc("RTRIM", str)
+	return NewStringFunc("RTRIM", str)
 }
 
 // CHR returns character with the given code.
 func CHR(integerExpression IntegerExpression) StringExpression {
-	return newStringFunc("CHR", integerExpression)
+	return NewStringFunc("CHR", integerExpression)
 }
 
 // CONCAT adds two or more expressions together
 func CONCAT(expressions ...Expression) StringExpression {
-	return newStringFunc("CONCAT", expressions...)
+	return NewStringFunc("CONCAT", expressions...)
 }
 
 // CONCAT_WS adds two or more expressions together with a separator.
 func CONCAT_WS(separator Expression, expressions ...Expression) StringExpression {
-	return newStringFunc("CONCAT_WS", append([]Expression{separator}, expressions...)...)
+	return NewStringFunc("CONCAT_WS", append([]Expression{separator}, expressions...)...)
 }
 
 // CONVERT converts string to dest_encoding. The original encoding is
 // specified by src_encoding. The string must be valid in this encoding.
 func CONVERT(str StringExpression, srcEncoding StringExpression, destEncoding StringExpression) StringExpression {
-	return newStringFunc("CONVERT", str, srcEncoding, destEncoding)
+	return NewStringFunc("CONVERT", str, srcEncoding, destEncoding)
 }
 
 // CONVERT_FROM converts string to the database encoding. The original
 // encoding is specified by src_encoding. The string must be valid in this encoding.
 func CONVERT_FROM(str StringExpression, srcEncoding StringExpression) StringExpression {
-	return newStringFunc("CONVERT_FROM", str, srcEncoding)
+	return NewStringFunc("CONVERT_FROM", str, srcEncoding)
 }
 
 // CONVERT_TO converts string to dest_encoding.
 func CONVERT_TO(str StringExpression, toEncoding StringExpression) StringExpression {
-	return newStringFunc("CONVERT_TO", str, toEncoding)
+	return NewStringFunc("CONVERT_TO", str, toEncoding)
 }
 
 // ENCODE encodes binary data into a textual representation.
 // Supported formats are: base64, hex, escape. escape converts zero bytes and
 // high-bit-set bytes to octal sequences (\nnn) and doubles backslashes.
 func ENCODE(data StringExpression, format StringExpression) StringExpression {
-	return newStringFunc("ENCODE", data, format)
+	return NewStringFunc("ENCODE", data, format)
 }
 
 // DECODE decodes binary data from textual representation in string.
 // Options for format are same as in encode.
 func DECODE(data StringExpression, format StringExpression) StringExpression {
-	return newStringFunc("DECODE", data, format)
+	return NewStringFunc("DECODE", data, format)
 }
 
 // FORMAT formats a number to a format like "#,###,###.##", rounded to a specified number of decimal places, then it returns the result as a string.
 func FORMAT(formatStr StringExpression, formatArgs ...Expression) StringExpression {
 	args := []Expression{formatStr}
 	args = append(args, formatArgs...)
-	return newStringFunc("FORMAT", args...)
+	return NewStringFunc("FORMAT", args...)
 }
 
 // INITCAP converts the first letter of each word to upper case
 // and the rest to lower case. Words are sequences of alphanumeric
 // characters separated by non-alphanumeric characters.
 func INITCAP(str StringExpression) StringExpression {
-	return newStringFunc("INITCAP", str)
+	return NewStringFunc("INITCAP", str)
 }
 
 // LEFT returns first n characters in the string.
 // When n is negative, return all but last |n| characters.
 func LEFT(str StringExpression, n IntegerExpression) StringExpression {
-	return newStringFunc("LEFT", str, n)
+	return NewStringFunc("LEFT", str, n)
 }
 
 // RIGHT returns last n characters in the string.
 // When n is negative, return all but first |n| characters.
 func RIGHT(str StringExpression, n IntegerExpression) StringExpression {
-	return newStringFunc("RIGHT", str, n)
+	return NewStringFunc("RIGHT", str, n)
 }
 
 // LENGTH returns number of characters in string with a given encoding
 func LENGTH(str StringExpression, encoding ...StringExpression) StringExpression {
 	if len(encoding) > 0 {
-		return newStringFunc("LENGTH", str, encoding[0])
+		return NewStringFunc("LENGTH", str, encoding[0])
 	}
-	return newStringFunc("LENGTH", str)
+	return NewStringFunc("LENGTH", str)
 }
 
 // LPAD fills up the string to length length by prepending the characters
@@ -379,67 +379,67 @@ func LENGTH(str StringExpression, encoding ...StringExpression) StringExpression
 // then it is truncated (on the right).
 func LPAD(str StringExpression, length IntegerExpression, text ...StringExpression) StringExpression {
 	if len(text) > 0 {
-		return newStringFunc("LPAD", str, length, text[0])
+		return NewStringFunc("LPAD", str, length, text[0])
 	}
 
-	return newStringFunc("LPAD", str, length)
+	return NewStringFunc("LPAD", str, length)
 }
 
 // RPAD fills up the string to length length by appending the characters
 // fill (a space by default). If the string is already longer than length then it is truncated.
 func RPAD(str StringExpression, length IntegerExpression, text ...StringExpression) StringExpression {
 	if len(text) > 0 {
-		return newStringFunc("RPAD", str, length, text[0])
+		return NewStringFunc("RPAD", str, length, text[0])
 	}
 
-	return newStringFunc("RPAD", str, length)
+	return NewStringFunc("RPAD", str, length)
 }
 
 // MD5 calculates the MD5 hash of string, returning the result in hexadecimal
 func MD5(stringExpression StringExpression) StringExpression {
-	return newStringFunc("MD5", stringExpression)
+	return NewStringFunc("MD5", stringExpression)
 }
 
 // REPEAT repeats string the specified number of times
 func REPEAT(str StringExpression, n IntegerExpression) StringExpression {
-	return newStringFunc("REPEAT", str, n)
+	return NewStringFunc("REPEAT", str, n)
 }
 
 // REPLACE replaces all occurrences in string of substring from with substring to
 func REPLACE(text, from, to StringExpression) StringExpression {
-	return newStringFunc("REPLACE", text, from, to)
+	return NewStringFunc("REPLACE", text, from, to)
 }
 
 // REVERSE returns reversed string.
 func REVERSE(stringExpression StringExpression) StringExpression {
-	return newStringFunc("REVERSE", stringExpression)
+	return NewStringFunc("REVERSE", stringExpression)
 }
 
 // STRPOS returns location of specified substring (same as position(substring in string),
 // but note the reversed argument order)
 func STRPOS(str, substring StringExpression) IntegerExpression {
-	return newIntegerFunc("STRPOS", str, substring)
+	return NewIntegerFunc("STRPOS", str, substring)
 }
 
 // SUBSTR extracts substring
 func SUBSTR(str StringExpression, from IntegerExpression, count ...IntegerExpression) StringExpression {
 	if len(count) > 0 {
-		return newStringFunc("SUBSTR", str, from, count[0])
+		return NewStringFunc("SUBSTR", str, from, count[0])
 	}
-	return newStringFunc("SUBSTR", str, from)
+	return NewStringFunc("SUBSTR", str, from)
 }
 
 // TO_ASCII convert string to ASCII from another encoding
 func TO_ASCII(str StringExpression, encoding ...StringExpression) StringExpression {
 	if len(encoding) > 0 {
-		return newStringFunc("TO_ASCII", str, encoding[0])
+		return NewStringFunc("TO_ASCII", str, encoding[0])
 	}
-	return newStringFunc("TO_ASCII", str)
+	return NewStringFunc("TO_ASCII", str)
 }
 
 // TO_HEX converts number to its equivalent hexadecimal representation
 func TO_HEX(number IntegerExpression) StringExpression {
-	return newStringFunc("TO_HEX", number)
+	return NewStringFunc("TO_HEX", number)
 }
 
 // REGEXP_LIKE Returns 1 if the string expr matches the regular expression specified by the pattern pat, 0 otherwise.
@@ -455,12 +455,12 @@ func REGEXP_LIKE(stringExp StringExpression, pattern StringExpression, matchType
 
 // TO_CHAR converts expression to string with format
 func TO_CHAR(expression Expression, format StringExpression) StringExpression {
-	return newStringFunc("TO_CHAR", expression, format)
+	return NewStringFunc("TO_CHAR", expression, format)
 }
 
 // TO_DATE converts string to date using format
 func TO_DATE(dateStr, format StringExpression) DateExpression {
-	return newDateFunc("TO_DATE", dateStr, format)
+	return NewDateFunc("TO_DATE", dateStr, format)
 }
 
 // TO_NUMBER converts string to numeric using format
@@ -477,7 +477,7 @@ func TO_TIMESTAMP(timestampzStr, format StringExpression) TimestampzExpression {
 
 // CURRENT_DATE returns current date
 func CURRENT_DATE() DateExpression {
-	dateFunc := newDateFunc("CURRENT_DATE")
+	dateFunc := NewDateFunc("CURRENT_DATE")
 	dateFunc.noBrackets = true
 	return dateFunc
 }
@@ -517,9 +517,9 @@ func LOCALTIME(precision ...int) TimeExpression {
 	var timeFunc *timeFunc
 
 	if len(precision) > 0 {
-		timeFunc = newTimeFunc("LOCALTIME", FixedLiteral(precision[0]))
+		timeFunc = NewTimeFunc("LOCALTIME", FixedLiteral(precision[0]))
 	} else {
-		timeFunc = newTimeFunc("LOCALTIME")
+		timeFunc = NewTimeFunc("LOCALTIME")
 	}
 
 	timeFunc.noBrackets = true
@@ -547,6 +547,23 @@ func NOW() TimestampzExpression {
 	return newTimestampzFunc("NOW")
 }
 
+// --------------- Sequence Manipulation Functions -------------//
+
+// NEXTVAL advances sequence and returns new value
+func NEXTVAL(sequenceName StringExpression) IntegerExpression {
+	return NewIntegerFunc("NEXTVAL", sequenceName)
+}
+
+// CURRVAL returns value most recently obtained with NEXTVAL for sequence
+func CURRVAL(sequenceName StringExpression) IntegerExpression {
+	return NewIntegerFunc("CURRVAL", sequenceName)
+}
+
+// SETVAL sets sequence current value and returns it
+func SETVAL(sequenceName StringExpression, value IntegerExpression) IntegerExpression {
+	return NewIntegerFunc("SETVAL", sequenceName, value)
+}
+
 // --------------- Conditional Expressions Functions -------------//
 
 // COALESCE function returns the first of its arguments that is not null.
@@ -561,6 +578,11 @@ func NULLIF(value1, value2 Expression) Expression {
 	return newFunc("NULLIF", []Expression{value1, value2}, nil)
 }
 
+// IFNULL function returns value1 if it is not null; otherwise it returns value2.
+func IFNULL(value1, value2 Expression) Expression {
+	return newFunc("IFNULL", []Expression{value1, value2}, nil)
+}
+
 // GREATEST selects the largest  value from a list of expressions
 func GREATEST(value Expression, values ...Expression) Expression {
 	var allValues = []Expression{value}
@@ -691,7 +713,8 @@ type integerFunc struct {
 	integerInterfaceImpl
 }
 
-func newIntegerFunc(name string, expressions ...Expression) IntegerExpression {
+// NewIntegerFunc creates new integer function with name and expressions
+func NewIntegerFunc(name string, expressions ...Expression) IntegerExpression {
 	floatFunc := &integerFunc{}
 
 	floatFunc.funcExpressionImpl = *newFunc(name, expressions, floatFunc)
@@ -717,7 +740,8 @@ type stringFunc struct {
 	stringInterfaceImpl
 }
 
-func newStringFunc(name string, expressions ...Expression) StringExpression {
+// NewStringFunc creates new string function with name and expressions
+func NewStringFunc(name string, expressions ...Expression) StringExpression {
 	stringFunc := &stringFunc{}
 
 	stringFunc.funcExpressionImpl = *newFunc(name, expressions, stringFunc)
@@ -731,7 +755,8 @@ type dateFunc struct {
 	dateInterfaceImpl
 }
 
-func newDateFunc(name string, expressions ...Expression) *dateFunc {
+// NewDateFunc creates new date function with name and expressions
+func NewDateFunc(name string, expressions ...Expression) *dateFunc {
 	dateFunc := &dateFunc{}
 
 	dateFunc.funcExpressionImpl = *newFunc(name, expressions, dateFunc)
@@ -745,7 +770,8 @@ type timeFunc struct {
 	timeInterfaceImpl
 }
 
-func newTimeFunc(name string, expressions ...Expression) *timeFunc {
+// NewTimeFunc creates new time function with name and expressions
+func NewTimeFunc(name string, expressions ...Expression) *timeFunc {
 	timeFun := &timeFunc{}
 
 	timeFun.funcExpressionImpl = *newFunc(name, expressions, timeFun)
@@ -162,6 +162,11 @@ func TestFuncNULLIF(t *testing.T) {
 	assertClauseSerialize(t, NULLIF(Float(11.2222), NULL), "NULLIF($1, NULL)", float64(11.2222))
 }
 
+func TestFuncIFNULL(t *testing.T) {
+	assertClauseSerialize(t, IFNULL(table1ColFloat, table2ColInt), "IFNULL(table1.col_float, table2.col_int)")
+	assertClauseSerialize(t, IFNULL(table1ColFloat, Float(11.2222)), "IFNULL(table1.col_float, $1)", float64(11.2222))
+}
+
 func TestFuncGREATEST(t *testing.T) {
 	assertClauseSerialize(t, GREATEST(table1ColFloat), "GREATEST(table1.col_float)")
 	assertClauseSerialize(t, GREATEST(Float(11.2222), NULL, String("str")), "GREATEST($1, NULL, $2)", float64(11.2222), "str")
@@ -176,3 +181,9 @@ func TestTO_ASCII(t *testing.T) {
 	assertClauseSerialize(t, TO_ASCII(String("Karel")), `TO_ASCII($1)`, "Karel")
 	assertClauseSerialize(t, TO_ASCII(String("Karel")), `TO_ASCII($1)`, "Karel")
 }
+
+func TestFuncSequence(t *testing.T) {
+	assertClauseSerialize(t, NEXTVAL(String("db.seq")), "NEXTVAL($1)", "db.seq")
+	assertClauseSerialize(t, CURRVAL(String("db.seq")), "CURRVAL($1)", "db.seq")
+	assertClauseSerialize(t, SETVAL(String("db.seq"), Int(11)), "SETVAL($1, $2)", "db.seq", int64(11))
+}
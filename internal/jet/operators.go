@@ -1,5 +1,7 @@
 package jet
 
+import "github.com/go-jet/jet/internal/utils"
+
 // Operators
 const (
 	StringConcatOperator        = "||"
@@ -14,6 +16,58 @@ func NOT(exp BoolExpression) BoolExpression {
 	return newPrefixBoolOperator(exp, "NOT")
 }
 
+// AND joins conditions with AND operator. Nil conditions are skipped. If all conditions are nil, AND returns nil,
+// and nil condition in WHERE clause is not serialized.
+func AND(conditions ...BoolExpression) BoolExpression {
+	return joinConditions("AND", conditions)
+}
+
+// OR joins conditions with OR operator. Nil conditions are skipped. If all conditions are nil, OR returns nil.
+func OR(conditions ...BoolExpression) BoolExpression {
+	return joinConditions("OR", conditions)
+}
+
+func joinConditions(operator string, conditions []BoolExpression) BoolExpression {
+	var ret BoolExpression
+
+	for _, condition := range conditions {
+		if utils.IsNil(condition) {
+			continue
+		}
+
+		if ret == nil {
+			ret = condition
+		} else {
+			ret = newBinaryBoolOperator(ret, condition, operator)
+		}
+	}
+
+	return ret
+}
+
+// Conditional returns condition if include is true, otherwise nil. Used to add optional condition to AND and OR.
+// Condition is always evaluated, even if include is false, so it can not dereference values checked by include.
+// Use ConditionalFunc for such conditions:
+//
+//	ConditionalFunc(name != nil, func() BoolExpression { return Actor.FirstName.EQ(String(*name)) })
+func Conditional(include bool, condition BoolExpression) BoolExpression {
+	if !include {
+		return nil
+	}
+
+	return condition
+}
+
+// ConditionalFunc returns condition created by conditionFunc if include is true, otherwise nil.
+// Unlike Conditional, conditionFunc is called only if include is true.
+func ConditionalFunc(include bool, conditionFunc func() BoolExpression) BoolExpression {
+	if !include {
+		return nil
+	}
+
+	return conditionFunc()
+}
+
 // BIT_NOT inverts every bit in integer expression result
 func BIT_NOT(expr IntegerExpression) IntegerExpression {
 	if literalExp, ok := expr.(LiteralExpression); ok {
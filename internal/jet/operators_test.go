@@ -1,6 +1,9 @@
 package jet
 
-import "testing"
+import (
+	"gotest.tools/assert"
+	"testing"
+)
 
 func TestOperatorNOT(t *testing.T) {
 	notExpression := NOT(Int(2).EQ(Int(1)))
@@ -11,6 +14,40 @@ func TestOperatorNOT(t *testing.T) {
 	assertClauseSerialize(t, notExpression.AND(Int(4).EQ(Int(5))), `((NOT ($1 = $2)) AND ($3 = $4))`, int64(2), int64(1), int64(4), int64(5))
 }
 
+func TestOperatorAND_OR(t *testing.T) {
+	assert.Assert(t, AND() == nil)
+	assert.Assert(t, AND(nil, nil) == nil)
+	assert.Assert(t, OR(nil) == nil)
+
+	assertClauseSerialize(t, AND(nil, table1ColBool), "table1.col_bool")
+	assertClauseSerialize(t, AND(table1ColBool, nil, table1Col1.GT(Int(2)), table3Col1.EQ(Int(1))),
+		"((table1.col_bool AND (table1.col1 > $1)) AND (table3.col1 = $2))", int64(2), int64(1))
+	assertClauseSerialize(t, OR(table1ColBool, nil, table1Col1.GT(Int(2))), "(table1.col_bool OR (table1.col1 > $1))", int64(2))
+	assertClauseSerialize(t, AND(table1ColBool, OR(nil, nil)), "table1.col_bool")
+}
+
+func TestConditional(t *testing.T) {
+	assert.Assert(t, Conditional(false, table1ColBool) == nil)
+	assertClauseSerialize(t, Conditional(true, table1ColBool), "table1.col_bool")
+	assertClauseSerialize(t, AND(Conditional(false, table1ColBool), Conditional(true, table1Col1.GT(Int(2)))),
+		"(table1.col1 > $1)", int64(2))
+}
+
+func TestConditionalFunc(t *testing.T) {
+	var minValue *int64
+
+	assert.Assert(t, ConditionalFunc(minValue != nil, func() BoolExpression {
+		return table1Col1.GT(Int(*minValue))
+	}) == nil)
+
+	minValue = new(int64)
+	*minValue = 2
+
+	assertClauseSerialize(t, AND(table1ColBool, ConditionalFunc(minValue != nil, func() BoolExpression {
+		return table1Col1.GT(Int(*minValue))
+	})), "(table1.col_bool AND (table1.col1 > $1))", int64(2))
+}
+
 func TestCase1(t *testing.T) {
 	query := CASE().
 		WHEN(table3Col1.EQ(Int(1))).THEN(table3Col1.ADD(Int(1))).
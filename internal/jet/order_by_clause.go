@@ -27,3 +27,13 @@ func (o *orderByClauseImpl) serializeForOrderBy(statement StatementType, out *SQ
 func newOrderByClause(expression Expression, ascent bool) OrderByClause {
 	return &orderByClauseImpl{expression: expression, ascent: ascent}
 }
+
+// ConditionalOrderBy returns orderBy if include is true, otherwise nil. Nil clauses are skipped in ORDER BY
+// clause of a statement, so ordering can be added conditionally.
+func ConditionalOrderBy(include bool, orderBy OrderByClause) OrderByClause {
+	if !include {
+		return nil
+	}
+
+	return orderBy
+}
@@ -0,0 +1,157 @@
+package jet
+
+import (
+	"database/sql/driver"
+	"errors"
+	"github.com/go-jet/jet/internal/utils"
+	"reflect"
+)
+
+// NamedArgument is statement argument placeholder, whose value is bound by name when statement is executed
+type NamedArgument struct {
+	Name string
+}
+
+// Value returns error, because named argument has to be bound before statement execution
+func (n NamedArgument) Value() (driver.Value, error) {
+	return nil, errors.New("jet: parameter " + n.Name + " is not bound, use prepared statement to execute statement with named parameters")
+}
+
+type paramExpressionImpl struct {
+	expressionInterfaceImpl
+
+	name string
+}
+
+func newParam(name string) *paramExpressionImpl {
+	if name == "" {
+		panic("jet: parameter name is empty")
+	}
+
+	param := &paramExpressionImpl{name: name}
+	param.expressionInterfaceImpl.Parent = param
+
+	return param
+}
+
+func (p *paramExpressionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if out.debug && !out.normalized {
+		if !isPreSeparator(out.lastChar) {
+			out.WriteByte(' ') // ':' does not separate tokens itself
+		}
+		out.WriteString(":" + p.name)
+		return
+	}
+
+	out.insertParametrizedArgument(NamedArgument{Name: p.name})
+}
+
+// BoolParam creates named bool parameter
+func BoolParam(name string) BoolExpression {
+	return BoolExp(newParam(name))
+}
+
+// IntParam creates named integer parameter
+func IntParam(name string) IntegerExpression {
+	return IntExp(newParam(name))
+}
+
+// FloatParam creates named float parameter
+func FloatParam(name string) FloatExpression {
+	return FloatExp(newParam(name))
+}
+
+// StringParam creates named string parameter
+func StringParam(name string) StringExpression {
+	return StringExp(newParam(name))
+}
+
+// DateParam creates named date parameter
+func DateParam(name string) DateExpression {
+	return DateExp(newParam(name))
+}
+
+// TimeParam creates named time parameter
+func TimeParam(name string) TimeExpression {
+	return TimeExp(newParam(name))
+}
+
+// TimezParam creates named time with time zone parameter
+func TimezParam(name string) TimezExpression {
+	return TimezExp(newParam(name))
+}
+
+// TimestampParam creates named timestamp parameter
+func TimestampParam(name string) TimestampExpression {
+	return TimestampExp(newParam(name))
+}
+
+// TimestampzParam creates named timestamp with time zone parameter
+func TimestampzParam(name string) TimestampzExpression {
+	return TimestampzExp(newParam(name))
+}
+
+// BindArgs replaces every NamedArgument in args with parameter value from params. Params can be
+// map[string]interface{}, or struct (or pointer to struct) whose field name or alias tag matches parameter name.
+func BindArgs(args []interface{}, params interface{}) (boundArgs []interface{}, err error) {
+	defer utils.ErrorCatch(&err)
+
+	paramValue := paramValueFunc(params)
+
+	boundArgs = make([]interface{}, len(args))
+
+	for i, arg := range args {
+		namedArg, ok := arg.(NamedArgument)
+
+		if !ok {
+			boundArgs[i] = arg
+			continue
+		}
+
+		value, found := paramValue(namedArg.Name)
+
+		if !found {
+			return nil, errors.New("jet: missing value for parameter " + namedArg.Name)
+		}
+
+		boundArgs[i] = value
+	}
+
+	return boundArgs, nil
+}
+
+func paramValueFunc(params interface{}) func(name string) (interface{}, bool) {
+	if utils.IsNil(params) {
+		return func(name string) (interface{}, bool) {
+			return nil, false
+		}
+	}
+
+	if paramsMap, ok := params.(map[string]interface{}); ok {
+		return func(name string) (interface{}, bool) {
+			value, found := paramsMap[name]
+			return value, found
+		}
+	}
+
+	structValue := reflect.Indirect(reflect.ValueOf(params))
+	utils.ValueMustBe(structValue, reflect.Struct, "jet: parameters have to be map[string]interface{} or struct")
+
+	return func(name string) (interface{}, bool) {
+		structType := structValue.Type()
+
+		for i := 0; i < structType.NumField(); i++ {
+			field := structType.Field(i)
+
+			if field.PkgPath != "" {
+				continue
+			}
+
+			if field.Tag.Get("alias") == name || field.Name == name || field.Name == utils.ToGoIdentifier(name) {
+				return structValue.Field(i).Interface(), true
+			}
+		}
+
+		return nil, false
+	}
+}
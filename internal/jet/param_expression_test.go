@@ -0,0 +1,48 @@
+package jet
+
+import (
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestParam(t *testing.T) {
+	assertClauseSerialize(t, table1ColInt.GT(IntParam("min")), "(table1.col_int > $1)", NamedArgument{Name: "min"})
+	assertClauseSerialize(t, table1ColInt.ADD(IntParam("min")).LT(Int(11)), "((table1.col_int + $1) < $2)",
+		NamedArgument{Name: "min"}, int64(11))
+	assertClauseDebugSerialize(t, table1ColBool.EQ(BoolParam("flag")), "(table1.col_bool = :flag)")
+}
+
+func TestParamNormalized(t *testing.T) {
+	out := newSQLBuilder(defaultDialect, true, []FormatOption{Normalized})
+	table1ColInt.ADD(IntParam("min")).GT(Int(2)).serialize(SelectStatementType, out)
+
+	assert.Equal(t, out.Buff.String(), "((table1.col_int + ?) > ?)")
+}
+
+func TestBindArgs(t *testing.T) {
+	args := []interface{}{NamedArgument{Name: "min_len"}, int64(11), NamedArgument{Name: "title"}}
+
+	boundArgs, err := BindArgs(args, map[string]interface{}{"min_len": 20, "title": "Alien"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, boundArgs, []interface{}{20, int64(11), "Alien"})
+
+	type params struct {
+		MinLen int64
+		Name   string `alias:"title"`
+	}
+
+	boundArgs, err = BindArgs(args, &params{MinLen: 30, Name: "Matrix"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, boundArgs, []interface{}{int64(30), int64(11), "Matrix"})
+
+	_, err = BindArgs(args, map[string]interface{}{"min_len": 20})
+	assert.Error(t, err, "jet: missing value for parameter title")
+
+	_, err = BindArgs(args, 12)
+	assert.Error(t, err, "jet: parameters have to be map[string]interface{} or struct")
+}
+
+func TestNamedArgumentValue(t *testing.T) {
+	_, err := NamedArgument{Name: "min_len"}.Value()
+	assert.Error(t, err, "jet: parameter min_len is not bound, use prepared statement to execute statement with named parameters")
+}
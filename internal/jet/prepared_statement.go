@@ -0,0 +1,144 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"github.com/go-jet/jet/qrm"
+)
+
+// PreparedStatement is statement prepared on database connection, that can be executed many times with different
+// named parameter values. Params can be map[string]interface{}, or struct whose field name or alias tag matches
+// parameter name.
+type PreparedStatement interface {
+	// Sql returns prepared sql query with list of arguments, where named parameters are NamedArgument placeholders.
+	Sql() (query string, args []interface{})
+
+	// Query executes prepared statement with params and stores row result in destination.
+	Query(params interface{}, destination interface{}) error
+	// QueryContext executes prepared statement with a context and params and stores row result in destination.
+	QueryContext(context context.Context, params interface{}, destination interface{}) error
+
+	// Exec executes prepared statement with params without returning any rows.
+	Exec(params interface{}) (sql.Result, error)
+	// ExecContext executes prepared statement with a context and params without returning any rows.
+	ExecContext(context context.Context, params interface{}) (sql.Result, error)
+
+	// Close closes database prepared statement. Statements prepared with StatementCache are owned by the cache,
+	// and are closed when evicted from the cache or when the cache is closed.
+	Close() error
+}
+
+func newPreparedStatement(ctx context.Context, db qrm.Preparer, statement Statement) (PreparedStatement, error) {
+	query, args, err := statementSql(statement)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cache, ok := db.(*StatementCache); ok {
+		return &preparedStatementImpl{cache: cache, query: query, args: args}, nil
+	}
+
+	stmt, err := db.PrepareContext(ctx, query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &preparedStatementImpl{
+		stmt:  stmt,
+		query: query,
+		args:  args,
+	}, nil
+}
+
+type preparedStatementImpl struct {
+	stmt  *sql.Stmt
+	cache *StatementCache // set instead of stmt, if statement is prepared with cache
+	query string
+	args  []interface{}
+}
+
+func (p *preparedStatementImpl) Sql() (query string, args []interface{}) {
+	return p.query, p.args
+}
+
+func (p *preparedStatementImpl) Query(params interface{}, destination interface{}) error {
+	return p.QueryContext(context.Background(), params, destination)
+}
+
+func (p *preparedStatementImpl) QueryContext(context context.Context, params interface{}, destination interface{}) error {
+	args, err := BindArgs(p.args, params)
+
+	if err != nil {
+		return err
+	}
+
+	stmt, release, err := p.acquireStmt(context)
+
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return runQuery(context, stmtDB{stmt}, p.query, args, destination)
+}
+
+func (p *preparedStatementImpl) Exec(params interface{}) (sql.Result, error) {
+	return p.ExecContext(context.Background(), params)
+}
+
+func (p *preparedStatementImpl) ExecContext(context context.Context, params interface{}) (sql.Result, error) {
+	args, err := BindArgs(p.args, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, release, err := p.acquireStmt(context)
+
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return stmt.ExecContext(context, args...)
+}
+
+func (p *preparedStatementImpl) Close() error {
+	if p.cache != nil {
+		return nil
+	}
+
+	return p.stmt.Close()
+}
+
+// acquireStmt returns database prepared statement, and function to release it after execution
+func (p *preparedStatementImpl) acquireStmt(ctx context.Context) (*sql.Stmt, func(), error) {
+	if p.cache != nil {
+		return p.cache.acquire(ctx, p.query)
+	}
+
+	return p.stmt, func() {}, nil
+}
+
+// stmtDB executes prepared statement, regardless of query passed
+type stmtDB struct {
+	stmt *sql.Stmt
+}
+
+func (s stmtDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return s.stmt.Exec(args...)
+}
+
+func (s stmtDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return s.stmt.ExecContext(ctx, args...)
+}
+
+func (s stmtDB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return s.stmt.Query(args...)
+}
+
+func (s stmtDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return s.stmt.QueryContext(ctx, args...)
+}
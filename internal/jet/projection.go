@@ -1,5 +1,7 @@
 package jet
 
+import "github.com/go-jet/jet/qrm"
+
 // Projection is interface for all projection types. Types that can be part of, for instance SELECT clause.
 type Projection interface {
 	serializeForProjection(statement StatementType, out *SQLBuilder)
@@ -27,3 +29,30 @@ func (cl ProjectionList) fromImpl(subQuery SelectTable) Projection {
 func (cl ProjectionList) serializeForProjection(statement StatementType, out *SQLBuilder) {
 	SerializeProjectionList(statement, cl, out)
 }
+
+// ExplainMapping explains how result of statement projections would be mapped onto destination, without running
+// the statement. Projections without alias are listed with empty column name.
+func ExplainMapping(statement HasProjections, destination interface{}) qrm.Mapping {
+	return qrm.ExplainMapping(projectionAliases(statement.projections()), destination)
+}
+
+func projectionAliases(projections ProjectionList) []string {
+	var aliases []string
+
+	for _, projection := range projections {
+		switch p := projection.(type) {
+		case *alias:
+			aliases = append(aliases, p.alias)
+		case ColumnList:
+			aliases = append(aliases, projectionAliases(ColumnListToProjectionList(p))...)
+		case ProjectionList:
+			aliases = append(aliases, projectionAliases(p)...)
+		case Column:
+			aliases = append(aliases, p.defaultAlias())
+		default:
+			aliases = append(aliases, "")
+		}
+	}
+
+	return aliases
+}
@@ -10,6 +10,7 @@ type SelectTable interface {
 type selectTableImpl struct {
 	selectStmt StatementWithProjections
 	alias      string
+	lateral    bool
 
 	projections ProjectionList
 }
@@ -24,6 +25,14 @@ func NewSelectTable(selectStmt StatementWithProjections, alias string) SelectTab
 	return &selectTable
 }
 
+// NewLateralSelectTable creates select table that can reference columns of preceding FROM items
+func NewLateralSelectTable(selectStmt StatementWithProjections, alias string) SelectTable {
+	selectTable := NewSelectTable(selectStmt, alias).(*selectTableImpl)
+	selectTable.lateral = true
+
+	return selectTable
+}
+
 func (s *selectTableImpl) Alias() string {
 	return s.alias
 }
@@ -37,6 +46,11 @@ func (s *selectTableImpl) serialize(statement StatementType, out *SQLBuilder, op
 		panic("jet: expression table is nil. ")
 	}
 
+	if s.lateral {
+		out.RequireCapability(CapabilityLateral)
+		out.WriteString("LATERAL")
+	}
+
 	s.selectStmt.serialize(statement, out)
 
 	out.WriteString("AS")
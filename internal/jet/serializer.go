@@ -13,13 +13,15 @@ type StatementType string
 
 // Statement types
 const (
-	SelectStatementType StatementType = "SELECT"
-	InsertStatementType StatementType = "INSERT"
-	UpdateStatementType StatementType = "UPDATE"
-	DeleteStatementType StatementType = "DELETE"
-	SetStatementType    StatementType = "SET"
-	LockStatementType   StatementType = "LOCK"
-	UnLockStatementType StatementType = "UNLOCK"
+	SelectStatementType  StatementType = "SELECT"
+	InsertStatementType  StatementType = "INSERT"
+	UpdateStatementType  StatementType = "UPDATE"
+	DeleteStatementType  StatementType = "DELETE"
+	SetStatementType     StatementType = "SET"
+	LockStatementType    StatementType = "LOCK"
+	UnLockStatementType  StatementType = "UNLOCK"
+	RefreshStatementType StatementType = "REFRESH"
+	MergeStatementType   StatementType = "MERGE"
 )
 
 // Serializer interface
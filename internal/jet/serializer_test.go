@@ -1,6 +1,7 @@
 package jet
 
 import (
+	"database/sql"
 	"github.com/google/uuid"
 	"gotest.tools/assert"
 	"testing"
@@ -25,6 +26,20 @@ func TestArgToString(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Equal(t, argToString(time), "'2006-01-02 15:04:05-07:00'")
 
+	type mood string
+	ptrValue := int16(16)
+	var nilPtr *int64
+
+	assert.Equal(t, argToString(int8(-8)), "-8")
+	assert.Equal(t, argToString(uint16(16)), "16")
+	assert.Equal(t, argToString(uint64(64)), "64")
+	assert.Equal(t, argToString(float32(1.1)), "1.1")
+	assert.Equal(t, argToString(mood("happy")), "'happy'")
+	assert.Equal(t, argToString(&ptrValue), "16")
+	assert.Equal(t, argToString(nilPtr), "NULL")
+	assert.Equal(t, argToString(sql.NullString{String: "john", Valid: true}), "'john'")
+	assert.Equal(t, argToString(sql.NullInt64{}), "NULL")
+
 	func() {
 		defer func() {
 			assert.Equal(t, recover().(string), "jet: map[string]bool type can not be used as SQL query parameter")
@@ -2,6 +2,7 @@ package jet
 
 import (
 	"bytes"
+	"database/sql/driver"
 	"fmt"
 	"github.com/go-jet/jet/internal/3rdparty/pq"
 	"github.com/go-jet/jet/internal/utils"
@@ -25,10 +26,47 @@ type SQLBuilder struct {
 	ident    int
 
 	debug bool
+
+	compact      bool
+	pendingSpace bool
+	normalized   bool
+
+	errors []*SerializeError
 }
 
 const defaultIdent = 5
 
+// FormatOption changes format of sql query returned by Sql and DebugSql
+type FormatOption int
+
+// Sql query formats
+const (
+	// Pretty formats query in multiple lines, with nested clauses indented. It is default query format.
+	Pretty FormatOption = iota
+	// Compact formats query in a single line, without new lines and indentation. Useful for logging.
+	Compact
+	// Normalized replaces every literal and argument with ?, so queries that differ only in values have the same
+	// text. Useful for grouping query statistics.
+	Normalized
+)
+
+func newSQLBuilder(dialect Dialect, debug bool, options []FormatOption) *SQLBuilder {
+	builder := &SQLBuilder{Dialect: dialect, debug: debug}
+
+	for _, option := range options {
+		switch option {
+		case Pretty:
+			builder.compact = false
+		case Compact:
+			builder.compact = true
+		case Normalized:
+			builder.normalized = true
+		}
+	}
+
+	return builder
+}
+
 // IncreaseIdent adds ident or defaultIdent number of spaces to each new line
 func (s *SQLBuilder) IncreaseIdent(ident ...int) {
 	if len(ident) > 0 {
@@ -62,6 +100,11 @@ func (s *SQLBuilder) WriteProjections(statement StatementType, projections []Pro
 
 // NewLine adds new line to output SQL
 func (s *SQLBuilder) NewLine() {
+	if s.compact {
+		s.pendingSpace = s.Buff.Len() > 0
+		return
+	}
+
 	s.write([]byte{'\n'})
 	s.write(bytes.Repeat([]byte{' '}, s.ident))
 }
@@ -71,6 +114,16 @@ func (s *SQLBuilder) write(data []byte) {
 		return
 	}
 
+	if s.pendingSpace {
+		s.pendingSpace = false
+
+		// new line is replaced with a single space, except after opening and before closing bracket
+		if s.lastChar != ' ' && s.lastChar != '(' && !isPostSeparator(data[0]) {
+			s.Buff.WriteByte(' ')
+			s.lastChar = ' '
+		}
+	}
+
 	if !isPreSeparator(s.lastChar) && !isPostSeparator(data[0]) && s.Buff.Len() > 0 {
 		s.Buff.WriteByte(' ')
 	}
@@ -89,8 +142,7 @@ func isPostSeparator(b byte) bool {
 
 // WriteAlias is used to add alias to output SQL
 func (s *SQLBuilder) WriteAlias(str string) {
-	aliasQuoteChar := string(s.Dialect.AliasQuoteChar())
-	s.WriteString(aliasQuoteChar + str + aliasQuoteChar)
+	s.WriteString(quoteWith(s.Dialect.AliasQuoteChar(), str))
 }
 
 // WriteString writes sting to output SQL
@@ -101,8 +153,7 @@ func (s *SQLBuilder) WriteString(str string) {
 // WriteIdentifier adds identifier to output SQL
 func (s *SQLBuilder) WriteIdentifier(name string, alwaysQuote ...bool) {
 	if shouldQuoteIdentifier(name) || len(alwaysQuote) > 0 {
-		identQuoteChar := string(s.Dialect.IdentifierQuoteChar())
-		s.WriteString(identQuoteChar + name + identQuoteChar)
+		s.WriteString(quoteWith(s.Dialect.IdentifierQuoteChar(), name))
 	} else {
 		s.WriteString(name)
 	}
@@ -113,12 +164,52 @@ func (s *SQLBuilder) WriteByte(b byte) {
 	s.write([]byte{b})
 }
 
+// RequireCapability panics if builder dialect does not support capability
+func (s *SQLBuilder) RequireCapability(capability Capability) {
+	if !s.Dialect.Supports(capability) {
+		panic("jet: " + string(capability) + " is not supported by " + s.Dialect.Name() + " dialect")
+	}
+}
+
+// serializeClause serializes statement clause. Misuse panic of invalid clause is collected as SerializeError, so the
+// rest of the statement can still be serialized. Runtime errors are not recovered.
+func (s *SQLBuilder) serializeClause(statement StatementType, clause Clause) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			if !isMisusePanic(recovered) {
+				panic(recovered)
+			}
+
+			s.errors = append(s.errors, newSerializeError(clause, recovered))
+		}
+	}()
+
+	clause.Serialize(statement, s)
+}
+
+func (s *SQLBuilder) err() *SerializeError {
+	if len(s.errors) == 0 {
+		return nil
+	}
+
+	return s.errors[0]
+}
+
 func (s *SQLBuilder) finalize() (string, []interface{}) {
+	if s.compact {
+		return s.Buff.String() + ";", s.Args
+	}
+
 	return s.Buff.String() + ";\n", s.Args
 }
 
 func (s *SQLBuilder) insertConstantArgument(arg interface{}) {
-	s.WriteString(argToString(arg))
+	if s.normalized {
+		s.WriteString("?")
+		return
+	}
+
+	s.WriteString(s.argToString(arg))
 }
 
 func (s *SQLBuilder) insertParametrizedArgument(arg interface{}) {
@@ -128,13 +219,34 @@ func (s *SQLBuilder) insertParametrizedArgument(arg interface{}) {
 	}
 
 	s.Args = append(s.Args, arg)
+
+	if s.normalized {
+		s.WriteString("?")
+		return
+	}
+
 	argPlaceholder := s.Dialect.ArgumentPlaceholder()(len(s.Args))
 
 	s.WriteString(argPlaceholder)
 }
 
+// argToString converts argument to SQL literal, using dialect specific literal if there is one
+func (s *SQLBuilder) argToString(value interface{}) string {
+	value = literalValue(value)
+
+	if s.Dialect != nil {
+		if literal, ok := s.Dialect.ArgumentToString(value); ok {
+			return literal
+		}
+	}
+
+	return argToString(value)
+}
+
 func argToString(value interface{}) string {
-	if utils.IsNil(value) {
+	value = literalValue(value)
+
+	if value == nil {
 		return "NULL"
 	}
 
@@ -150,9 +262,11 @@ func argToString(value interface{}) string {
 		return strconv.FormatInt(int64(bindVal), 10)
 	case int64:
 		return strconv.FormatInt(bindVal, 10)
+	case uint64:
+		return strconv.FormatUint(bindVal, 10)
 
 	case float32:
-		return strconv.FormatFloat(float64(bindVal), 'f', -1, 64)
+		return strconv.FormatFloat(float64(bindVal), 'f', -1, 32)
 	case float64:
 		return strconv.FormatFloat(float64(bindVal), 'f', -1, 64)
 
@@ -169,6 +283,56 @@ func argToString(value interface{}) string {
 	}
 }
 
+// literalValue dereferences pointers, reads values of driver.Valuer arguments and converts named types and other
+// numeric widths to basic types, so that value can be written as SQL literal.
+func literalValue(value interface{}) interface{} {
+	for {
+		if utils.IsNil(value) {
+			return nil
+		}
+
+		switch bindVal := value.(type) {
+		case bool, int, int32, int64, uint64, float32, float64, string, []byte, uuid.UUID, time.Time:
+			return value
+		case driver.Valuer:
+			driverValue, err := bindVal.Value()
+
+			if err != nil {
+				panic("jet: " + err.Error())
+			}
+
+			value = driverValue
+			continue
+		}
+
+		reflectValue := reflect.ValueOf(value)
+
+		switch reflectValue.Kind() {
+		case reflect.Ptr:
+			value = reflectValue.Elem().Interface()
+		case reflect.Bool:
+			return reflectValue.Bool()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return reflectValue.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return reflectValue.Uint()
+		case reflect.Float32:
+			return float32(reflectValue.Float())
+		case reflect.Float64:
+			return reflectValue.Float()
+		case reflect.String:
+			return reflectValue.String()
+		case reflect.Slice:
+			if reflectValue.Type().Elem().Kind() == reflect.Uint8 {
+				return reflectValue.Bytes()
+			}
+			return value
+		default:
+			return value
+		}
+	}
+}
+
 func shouldQuoteIdentifier(identifier string) bool {
 	for _, c := range identifier {
 		if unicode.IsNumber(c) || c == '_' {
@@ -184,6 +348,15 @@ func shouldQuoteIdentifier(identifier string) bool {
 	return false
 }
 
+// quoteWith surrounds str with quote char. Opening bracket is closed with closing bracket.
+func quoteWith(quoteChar byte, str string) string {
+	if quoteChar == '[' {
+		return "[" + str + "]"
+	}
+
+	return string(quoteChar) + str + string(quoteChar)
+}
+
 func stringQuote(value string) string {
 	return `'` + strings.Replace(value, "'", "''", -1) + `'`
 }
@@ -4,22 +4,26 @@ import (
 	"context"
 	"database/sql"
 	"github.com/go-jet/jet/qrm"
+	"reflect"
 )
 
 //Statement is common interface for all statements(SELECT, INSERT, UPDATE, DELETE, LOCK)
 type Statement interface {
-	// Sql returns parametrized sql query with list of arguments.
-	Sql() (query string, args []interface{})
+	// Sql returns parametrized sql query with list of arguments. Query format can be changed with options.
+	// Sql panics if statement is not valid.
+	Sql(options ...FormatOption) (query string, args []interface{})
+	// SqlErr returns parametrized sql query with list of arguments, or SerializeError if statement is not valid.
+	SqlErr(options ...FormatOption) (query string, args []interface{}, err error)
 	// DebugSql returns debug query where every parametrized placeholder is replaced with its argument.
-	// Do not use it in production. Use it only for debug purposes.
-	DebugSql() (query string)
+	// Query format can be changed with options. Do not use it in production. Use it only for debug purposes.
+	DebugSql(options ...FormatOption) (query string)
 
 	// Query executes statement over database connection db and stores row result in destination.
-	// Destination can be either pointer to struct or pointer to a slice.
+	// Destination can be either pointer to struct, pointer to a slice or pointer to a map keyed by the first column.
 	// If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
 	Query(db qrm.DB, destination interface{}) error
 	// QueryContext executes statement with a context over database connection db and stores row result in destination.
-	// Destination can be either pointer to struct or pointer to a slice.
+	// Destination can be either pointer to struct, pointer to a slice or pointer to a map keyed by the first column.
 	// If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
 	QueryContext(context context.Context, db qrm.DB, destination interface{}) error
 
@@ -27,12 +31,66 @@ type Statement interface {
 	Exec(db qrm.DB) (sql.Result, error)
 	//Exec executes statement with context over db connection without returning any rows.
 	ExecContext(context context.Context, db qrm.DB) (sql.Result, error)
+
+	// Prepare creates prepared statement over db connection. Prepared statement can be executed many times with
+	// different values of named parameters. Prepared statement should be closed when it is no longer used.
+	// Pass StatementCache instead of db, to reuse prepared statements with the same sql text.
+	Prepare(db qrm.Preparer) (PreparedStatement, error)
+	// PrepareContext creates prepared statement with a context over db connection.
+	PrepareContext(context context.Context, db qrm.Preparer) (PreparedStatement, error)
+
+	// Compile serializes statement once into compiled statement, that reuses serialized sql query and arguments
+	// on every execution. Use it for hot paths, where the same statement is executed many times.
+	Compile() CompiledStatement
 }
 
 // SerializerStatement interface
 type SerializerStatement interface {
 	Serializer
 	Statement
+	HasProjections
+
+	// serializeStatement serializes statement as top level statement of its own statement type
+	serializeStatement(out *SQLBuilder)
+}
+
+// ReturningStatement is implemented by statements with RETURNING clause
+type ReturningStatement interface {
+	// ExecReturning executes statement with a context over database connection db, stores rows returned by RETURNING
+	// clause into destination, and returns rowsAffected as number of rows returned by RETURNING clause. Rows returned
+	// into pointer to non-empty slice are mapped in place, into slice element with the same index, for instance to
+	// update IDs of inserted models. Row returned into pointer to struct is mapped in place as well. Rows are matched
+	// to slice elements only by position, which is reliable only for INSERT, because PostgreSQL and SQLite do not
+	// guarantee the order of rows returned by UPDATE or DELETE. If statement returns different number of rows than
+	// slice length, for instance because some rows are skipped, error is returned and slice elements are not changed.
+	// Statement without RETURNING clause is executed the same as with Exec, destination is not changed, and
+	// rowsAffected is number of rows affected by the statement.
+	ExecReturning(context context.Context, db qrm.DB, destination interface{}) (rowsAffected int64, err error)
+}
+
+// ExecReturning executes statement with returning clause, and maps returned rows into destination
+func ExecReturning(context context.Context, db qrm.DB, statement Statement, returning ClauseReturning, destination interface{}) (rowsAffected int64, err error) {
+	query, args, err := statementSql(statement)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(returning.Projections) == 0 {
+		res, err := db.ExecContext(context, query, args...)
+
+		if err != nil {
+			return 0, err
+		}
+
+		return res.RowsAffected()
+	}
+
+	if !isStrictMode() {
+		defer catchMisusePanic(&err)
+	}
+
+	return qrm.QueryReturning(context, db, query, args, destination)
 }
 
 // StatementWithProjections interface
@@ -54,53 +112,130 @@ type serializerStatementInterfaceImpl struct {
 	parent        SerializerStatement
 }
 
-func (s *serializerStatementInterfaceImpl) Sql() (query string, args []interface{}) {
+func (s *serializerStatementInterfaceImpl) Sql(options ...FormatOption) (query string, args []interface{}) {
+	query, args, err := s.sql(false, options)
 
-	queryData := &SQLBuilder{Dialect: s.dialect}
+	if err != nil {
+		panic(err.recovered)
+	}
 
-	s.parent.serialize(s.statementType, queryData, noWrap)
+	return
+}
+
+func (s *serializerStatementInterfaceImpl) SqlErr(options ...FormatOption) (query string, args []interface{}, err error) {
+	query, args, serializeErr := s.sql(false, options)
+
+	if serializeErr != nil {
+		return "", nil, serializeErr
+	}
 
-	query, args = queryData.finalize()
 	return
 }
 
-func (s *serializerStatementInterfaceImpl) DebugSql() (query string) {
-	sqlBuilder := &SQLBuilder{Dialect: s.dialect, debug: true}
+func (s *serializerStatementInterfaceImpl) DebugSql(options ...FormatOption) (query string) {
+	query, _, err := s.sql(true, options)
 
-	s.parent.serialize(s.statementType, sqlBuilder, noWrap)
+	if err != nil {
+		panic(err.recovered)
+	}
 
-	query, _ = sqlBuilder.finalize()
 	return
 }
 
-func (s *serializerStatementInterfaceImpl) Query(db qrm.DB, destination interface{}) error {
-	query, args := s.Sql()
+func (s *serializerStatementInterfaceImpl) sql(debug bool, options []FormatOption) (string, []interface{}, *SerializeError) {
+	sqlBuilder := newSQLBuilder(s.dialect, debug, options)
+
+	s.serializeStatement(sqlBuilder)
+
+	query, args := sqlBuilder.finalize()
+
+	return query, args, sqlBuilder.err()
+}
 
-	return qrm.Query(context.Background(), db, query, args, destination)
+func (s *serializerStatementInterfaceImpl) serializeStatement(out *SQLBuilder) {
+	s.parent.serialize(s.statementType, out, noWrap)
+}
+
+func (s *serializerStatementInterfaceImpl) Query(db qrm.DB, destination interface{}) error {
+	return s.QueryContext(context.Background(), db, destination)
 }
 
 func (s *serializerStatementInterfaceImpl) QueryContext(context context.Context, db qrm.DB, destination interface{}) error {
-	query, args := s.Sql()
+	query, args, err := statementSql(s)
 
-	return qrm.Query(context, db, query, args, destination)
+	if err != nil {
+		return err
+	}
+
+	return runQuery(context, db, query, args, destination)
 }
 
 func (s *serializerStatementInterfaceImpl) Exec(db qrm.DB) (res sql.Result, err error) {
-	query, args := s.Sql()
+	query, args, err := statementSql(s)
+
+	if err != nil {
+		return nil, err
+	}
+
 	return db.Exec(query, args...)
 }
 
 func (s *serializerStatementInterfaceImpl) ExecContext(context context.Context, db qrm.DB) (res sql.Result, err error) {
-	query, args := s.Sql()
+	query, args, err := statementSql(s)
+
+	if err != nil {
+		return nil, err
+	}
 
 	return db.ExecContext(context, query, args...)
 }
 
+func (s *serializerStatementInterfaceImpl) Prepare(db qrm.Preparer) (PreparedStatement, error) {
+	return newPreparedStatement(context.Background(), db, s)
+}
+
+func (s *serializerStatementInterfaceImpl) PrepareContext(context context.Context, db qrm.Preparer) (PreparedStatement, error) {
+	return newPreparedStatement(context, db, s)
+}
+
+func (s *serializerStatementInterfaceImpl) Compile() CompiledStatement {
+	return newCompiledStatement(s)
+}
+
+type sqlStatement interface {
+	Sql(options ...FormatOption) (query string, args []interface{})
+	SqlErr(options ...FormatOption) (query string, args []interface{}, err error)
+}
+
+// statementSql returns statement sql query and arguments, or error if statement is not valid.
+// In strict mode invalid statement panics instead.
+func statementSql(statement sqlStatement) (query string, args []interface{}, err error) {
+	if isStrictMode() {
+		query, args = statement.Sql()
+		return
+	}
+
+	return statement.SqlErr()
+}
+
+// runQuery executes query and maps result into destination. Destination mapping panics are returned as error,
+// unless strict mode is on. Runtime errors are always raised as panics.
+func runQuery(context context.Context, db qrm.DB, query string, args []interface{}, destination interface{}) (err error) {
+	if !isStrictMode() {
+		defer catchMisusePanic(&err)
+	}
+
+	return qrm.Query(context, db, query, args, destination)
+}
+
 // ExpressionStatement interfacess
 type ExpressionStatement interface {
 	Expression
 	Statement
 	HasProjections
+
+	// serializeStatement serializes statement as top level statement of its own statement type
+	serializeStatement(out *SQLBuilder)
 }
 
 // NewExpressionStatementImpl creates new expression statement
@@ -143,6 +278,55 @@ type statementImpl struct {
 	serializerStatementInterfaceImpl
 
 	Clauses []Clause
+
+	immutable bool
+}
+
+func (s *statementImpl) statement() *statementImpl {
+	return s
+}
+
+// clauseStatement is implemented by statements created with NewStatementImpl and NewExpressionStatementImpl
+type clauseStatement interface {
+	statement() *statementImpl
+}
+
+// CloneStatement copies clauses and immutable mode of statement into newStatement. Both statements have to be created
+// by the same dialect statement constructor. Clause slices of newStatement are capped, so that append on newStatement
+// clause allocates new array, instead of overwriting array shared with statement.
+func CloneStatement(newStatement, statement Statement) {
+	dest := newStatement.(clauseStatement).statement()
+	source := statement.(clauseStatement).statement()
+
+	for i, clause := range source.Clauses {
+		destClause := reflect.ValueOf(dest.Clauses[i]).Elem()
+		destClause.Set(reflect.ValueOf(clause).Elem())
+
+		if destClause.Kind() != reflect.Struct {
+			continue
+		}
+
+		for j := 0; j < destClause.NumField(); j++ {
+			field := destClause.Field(j)
+
+			if field.Kind() == reflect.Slice && field.CanSet() {
+				field.Set(field.Slice3(0, field.Len(), field.Len()))
+			}
+		}
+	}
+
+	dest.immutable = source.immutable
+}
+
+// SetImmutable turns on immutable mode of statement. In immutable mode statement builder methods modify copy of the
+// statement, and statement itself stays unchanged.
+func SetImmutable(statement Statement) {
+	statement.(clauseStatement).statement().immutable = true
+}
+
+// IsImmutable returns true if statement is in immutable mode
+func IsImmutable(statement Statement) bool {
+	return statement.(clauseStatement).statement().immutable
 }
 
 func (s *statementImpl) projections() ProjectionList {
@@ -163,7 +347,7 @@ func (s *statementImpl) serialize(statement StatementType, out *SQLBuilder, opti
 	}
 
 	for _, clause := range s.Clauses {
-		clause.Serialize(statement, out)
+		out.serializeClause(statement, clause)
 	}
 
 	if !contains(options, noWrap) {
@@ -0,0 +1,212 @@
+package jet
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+)
+
+// StatementCache is cache of statements prepared on database connection pool, keyed by sql text. Cache keeps at most
+// size least recently used statements, and closes statements evicted from the cache.
+// Pass cache to statement Prepare instead of db, to reuse database prepared statements between Prepare calls:
+//
+//	cache := postgres.NewStatementCache(db, 100)
+//	defer cache.Close()
+//
+//	prepared, err := stmt.Prepare(cache)
+type StatementCache struct {
+	db   *sql.DB
+	size int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // front is the most recently used entry
+	closed  bool
+}
+
+type stmtCacheEntry struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int  // number of executions in progress
+	evicted bool // entry is removed from cache, and statement is closed when the last execution is done
+}
+
+// NewStatementCache creates new cache of at most size statements prepared on db
+func NewStatementCache(db *sql.DB, size int) *StatementCache {
+	if size < 1 {
+		panic("jet: statement cache size has to be positive")
+	}
+
+	return &StatementCache{
+		db:      db,
+		size:    size,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+// PrepareContext returns cached prepared statement for query, or prepares and caches new one. Returned statement is
+// owned by the cache, and can be closed by the cache as soon as it is evicted.
+func (c *StatementCache) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	stmt, release, err := c.acquire(ctx, query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	release()
+
+	return stmt, nil
+}
+
+// Len returns number of cached statements
+func (c *StatementCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// Close closes all cached statements. Statements are closed when executions in progress are done.
+func (c *StatementCache) Close() error {
+	c.mu.Lock()
+
+	c.closed = true
+
+	var toClose []*sql.Stmt
+
+	for c.lru.Len() > 0 {
+		if stmt := c.evict(c.lru.Back()); stmt != nil {
+			toClose = append(toClose, stmt)
+		}
+	}
+
+	c.mu.Unlock()
+
+	return closeStmts(toClose)
+}
+
+// acquire returns prepared statement for query, and function that has to be called after statement execution.
+// Statement is prepared outside the cache lock, so slow prepare does not block other queries.
+func (c *StatementCache) acquire(ctx context.Context, query string) (*sql.Stmt, func(), error) {
+	cached, err := c.acquireCached(query)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cached != nil {
+		return cached.stmt, func() { c.release(cached) }, nil
+	}
+
+	stmt, err := c.db.PrepareContext(ctx, query)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.mu.Lock()
+
+	if c.closed {
+		c.mu.Unlock()
+		stmt.Close()
+		return nil, nil, errStatementCacheClosed
+	}
+
+	var toClose []*sql.Stmt
+
+	entry := c.cachedEntry(query)
+
+	if entry != nil { // prepared concurrently
+		toClose = append(toClose, stmt)
+	} else {
+		entry = &stmtCacheEntry{query: query, stmt: stmt}
+		c.entries[query] = c.lru.PushFront(entry)
+
+		for c.lru.Len() > c.size {
+			if evicted := c.evict(c.lru.Back()); evicted != nil {
+				toClose = append(toClose, evicted)
+			}
+		}
+	}
+
+	entry.refs++
+
+	c.mu.Unlock()
+
+	closeStmts(toClose)
+
+	return entry.stmt, func() { c.release(entry) }, nil
+}
+
+var errStatementCacheClosed = errors.New("jet: statement cache is closed")
+
+func (c *StatementCache) acquireCached(query string) (*stmtCacheEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil, errStatementCacheClosed
+	}
+
+	entry := c.cachedEntry(query)
+
+	if entry != nil {
+		entry.refs++
+	}
+
+	return entry, nil
+}
+
+// cachedEntry returns cache entry for query, and marks it as the most recently used
+func (c *StatementCache) cachedEntry(query string) *stmtCacheEntry {
+	element, ok := c.entries[query]
+
+	if !ok {
+		return nil
+	}
+
+	c.lru.MoveToFront(element)
+
+	return element.Value.(*stmtCacheEntry)
+}
+
+// evict removes element from cache, and returns statement to close if it is not executing
+func (c *StatementCache) evict(element *list.Element) *sql.Stmt {
+	entry := c.lru.Remove(element).(*stmtCacheEntry)
+	delete(c.entries, entry.query)
+	entry.evicted = true
+
+	if entry.refs > 0 {
+		return nil
+	}
+
+	return entry.stmt
+}
+
+func (c *StatementCache) release(entry *stmtCacheEntry) {
+	c.mu.Lock()
+
+	entry.refs--
+	closeStmt := entry.evicted && entry.refs == 0
+
+	c.mu.Unlock()
+
+	if closeStmt {
+		entry.stmt.Close()
+	}
+}
+
+func closeStmts(stmts []*sql.Stmt) error {
+	var firstErr error
+
+	for _, stmt := range stmts {
+		if err := stmt.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
@@ -0,0 +1,163 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"gotest.tools/assert"
+	"io"
+	"sync"
+	"testing"
+)
+
+// countingDriver counts statements prepared and closed on database connection
+type countingDriver struct {
+	mu       sync.Mutex
+	prepared map[string]int
+	open     int
+}
+
+func (d *countingDriver) Open(name string) (driver.Conn, error) {
+	return countingConn{d}, nil
+}
+
+func (d *countingDriver) openStmts() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.open
+}
+
+type countingConn struct {
+	driver *countingDriver
+}
+
+func (c countingConn) Prepare(query string) (driver.Stmt, error) {
+	c.driver.mu.Lock()
+	defer c.driver.mu.Unlock()
+
+	c.driver.prepared[query]++
+	c.driver.open++
+
+	return countingStmt{c.driver}, nil
+}
+
+func (c countingConn) Close() error              { return nil }
+func (c countingConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type countingStmt struct {
+	driver *countingDriver
+}
+
+func (s countingStmt) Close() error {
+	s.driver.mu.Lock()
+	defer s.driver.mu.Unlock()
+
+	s.driver.open--
+	return nil
+}
+
+func (s countingStmt) NumInput() int { return -1 }
+
+func (s countingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+
+func (s countingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return emptyRows{}, nil
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string              { return []string{"id"} }
+func (emptyRows) Close() error                   { return nil }
+func (emptyRows) Next(dest []driver.Value) error { return io.EOF }
+
+var testDriver = &countingDriver{prepared: map[string]int{}}
+
+func init() {
+	sql.Register("jet_counting", testDriver)
+}
+
+func TestStatementCache(t *testing.T) {
+	db, err := sql.Open("jet_counting", "")
+	assert.NilError(t, err)
+	defer db.Close()
+	db.SetMaxIdleConns(1)
+	db.SetMaxOpenConns(1)
+
+	cache := NewStatementCache(db, 2)
+
+	prepare := func(query string) {
+		stmt, err := cache.PrepareContext(context.Background(), query)
+		assert.NilError(t, err)
+
+		_, err = stmt.Exec()
+		assert.NilError(t, err)
+	}
+
+	prepare("SELECT 1")
+	prepare("SELECT 2")
+	prepare("SELECT 1")
+
+	assert.Equal(t, cache.Len(), 2)
+	assert.Equal(t, testDriver.prepared["SELECT 1"], 1)
+	assert.Equal(t, testDriver.openStmts(), 2)
+
+	prepare("SELECT 3") // evicts least recently used SELECT 2
+
+	assert.Equal(t, cache.Len(), 2)
+	assert.Equal(t, testDriver.openStmts(), 2)
+
+	prepare("SELECT 2")
+	assert.Equal(t, testDriver.prepared["SELECT 2"], 2)
+
+	assert.NilError(t, cache.Close())
+	assert.Equal(t, cache.Len(), 0)
+	assert.Equal(t, testDriver.openStmts(), 0)
+
+	_, err = cache.PrepareContext(context.Background(), "SELECT 1")
+	assert.Error(t, err, "jet: statement cache is closed")
+}
+
+func TestStatementCacheEvictsAfterExecution(t *testing.T) {
+	db, err := sql.Open("jet_counting", "")
+	assert.NilError(t, err)
+	defer db.Close()
+
+	cache := NewStatementCache(db, 1)
+	defer cache.Close()
+
+	stmt, release, err := cache.acquire(context.Background(), "SELECT 10")
+	assert.NilError(t, err)
+
+	_, err = cache.PrepareContext(context.Background(), "SELECT 11") // evicts SELECT 10 while it is executing
+
+	assert.NilError(t, err)
+	_, err = stmt.Exec()
+	assert.NilError(t, err)
+
+	release()
+
+	_, err = stmt.Exec()
+	assert.Error(t, err, "sql: statement is closed")
+}
+
+func TestPreparedStatementClose(t *testing.T) {
+	db, err := sql.Open("jet_counting", "")
+	assert.NilError(t, err)
+	defer db.Close()
+
+	openStmts := testDriver.openStmts()
+
+	stmt, err := db.PrepareContext(context.Background(), "SELECT 20")
+	assert.NilError(t, err)
+	_, err = stmt.Exec()
+	assert.NilError(t, err)
+	assert.Equal(t, testDriver.openStmts(), openStmts+1)
+
+	prepared := &preparedStatementImpl{stmt: stmt, query: "SELECT 20"}
+
+	assert.NilError(t, prepared.Close())
+	assert.Equal(t, testDriver.openStmts(), openStmts)
+}
@@ -0,0 +1,49 @@
+package jet
+
+import (
+	"gotest.tools/assert"
+	"testing"
+)
+
+type testStatement struct {
+	SerializerStatement
+
+	Window ClauseWindow
+	Where  ClauseWhere
+}
+
+func newTestStatement() *testStatement {
+	newStatement := &testStatement{}
+	newStatement.SerializerStatement = NewStatementImpl(defaultDialect, SelectStatementType, newStatement,
+		&newStatement.Window, &newStatement.Where)
+
+	return newStatement
+}
+
+func TestCloneStatement(t *testing.T) {
+	statement := newTestStatement()
+	statement.Window.Definitions = make([]WindowDefinition, 1, 10)
+	statement.Window.Definitions[0].Name = "w1"
+	statement.Where.Condition = table1ColBool
+	SetImmutable(statement.SerializerStatement)
+
+	clone := newTestStatement()
+	CloneStatement(clone.SerializerStatement, statement.SerializerStatement)
+
+	assert.Assert(t, IsImmutable(clone.SerializerStatement))
+	assert.Equal(t, clone.Where.Condition, statement.Where.Condition)
+	assert.DeepEqual(t, clone.Window.Definitions, statement.Window.Definitions)
+
+	clone.Window.Definitions = append(clone.Window.Definitions, WindowDefinition{Name: "w2"})
+
+	assert.Equal(t, len(statement.Window.Definitions), 1)
+	assert.Equal(t, statement.Window.Definitions[:2][1].Name, "")
+}
+
+func TestIsImmutable(t *testing.T) {
+	statement := newTestStatement()
+	assert.Assert(t, !IsImmutable(statement.SerializerStatement))
+
+	SetImmutable(statement.SerializerStatement)
+	assert.Assert(t, IsImmutable(statement.SerializerStatement))
+}
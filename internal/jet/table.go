@@ -84,6 +84,43 @@ func (t *tableImpl) serialize(statement StatementType, out *SQLBuilder, options
 	}
 }
 
+// NewTableFunction creates new table function call with schema Name, function Name, list of function arguments
+// and list of result columns
+func NewTableFunction(schemaName, name string, args []Expression, column ColumnExpression, columns ...ColumnExpression) SerializerTable {
+	return &tableFunctionImpl{
+		tableImpl: *NewTable(schemaName, name, column, columns...).(*tableImpl),
+		args:      args,
+	}
+}
+
+type tableFunctionImpl struct {
+	tableImpl
+	args []Expression
+}
+
+func (t *tableFunctionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if t == nil {
+		panic("jet: tableFunctionImpl is nil")
+	}
+
+	out.WriteIdentifier(t.schemaName)
+	out.WriteString(".")
+
+	name := t.name
+	if shouldQuoteIdentifier(name) {
+		name = quoteWith(out.Dialect.IdentifierQuoteChar(), name)
+	}
+
+	out.WriteString(name + "(")
+	serializeExpressionList(statement, t.args, ", ", out)
+	out.WriteString(")")
+
+	if len(t.alias) > 0 {
+		out.WriteString("AS")
+		out.WriteIdentifier(t.alias)
+	}
+}
+
 // JoinType is type of table join
 type JoinType int
 
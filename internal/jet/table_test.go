@@ -15,6 +15,20 @@ func TestNewTable(t *testing.T) {
 	assert.Equal(t, newTable.columns()[0].Name(), "intCol")
 }
 
+func TestNewTableFunction(t *testing.T) {
+	tableFunction := NewTableFunction("schema", "func", []Expression{Int(1), String("str")}, IntegerColumn("intCol"))
+
+	assert.Equal(t, tableFunction.SchemaName(), "schema")
+	assert.Equal(t, tableFunction.TableName(), "func")
+	assert.Equal(t, len(tableFunction.columns()), 1)
+
+	assertClauseSerialize(t, tableFunction, `schema.func($1, $2)`, int64(1), "str")
+
+	tableFunction.AS("alias")
+
+	assertClauseSerialize(t, tableFunction, `schema.func($1, $2) AS alias`, int64(1), "str")
+}
+
 func TestNewJoinTable(t *testing.T) {
 	newTable1 := NewTable("schema", "table", IntegerColumn("intCol1"))
 	newTable2 := NewTable("schema", "table2", IntegerColumn("intCol2"))
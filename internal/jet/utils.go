@@ -83,10 +83,16 @@ func valueToClause(value interface{}) Serializer {
 }
 
 // UnwindRowFromModel func
-func UnwindRowFromModel(columns []Column, data interface{}) []Serializer {
+func UnwindRowFromModel(columns []Column, data interface{}) (row []Serializer) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			row = invalidRow(columns, recovered)
+		}
+	}()
+
 	structValue := reflect.Indirect(reflect.ValueOf(data))
 
-	row := []Serializer{}
+	row = []Serializer{}
 
 	utils.ValueMustBe(structValue, reflect.Struct, "jet: data has to be a struct")
 
@@ -115,11 +121,17 @@ func UnwindRowFromModel(columns []Column, data interface{}) []Serializer {
 }
 
 // UnwindRowsFromModels func
-func UnwindRowsFromModels(columns []Column, data interface{}) [][]Serializer {
+func UnwindRowsFromModels(columns []Column, data interface{}) (rows [][]Serializer) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			rows = [][]Serializer{invalidRow(columns, recovered)}
+		}
+	}()
+
 	sliceValue := reflect.Indirect(reflect.ValueOf(data))
 	utils.ValueMustBe(sliceValue, reflect.Slice, "jet: data has to be a slice.")
 
-	rows := [][]Serializer{}
+	rows = [][]Serializer{}
 
 	for i := 0; i < sliceValue.Len(); i++ {
 		structValue := sliceValue.Index(i)
@@ -130,6 +142,22 @@ func UnwindRowsFromModels(columns []Column, data interface{}) [][]Serializer {
 	return rows
 }
 
+// invalidRow returns row whose values panic with recovered value at serialization, so invalid model data is
+// reported as statement error. In strict mode, and for runtime errors, recovered value panics immediately.
+func invalidRow(columns []Column, recovered interface{}) []Serializer {
+	if isStrictMode() || !isMisusePanic(recovered) {
+		panic(recovered)
+	}
+
+	row := []Serializer{invalidSerializer{recovered: recovered}}
+
+	for i := 1; i < len(columns); i++ {
+		row = append(row, invalidSerializer{recovered: recovered})
+	}
+
+	return row
+}
+
 // UnwindRowFromValues func
 func UnwindRowFromValues(value interface{}, values []interface{}) []Serializer {
 	row := []Serializer{}
@@ -43,6 +43,10 @@ func (w *windowImpl) serialize(statement StatementType, out *SQLBuilder, options
 	w.orderBy.Serialize(statement, out)
 
 	if w.frameUnits != "" {
+		if w.frameUnits == "GROUPS" {
+			out.RequireCapability(CapabilityWindowGroupsFrame)
+		}
+
 		out.WriteString(w.frameUnits)
 
 		if w.end == nil {
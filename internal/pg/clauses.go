@@ -0,0 +1,19 @@
+package pg
+
+import "github.com/go-jet/jet/internal/jet"
+
+type clauseAsOfSystemTime struct {
+	Timestamp jet.Expression
+}
+
+func (a *clauseAsOfSystemTime) Serialize(statementType jet.StatementType, out *jet.SQLBuilder) {
+	if a.Timestamp == nil {
+		return
+	}
+
+	out.RequireCapability(jet.CapabilityAsOfSystemTime)
+
+	out.NewLine()
+	out.WriteString("AS OF SYSTEM TIME")
+	jet.Serialize(a.Timestamp, statementType, out)
+}
@@ -0,0 +1,76 @@
+package pg
+
+import "github.com/go-jet/jet/internal/jet"
+
+// RefreshMaterializedViewStatement is interface for REFRESH MATERIALIZED VIEW statement
+type RefreshMaterializedViewStatement interface {
+	jet.Statement
+
+	CONCURRENTLY() RefreshMaterializedViewStatement
+	WITH_NO_DATA() RefreshMaterializedViewStatement
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() RefreshMaterializedViewStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() RefreshMaterializedViewStatement
+}
+
+func newRefreshMaterializedViewStatement(dialect jet.Dialect, view jet.SerializerTable) RefreshMaterializedViewStatement {
+	newRefresh := &refreshMaterializedViewStatementImpl{dialect: dialect}
+	newRefresh.SerializerStatement = jet.NewStatementImpl(dialect, jet.RefreshStatementType, newRefresh,
+		&newRefresh.StatementBegin, &newRefresh.WithNoData)
+
+	newRefresh.StatementBegin.Name = "REFRESH MATERIALIZED VIEW"
+	newRefresh.StatementBegin.Tables = []jet.SerializerTable{view}
+	newRefresh.WithNoData.Name = "WITH NO DATA"
+	return newRefresh
+}
+
+type refreshMaterializedViewStatementImpl struct {
+	jet.SerializerStatement
+
+	dialect jet.Dialect
+
+	StatementBegin jet.ClauseStatementBegin
+	WithNoData     jet.ClauseOptional
+}
+
+func (r *refreshMaterializedViewStatementImpl) CONCURRENTLY() RefreshMaterializedViewStatement {
+	r = r.edit()
+	r.StatementBegin.Name = "REFRESH MATERIALIZED VIEW CONCURRENTLY"
+	return r
+}
+
+func (r *refreshMaterializedViewStatementImpl) WITH_NO_DATA() RefreshMaterializedViewStatement {
+	r = r.edit()
+	r.WithNoData.Show = true
+	return r
+}
+
+func (r *refreshMaterializedViewStatementImpl) Clone() RefreshMaterializedViewStatement {
+	return r.clone()
+}
+
+func (r *refreshMaterializedViewStatementImpl) Immutable() RefreshMaterializedViewStatement {
+	newRefresh := r.clone()
+	jet.SetImmutable(newRefresh.SerializerStatement)
+
+	return newRefresh
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (r *refreshMaterializedViewStatementImpl) edit() *refreshMaterializedViewStatementImpl {
+	if !jet.IsImmutable(r.SerializerStatement) {
+		return r
+	}
+
+	return r.clone()
+}
+
+func (r *refreshMaterializedViewStatementImpl) clone() *refreshMaterializedViewStatementImpl {
+	newRefresh := newRefreshMaterializedViewStatement(r.dialect, nil).(*refreshMaterializedViewStatementImpl)
+	jet.CloneStatement(newRefresh.SerializerStatement, r.SerializerStatement)
+
+	return newRefresh
+}
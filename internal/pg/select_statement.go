@@ -0,0 +1,205 @@
+package pg
+
+import "github.com/go-jet/jet/internal/jet"
+
+// SelectStatement is interface for SELECT statement
+type SelectStatement interface {
+	jet.Statement
+	jet.HasProjections
+	jet.Expression
+
+	DISTINCT(on ...jet.Expression) SelectStatement
+	FROM(table ReadableTable) SelectStatement
+	// AS_OF_SYSTEM_TIME reads data as of timestamp. Supported only by CockroachDB.
+	AS_OF_SYSTEM_TIME(timestamp jet.Expression) SelectStatement
+	WHERE(expression jet.BoolExpression) SelectStatement
+	GROUP_BY(groupByClauses ...jet.GroupByClause) SelectStatement
+	HAVING(boolExpression jet.BoolExpression) SelectStatement
+	WINDOW(name string) windowExpand
+	ORDER_BY(orderByClauses ...jet.OrderByClause) SelectStatement
+	LIMIT(limit int64) SelectStatement
+	OFFSET(offset int64) SelectStatement
+	FOR(lock jet.RowLock) SelectStatement
+
+	UNION(rhs SelectStatement) SetStatement
+	UNION_ALL(rhs SelectStatement) SetStatement
+	INTERSECT(rhs SelectStatement) SetStatement
+	INTERSECT_ALL(rhs SelectStatement) SetStatement
+	EXCEPT(rhs SelectStatement) SetStatement
+	EXCEPT_ALL(rhs SelectStatement) SetStatement
+
+	AsTable(alias string) SelectTable
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() SelectStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() SelectStatement
+}
+
+// SELECT creates new SelectStatement of dialect with list of projections
+func SELECT(dialect jet.Dialect, projections []jet.Projection) SelectStatement {
+	return newSelectStatement(dialect, nil, projections)
+}
+
+// CountQuery derives statement that counts rows of statement: SELECT COUNT(*) AS "count" FROM (statement) AS "count_query".
+// ORDER BY, LIMIT and OFFSET clauses of statement are dropped, so total count can be derived from page query.
+func CountQuery(dialect jet.Dialect, statement SelectStatement) SelectStatement {
+	if selectStmt, ok := statement.(*selectStatementImpl); ok {
+		countedStmt := selectStmt.clone()
+		countedStmt.OrderBy.List = nil
+		countedStmt.Limit.Count = -1
+		countedStmt.Offset.Count = -1
+		statement = countedStmt
+	}
+
+	return SELECT(dialect, []jet.Projection{jet.COUNT(jet.STAR).AS("count")}).FROM(statement.AsTable("count_query"))
+}
+
+func newSelectStatement(dialect jet.Dialect, table ReadableTable, projections []jet.Projection) SelectStatement {
+	newSelect := &selectStatementImpl{dialect: dialect}
+	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(dialect, jet.SelectStatementType, newSelect, &newSelect.Select,
+		&newSelect.From, &newSelect.AsOfSystemTime, &newSelect.Where, &newSelect.GroupBy, &newSelect.Having, &newSelect.Window,
+		&newSelect.OrderBy, &newSelect.Limit, &newSelect.Offset, &newSelect.For)
+
+	newSelect.Select.Projections = projections
+	newSelect.From.Table = table
+	newSelect.Limit.Count = -1
+	newSelect.Offset.Count = -1
+
+	newSelect.setOperatorsImpl = setOperatorsImpl{dialect: dialect, parent: newSelect}
+
+	return newSelect
+}
+
+type selectStatementImpl struct {
+	jet.ExpressionStatement
+	setOperatorsImpl
+
+	dialect jet.Dialect
+
+	Select         jet.ClauseSelect
+	From           jet.ClauseFrom
+	AsOfSystemTime clauseAsOfSystemTime
+	Where          jet.ClauseWhere
+	GroupBy        jet.ClauseGroupBy
+	Having         jet.ClauseHaving
+	Window         jet.ClauseWindow
+	OrderBy        jet.ClauseOrderBy
+	Limit          jet.ClauseLimit
+	Offset         jet.ClauseOffset
+	For            jet.ClauseFor
+}
+
+func (s *selectStatementImpl) DISTINCT(on ...jet.Expression) SelectStatement {
+	s = s.edit()
+	s.Select.Distinct = true
+	s.Select.DistinctOn = on
+	return s
+}
+
+func (s *selectStatementImpl) FROM(table ReadableTable) SelectStatement {
+	s = s.edit()
+	s.From.Table = table
+	return s
+}
+
+func (s *selectStatementImpl) AS_OF_SYSTEM_TIME(timestamp jet.Expression) SelectStatement {
+	s = s.edit()
+	s.AsOfSystemTime.Timestamp = timestamp
+	return s
+}
+
+func (s *selectStatementImpl) WHERE(condition jet.BoolExpression) SelectStatement {
+	s = s.edit()
+	s.Where.Condition = condition
+	return s
+}
+
+func (s *selectStatementImpl) GROUP_BY(groupByClauses ...jet.GroupByClause) SelectStatement {
+	s = s.edit()
+	s.GroupBy.List = groupByClauses
+	return s
+}
+
+func (s *selectStatementImpl) HAVING(boolExpression jet.BoolExpression) SelectStatement {
+	s = s.edit()
+	s.Having.Condition = boolExpression
+	return s
+}
+
+func (s *selectStatementImpl) WINDOW(name string) windowExpand {
+	s = s.edit()
+	s.Window.Definitions = append(s.Window.Definitions, jet.WindowDefinition{Name: name})
+	return windowExpand{selectStatement: s}
+}
+
+func (s *selectStatementImpl) ORDER_BY(orderByClauses ...jet.OrderByClause) SelectStatement {
+	s = s.edit()
+	s.OrderBy.List = orderByClauses
+	return s
+}
+
+func (s *selectStatementImpl) LIMIT(limit int64) SelectStatement {
+	s = s.edit()
+	s.Limit.Count = limit
+	return s
+}
+
+func (s *selectStatementImpl) OFFSET(offset int64) SelectStatement {
+	s = s.edit()
+	s.Offset.Count = offset
+	return s
+}
+
+func (s *selectStatementImpl) FOR(lock jet.RowLock) SelectStatement {
+	s = s.edit()
+	s.For.Lock = lock
+	return s
+}
+
+func (s *selectStatementImpl) AsTable(alias string) SelectTable {
+	return newSelectTable(s.dialect, s, alias)
+}
+
+func (s *selectStatementImpl) Clone() SelectStatement {
+	return s.clone()
+}
+
+func (s *selectStatementImpl) Immutable() SelectStatement {
+	newSelect := s.clone()
+	jet.SetImmutable(newSelect.ExpressionStatement)
+
+	return newSelect
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (s *selectStatementImpl) edit() *selectStatementImpl {
+	if !jet.IsImmutable(s.ExpressionStatement) {
+		return s
+	}
+
+	return s.clone()
+}
+
+func (s *selectStatementImpl) clone() *selectStatementImpl {
+	newSelect := newSelectStatement(s.dialect, nil, nil).(*selectStatementImpl)
+	jet.CloneStatement(newSelect.ExpressionStatement, s.ExpressionStatement)
+
+	return newSelect
+}
+
+//-----------------------------------------------------
+
+type windowExpand struct {
+	selectStatement *selectStatementImpl
+}
+
+func (w windowExpand) AS(window ...jet.Window) SelectStatement {
+	if len(window) == 0 {
+		return w.selectStatement
+	}
+	windowsDefinition := w.selectStatement.Window.Definitions
+	windowsDefinition[len(windowsDefinition)-1].Window = window[0]
+	return w.selectStatement
+}
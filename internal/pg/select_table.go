@@ -0,0 +1,35 @@
+package pg
+
+import "github.com/go-jet/jet/internal/jet"
+
+// SelectTable is interface for sub-queries
+type SelectTable interface {
+	Readable
+	jet.SelectTable
+}
+
+type selectTableImpl struct {
+	jet.SelectTable
+	readableTableInterfaceImpl
+}
+
+func newSelectTable(dialect jet.Dialect, selectStmt jet.StatementWithProjections, alias string) SelectTable {
+	subQuery := &selectTableImpl{
+		SelectTable: jet.NewSelectTable(selectStmt, alias),
+	}
+
+	subQuery.readableTableInterfaceImpl = readableTableInterfaceImpl{dialect: dialect, parent: subQuery}
+
+	return subQuery
+}
+
+// LATERAL creates sub-query of dialect, that can reference columns of FROM items appearing before it
+func LATERAL(dialect jet.Dialect, selectStmt SelectStatement, alias string) SelectTable {
+	subQuery := &selectTableImpl{
+		SelectTable: jet.NewLateralSelectTable(selectStmt, alias),
+	}
+
+	subQuery.readableTableInterfaceImpl = readableTableInterfaceImpl{dialect: dialect, parent: subQuery}
+
+	return subQuery
+}
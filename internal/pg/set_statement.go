@@ -0,0 +1,182 @@
+package pg
+
+import "github.com/go-jet/jet/internal/jet"
+
+// UNION effectively appends the result of sub-queries(select statements) into single query.
+// It eliminates duplicate rows from its result.
+func UNION(dialect jet.Dialect, lhs, rhs jet.StatementWithProjections, selects ...jet.StatementWithProjections) SetStatement {
+	return newSetStatementImpl(dialect, union, false, toSelectList(lhs, rhs, selects...))
+}
+
+// UNION_ALL effectively appends the result of sub-queries(select statements) into single query.
+// It does not eliminates duplicate rows from its result.
+func UNION_ALL(dialect jet.Dialect, lhs, rhs jet.StatementWithProjections, selects ...jet.StatementWithProjections) SetStatement {
+	return newSetStatementImpl(dialect, union, true, toSelectList(lhs, rhs, selects...))
+}
+
+// INTERSECT returns all rows that are in query results.
+// It eliminates duplicate rows from its result.
+func INTERSECT(dialect jet.Dialect, lhs, rhs jet.StatementWithProjections, selects ...jet.StatementWithProjections) SetStatement {
+	return newSetStatementImpl(dialect, intersect, false, toSelectList(lhs, rhs, selects...))
+}
+
+// INTERSECT_ALL returns all rows that are in query results.
+// It does not eliminates duplicate rows from its result.
+func INTERSECT_ALL(dialect jet.Dialect, lhs, rhs jet.StatementWithProjections, selects ...jet.StatementWithProjections) SetStatement {
+	return newSetStatementImpl(dialect, intersect, true, toSelectList(lhs, rhs, selects...))
+}
+
+// EXCEPT returns all rows that are in the result of query lhs but not in the result of query rhs.
+// It eliminates duplicate rows from its result.
+func EXCEPT(dialect jet.Dialect, lhs, rhs jet.StatementWithProjections) SetStatement {
+	return newSetStatementImpl(dialect, except, false, toSelectList(lhs, rhs))
+}
+
+// EXCEPT_ALL returns all rows that are in the result of query lhs but not in the result of query rhs.
+// It does not eliminates duplicate rows from its result.
+func EXCEPT_ALL(dialect jet.Dialect, lhs, rhs jet.StatementWithProjections) SetStatement {
+	return newSetStatementImpl(dialect, except, true, toSelectList(lhs, rhs))
+}
+
+// SetStatement is interface for UNION, INTERSECT and EXCEPT statements
+type SetStatement interface {
+	setOperators
+
+	ORDER_BY(orderByClauses ...jet.OrderByClause) SetStatement
+
+	LIMIT(limit int64) SetStatement
+	OFFSET(offset int64) SetStatement
+
+	AsTable(alias string) SelectTable
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() SetStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() SetStatement
+}
+
+type setOperators interface {
+	jet.Statement
+	jet.HasProjections
+	jet.Expression
+
+	UNION(rhs SelectStatement) SetStatement
+	UNION_ALL(rhs SelectStatement) SetStatement
+	INTERSECT(rhs SelectStatement) SetStatement
+	INTERSECT_ALL(rhs SelectStatement) SetStatement
+	EXCEPT(rhs SelectStatement) SetStatement
+	EXCEPT_ALL(rhs SelectStatement) SetStatement
+}
+
+type setOperatorsImpl struct {
+	dialect jet.Dialect
+	parent  setOperators
+}
+
+func (s *setOperatorsImpl) UNION(rhs SelectStatement) SetStatement {
+	return UNION(s.dialect, s.parent, rhs)
+}
+
+func (s *setOperatorsImpl) UNION_ALL(rhs SelectStatement) SetStatement {
+	return UNION_ALL(s.dialect, s.parent, rhs)
+}
+
+func (s *setOperatorsImpl) INTERSECT(rhs SelectStatement) SetStatement {
+	return INTERSECT(s.dialect, s.parent, rhs)
+}
+
+func (s *setOperatorsImpl) INTERSECT_ALL(rhs SelectStatement) SetStatement {
+	return INTERSECT_ALL(s.dialect, s.parent, rhs)
+}
+
+func (s *setOperatorsImpl) EXCEPT(rhs SelectStatement) SetStatement {
+	return EXCEPT(s.dialect, s.parent, rhs)
+}
+
+func (s *setOperatorsImpl) EXCEPT_ALL(rhs SelectStatement) SetStatement {
+	return EXCEPT_ALL(s.dialect, s.parent, rhs)
+}
+
+type setStatementImpl struct {
+	jet.ExpressionStatement
+
+	setOperatorsImpl
+
+	setOperator jet.ClauseSetStmtOperator
+}
+
+func newSetStatementImpl(dialect jet.Dialect, operator string, all bool, selects []jet.StatementWithProjections) SetStatement {
+	newSetStatement := &setStatementImpl{}
+	newSetStatement.ExpressionStatement = jet.NewExpressionStatementImpl(dialect, jet.SetStatementType, newSetStatement,
+		&newSetStatement.setOperator)
+
+	newSetStatement.setOperator.Operator = operator
+	newSetStatement.setOperator.All = all
+	newSetStatement.setOperator.Selects = selects
+	newSetStatement.setOperator.Limit.Count = -1
+	newSetStatement.setOperator.Offset.Count = -1
+
+	newSetStatement.setOperatorsImpl = setOperatorsImpl{dialect: dialect, parent: newSetStatement}
+
+	return newSetStatement
+}
+
+func (s *setStatementImpl) ORDER_BY(orderByClauses ...jet.OrderByClause) SetStatement {
+	s = s.edit()
+	s.setOperator.OrderBy.List = orderByClauses
+	return s
+}
+
+func (s *setStatementImpl) LIMIT(limit int64) SetStatement {
+	s = s.edit()
+	s.setOperator.Limit.Count = limit
+	return s
+}
+
+func (s *setStatementImpl) OFFSET(offset int64) SetStatement {
+	s = s.edit()
+	s.setOperator.Offset.Count = offset
+	return s
+}
+
+func (s *setStatementImpl) AsTable(alias string) SelectTable {
+	return newSelectTable(s.dialect, s, alias)
+}
+
+func (s *setStatementImpl) Clone() SetStatement {
+	return s.clone()
+}
+
+func (s *setStatementImpl) Immutable() SetStatement {
+	newSetStatement := s.clone()
+	jet.SetImmutable(newSetStatement.ExpressionStatement)
+
+	return newSetStatement
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (s *setStatementImpl) edit() *setStatementImpl {
+	if !jet.IsImmutable(s.ExpressionStatement) {
+		return s
+	}
+
+	return s.clone()
+}
+
+func (s *setStatementImpl) clone() *setStatementImpl {
+	newSetStatement := newSetStatementImpl(s.dialect, "", false, nil).(*setStatementImpl)
+	jet.CloneStatement(newSetStatement.ExpressionStatement, s.ExpressionStatement)
+
+	return newSetStatement
+}
+
+const (
+	union     = "UNION"
+	intersect = "INTERSECT"
+	except    = "EXCEPT"
+)
+
+func toSelectList(lhs, rhs jet.StatementWithProjections, selects ...jet.StatementWithProjections) []jet.StatementWithProjections {
+	return append([]jet.StatementWithProjections{lhs, rhs}, selects...)
+}
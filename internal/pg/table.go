@@ -0,0 +1,204 @@
+// Package pg implements tables and statements shared by PostgreSQL compatible dialects (PostgreSQL and CockroachDB).
+// Statements are serialized with the dialect of the table or constructor function they are created with.
+package pg
+
+import "github.com/go-jet/jet/internal/jet"
+
+// Table is interface for tables of PostgreSQL compatible dialects
+type Table interface {
+	Readable
+	Writable
+	jet.SerializerTable
+}
+
+// Readable is interface of read only table methods
+type Readable interface {
+	// Generates a select query on the current tableName.
+	SELECT(projection jet.Projection, projections ...jet.Projection) SelectStatement
+
+	// Creates a inner join tableName Expression using onCondition.
+	INNER_JOIN(table ReadableTable, onCondition jet.BoolExpression) ReadableTable
+
+	// Creates a left join tableName Expression using onCondition.
+	LEFT_JOIN(table ReadableTable, onCondition jet.BoolExpression) ReadableTable
+
+	// Creates a right join tableName Expression using onCondition.
+	RIGHT_JOIN(table ReadableTable, onCondition jet.BoolExpression) ReadableTable
+
+	// Creates a full join tableName Expression using onCondition.
+	FULL_JOIN(table ReadableTable, onCondition jet.BoolExpression) ReadableTable
+
+	// Creates a cross join tableName Expression using onCondition.
+	CROSS_JOIN(table ReadableTable) ReadableTable
+}
+
+// Writable is interface of table methods creating data modification statements
+type Writable interface {
+	INSERT(columns ...jet.Column) InsertStatement
+	UPDATE(column jet.Column, columns ...jet.Column) UpdateStatement
+	DELETE() DeleteStatement
+}
+
+// ReadableTable interface
+type ReadableTable interface {
+	Readable
+	jet.Serializer
+}
+
+// WritableTable interface
+type WritableTable interface {
+	jet.Table
+	Writable
+	jet.Serializer
+}
+
+type readableTableInterfaceImpl struct {
+	dialect jet.Dialect
+	parent  ReadableTable
+}
+
+// Generates a select query on the current tableName.
+func (r *readableTableInterfaceImpl) SELECT(projection1 jet.Projection, projections ...jet.Projection) SelectStatement {
+	return newSelectStatement(r.dialect, r.parent, append([]jet.Projection{projection1}, projections...))
+}
+
+// Creates a inner join tableName Expression using onCondition.
+func (r *readableTableInterfaceImpl) INNER_JOIN(table ReadableTable, onCondition jet.BoolExpression) ReadableTable {
+	return newJoinTable(r.dialect, r.parent, table, jet.InnerJoin, onCondition)
+}
+
+// Creates a left join tableName Expression using onCondition.
+func (r *readableTableInterfaceImpl) LEFT_JOIN(table ReadableTable, onCondition jet.BoolExpression) ReadableTable {
+	return newJoinTable(r.dialect, r.parent, table, jet.LeftJoin, onCondition)
+}
+
+// Creates a right join tableName Expression using onCondition.
+func (r *readableTableInterfaceImpl) RIGHT_JOIN(table ReadableTable, onCondition jet.BoolExpression) ReadableTable {
+	return newJoinTable(r.dialect, r.parent, table, jet.RightJoin, onCondition)
+}
+
+func (r *readableTableInterfaceImpl) FULL_JOIN(table ReadableTable, onCondition jet.BoolExpression) ReadableTable {
+	return newJoinTable(r.dialect, r.parent, table, jet.FullJoin, onCondition)
+}
+
+func (r *readableTableInterfaceImpl) CROSS_JOIN(table ReadableTable) ReadableTable {
+	return newJoinTable(r.dialect, r.parent, table, jet.CrossJoin, nil)
+}
+
+type writableTableInterfaceImpl struct {
+	dialect jet.Dialect
+	parent  WritableTable
+}
+
+func (w *writableTableInterfaceImpl) INSERT(columns ...jet.Column) InsertStatement {
+	return newInsertStatement(w.dialect, w.parent, jet.UnwidColumnList(columns))
+}
+
+func (w *writableTableInterfaceImpl) UPDATE(column jet.Column, columns ...jet.Column) UpdateStatement {
+	return newUpdateStatement(w.dialect, w.parent, jet.UnwindColumns(column, columns...))
+}
+
+func (w *writableTableInterfaceImpl) DELETE() DeleteStatement {
+	return newDeleteStatement(w.dialect, w.parent)
+}
+
+type tableImpl struct {
+	readableTableInterfaceImpl
+	writableTableInterfaceImpl
+
+	jet.SerializerTable
+}
+
+// NewTable creates new table of dialect with schema Name, table Name and list of columns
+func NewTable(dialect jet.Dialect, schemaName, name string, column jet.ColumnExpression, columns ...jet.ColumnExpression) Table {
+
+	t := &tableImpl{
+		SerializerTable: jet.NewTable(schemaName, name, column, columns...),
+	}
+
+	t.readableTableInterfaceImpl = readableTableInterfaceImpl{dialect: dialect, parent: t}
+	t.writableTableInterfaceImpl = writableTableInterfaceImpl{dialect: dialect, parent: t}
+
+	return t
+}
+
+// MaterializedView is interface for materialized views. Materialized views are read only.
+type MaterializedView interface {
+	Readable
+	jet.SerializerTable
+
+	// REFRESH creates REFRESH MATERIALIZED VIEW statement for this materialized view
+	REFRESH() RefreshMaterializedViewStatement
+}
+
+type materializedViewImpl struct {
+	readableTableInterfaceImpl
+
+	jet.SerializerTable
+}
+
+// NewMaterializedView creates new materialized view of dialect with schema Name, view Name and list of columns
+func NewMaterializedView(dialect jet.Dialect, schemaName, name string, column jet.ColumnExpression, columns ...jet.ColumnExpression) MaterializedView {
+
+	v := &materializedViewImpl{
+		SerializerTable: jet.NewTable(schemaName, name, column, columns...),
+	}
+
+	v.readableTableInterfaceImpl = readableTableInterfaceImpl{dialect: dialect, parent: v}
+
+	return v
+}
+
+func (v *materializedViewImpl) REFRESH() RefreshMaterializedViewStatement {
+	return newRefreshMaterializedViewStatement(v.dialect, v)
+}
+
+// TableFunction is interface for set returning functions, callable as read only tables
+type TableFunction interface {
+	Readable
+	jet.SerializerTable
+}
+
+type tableFunctionImpl struct {
+	readableTableInterfaceImpl
+
+	jet.SerializerTable
+}
+
+// NewTableFunction creates new table function call of dialect with schema Name, function Name, list of function
+// arguments and list of result columns
+func NewTableFunction(dialect jet.Dialect, schemaName, name string, args []jet.Expression, column jet.ColumnExpression, columns ...jet.ColumnExpression) TableFunction {
+
+	f := &tableFunctionImpl{
+		SerializerTable: jet.NewTableFunction(schemaName, name, args, column, columns...),
+	}
+
+	f.readableTableInterfaceImpl = readableTableInterfaceImpl{dialect: dialect, parent: f}
+
+	return f
+}
+
+type joinTable struct {
+	readableTableInterfaceImpl
+	jet.JoinTable
+}
+
+func newJoinTable(dialect jet.Dialect, lhs jet.Serializer, rhs jet.Serializer, joinType jet.JoinType, onCondition jet.BoolExpression) ReadableTable {
+	newJoinTable := &joinTable{
+		JoinTable: jet.NewJoinTable(lhs, rhs, joinType, onCondition),
+	}
+
+	newJoinTable.readableTableInterfaceImpl = readableTableInterfaceImpl{dialect: dialect, parent: newJoinTable}
+
+	return newJoinTable
+}
+
+// ConditionalJoin returns table joined by join function if include is true, otherwise table unchanged.
+// join is called only if include is true.
+func ConditionalJoin(include bool, table ReadableTable, join func(table ReadableTable) ReadableTable) ReadableTable {
+	if !include {
+		return table
+	}
+
+	return join(table)
+}
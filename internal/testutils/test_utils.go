@@ -159,6 +159,13 @@ func AssertQueryPanicErr(t *testing.T, stmt jet.Statement, db qrm.DB, dest inter
 	stmt.Query(db, dest)
 }
 
+// AssertQueryErr check if statement Query execution returns error with errString
+func AssertQueryErr(t *testing.T, stmt jet.Statement, db qrm.DB, dest interface{}, errString string) {
+	err := stmt.Query(db, dest)
+
+	assert.Error(t, err, errString)
+}
+
 // AssertFileContent check if file content at filePath contains expectedContent text.
 func AssertFileContent(t *testing.T, filePath string, contentBegin string, expectedContent string) {
 	enumFileData, err := ioutil.ReadFile(filePath)
@@ -1,11 +1,14 @@
 package utils
 
 import (
+	"bytes"
 	"database/sql"
 	"fmt"
 	"github.com/go-jet/jet/internal/3rdparty/snaker"
 	"go/format"
+	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
 	"reflect"
 	"strings"
@@ -21,23 +24,28 @@ func ToGoFileName(databaseIdentifier string) string {
 	return strings.ToLower(replaceInvalidChars(databaseIdentifier))
 }
 
-// SaveGoFile saves go file at folder dir, with name fileName and contents text.
+// SaveGoFile saves go file at folder dir, with name fileName and contents text. Existing file is not rewritten if
+// its content is equal to formatted text.
 func SaveGoFile(dirPath, fileName string, text []byte) error {
 	newGoFilePath := filepath.Join(dirPath, fileName) + ".go"
 
-	file, err := os.Create(newGoFilePath)
-
+	p, err := format.Source(text)
 	if err != nil {
 		return err
 	}
 
-	defer file.Close()
+	if existing, err := ioutil.ReadFile(newGoFilePath); err == nil && bytes.Equal(existing, p) {
+		return nil
+	}
+
+	file, err := os.Create(newGoFilePath)
 
-	p, err := format.Source(text)
 	if err != nil {
 		return err
 	}
 
+	defer file.Close()
+
 	_, err = file.Write(p)
 
 	if err != nil {
@@ -79,6 +87,46 @@ func CleanUpGeneratedFiles(dir string) error {
 	return nil
 }
 
+// GoImportPath returns Go import path of package at dirPath, resolved from the closest go.mod file found in
+// dirPath or any of its parent directories. Returns empty string if go.mod file can not be found.
+func GoImportPath(dirPath string) (string, error) {
+	absDirPath, err := filepath.Abs(dirPath)
+
+	if err != nil {
+		return "", err
+	}
+
+	for moduleDir := absDirPath; ; moduleDir = filepath.Dir(moduleDir) {
+		goMod, err := ioutil.ReadFile(filepath.Join(moduleDir, "go.mod"))
+
+		if err == nil {
+			for _, line := range strings.Split(string(goMod), "\n") {
+				fields := strings.Fields(line)
+
+				if len(fields) == 2 && fields[0] == "module" {
+					relPath, err := filepath.Rel(moduleDir, absDirPath)
+
+					if err != nil {
+						return "", err
+					}
+
+					return path.Join(strings.Trim(fields[1], `"`), filepath.ToSlash(relPath)), nil
+				}
+			}
+
+			return "", nil
+		}
+
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+
+		if filepath.Dir(moduleDir) == moduleDir {
+			return "", nil
+		}
+	}
+}
+
 // DBClose closes non nil db connection
 func DBClose(db *sql.DB) {
 	if db == nil {
@@ -3,6 +3,9 @@ package utils
 import (
 	"fmt"
 	"gotest.tools/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -48,3 +51,20 @@ func TestErrorCatchNonErr(t *testing.T) {
 
 	assert.Error(t, err, "11")
 }
+
+func TestGoImportPath(t *testing.T) {
+	moduleDir, err := ioutil.TempDir("", "jet")
+	assert.NilError(t, err)
+	defer os.RemoveAll(moduleDir)
+
+	importPath, err := GoImportPath(filepath.Join(moduleDir, "gen", "model"))
+	assert.NilError(t, err)
+	assert.Equal(t, importPath, "")
+
+	err = ioutil.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module github.com/org/project\n\ngo 1.13\n"), 0644)
+	assert.NilError(t, err)
+
+	importPath, err = GoImportPath(filepath.Join(moduleDir, "gen", "jetdb", "dvds", "model"))
+	assert.NilError(t, err)
+	assert.Equal(t, importPath, "github.com/org/project/gen/jetdb/dvds/model")
+}
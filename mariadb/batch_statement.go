@@ -0,0 +1,9 @@
+package mariadb
+
+import "github.com/go-jet/jet/internal/jet"
+
+// BATCH creates batch of MariaDB statements, executed as single query in one database round trip.
+// Result set of each statement is mapped into its own destination.
+func BATCH(statements ...Statement) BatchStatement {
+	return jet.NewBatchStatement(Dialect, statements...)
+}
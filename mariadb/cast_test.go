@@ -0,0 +1,13 @@
+package mariadb
+
+import (
+	"testing"
+)
+
+func TestCAST(t *testing.T) {
+	assertClauseSerialize(t, CAST(Int(22)).AS_CHAR(10), `CAST(? AS CHAR(10))`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_SIGNED(), `CAST(? AS SIGNED)`)
+	assertClauseSerialize(t, CAST(String("a")).AS_UUID(), `CAST(? AS UUID)`)
+	assertClauseSerialize(t, CAST(String("a")).AS_INET4(), `CAST(? AS INET4)`)
+	assertClauseSerialize(t, CAST(String("a")).AS_INET6(), `CAST(? AS INET6)`)
+}
@@ -0,0 +1,58 @@
+package mariadb
+
+import "github.com/go-jet/jet/mysql"
+
+// Column is common column interface for all types of columns.
+type Column = mysql.Column
+
+// ColumnList function returns list of columns that be used as projection or column list for UPDATE and INSERT statement.
+type ColumnList = mysql.ColumnList
+
+// ColumnBool is interface for SQL boolean columns.
+type ColumnBool = mysql.ColumnBool
+
+// BoolColumn creates named bool column.
+var BoolColumn = mysql.BoolColumn
+
+// ColumnString is interface for SQL text, character, character varying
+// bytea, uuid columns and enums types.
+type ColumnString = mysql.ColumnString
+
+// StringColumn creates named string column.
+var StringColumn = mysql.StringColumn
+
+// ColumnInteger is interface for SQL smallint, integer, bigint columns.
+type ColumnInteger = mysql.ColumnInteger
+
+// IntegerColumn creates named integer column.
+var IntegerColumn = mysql.IntegerColumn
+
+// ColumnFloat is interface for SQL real, numeric, decimal or double precision column.
+type ColumnFloat = mysql.ColumnFloat
+
+// FloatColumn creates named float column.
+var FloatColumn = mysql.FloatColumn
+
+// ColumnTime is interface for SQL time column.
+type ColumnTime = mysql.ColumnTime
+
+// TimeColumn creates named time column
+var TimeColumn = mysql.TimeColumn
+
+// ColumnDate is interface of SQL date columns.
+type ColumnDate = mysql.ColumnDate
+
+// DateColumn creates named date column.
+var DateColumn = mysql.DateColumn
+
+// ColumnDateTime is interface of SQL timestamp columns.
+type ColumnDateTime = mysql.ColumnDateTime
+
+// DateTimeColumn creates named timestamp column
+var DateTimeColumn = mysql.DateTimeColumn
+
+// ColumnTimestamp is interface of SQL timestamp columns.
+type ColumnTimestamp = mysql.ColumnTimestamp
+
+// TimestampColumn creates named timestamp column
+var TimestampColumn = mysql.TimestampColumn
@@ -0,0 +1,102 @@
+package mariadb
+
+import (
+	"context"
+	"github.com/go-jet/jet/internal/jet"
+	"github.com/go-jet/jet/qrm"
+)
+
+// DeleteStatement is interface for MariaDB DELETE statement
+type DeleteStatement interface {
+	Statement
+	jet.ReturningStatement
+
+	WHERE(expression BoolExpression) DeleteStatement
+	ORDER_BY(orderByClauses ...jet.OrderByClause) DeleteStatement
+	LIMIT(limit int64) DeleteStatement
+	RETURNING(projections ...jet.Projection) DeleteStatement
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() DeleteStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() DeleteStatement
+}
+
+type deleteStatementImpl struct {
+	jet.SerializerStatement
+
+	Delete    jet.ClauseStatementBegin
+	Where     jet.ClauseWhere
+	OrderBy   jet.ClauseOrderBy
+	Limit     jet.ClauseLimit
+	Returning jet.ClauseReturning
+}
+
+func newDeleteStatement(table Table) DeleteStatement {
+	newDelete := &deleteStatementImpl{}
+	newDelete.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DeleteStatementType, newDelete, &newDelete.Delete,
+		&newDelete.Where, &newDelete.OrderBy, &newDelete.Limit, &newDelete.Returning)
+
+	newDelete.Delete.Name = "DELETE FROM"
+	newDelete.Delete.Tables = append(newDelete.Delete.Tables, table)
+	newDelete.Where.Mandatory = true
+	newDelete.Limit.Count = -1
+
+	return newDelete
+}
+
+func (d *deleteStatementImpl) WHERE(expression BoolExpression) DeleteStatement {
+	d = d.edit()
+	d.Where.Condition = expression
+	return d
+}
+
+func (d *deleteStatementImpl) ORDER_BY(orderByClauses ...jet.OrderByClause) DeleteStatement {
+	d = d.edit()
+	d.OrderBy.List = orderByClauses
+	return d
+}
+
+func (d *deleteStatementImpl) LIMIT(limit int64) DeleteStatement {
+	d = d.edit()
+	d.Limit.Count = limit
+	return d
+}
+
+func (d *deleteStatementImpl) RETURNING(projections ...jet.Projection) DeleteStatement {
+	d = d.edit()
+	d.Returning.Projections = projections
+	return d
+}
+
+func (d *deleteStatementImpl) ExecReturning(ctx context.Context, db qrm.DB, destination interface{}) (int64, error) {
+	return jet.ExecReturning(ctx, db, d, d.Returning, destination)
+}
+
+func (d *deleteStatementImpl) Clone() DeleteStatement {
+	return d.clone()
+}
+
+func (d *deleteStatementImpl) Immutable() DeleteStatement {
+	newDelete := d.clone()
+	jet.SetImmutable(newDelete.SerializerStatement)
+
+	return newDelete
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (d *deleteStatementImpl) edit() *deleteStatementImpl {
+	if !jet.IsImmutable(d.SerializerStatement) {
+		return d
+	}
+
+	return d.clone()
+}
+
+func (d *deleteStatementImpl) clone() *deleteStatementImpl {
+	newDelete := newDeleteStatement(nil).(*deleteStatementImpl)
+	jet.CloneStatement(newDelete.SerializerStatement, d.SerializerStatement)
+
+	return newDelete
+}
@@ -0,0 +1,26 @@
+package mariadb
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+	"github.com/go-jet/jet/mysql"
+)
+
+// Dialect is implementation of MariaDB dialect for SQL Builder serialisation.
+// MariaDB statements are serialized the same way as MySQL statements.
+var Dialect jet.Dialect = &dialect{Dialect: mysql.Dialect}
+
+type dialect struct {
+	jet.Dialect
+}
+
+func (d *dialect) Name() string {
+	return "MariaDB"
+}
+
+func (d *dialect) PackageName() string {
+	return "mariadb"
+}
+
+func (d *dialect) Supports(capability jet.Capability) bool {
+	return capability == jet.CapabilityReturning
+}
@@ -0,0 +1,20 @@
+package mariadb
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestDialect(t *testing.T) {
+	assert.Equal(t, Dialect.Name(), "MariaDB")
+	assert.Equal(t, Dialect.PackageName(), "mariadb")
+}
+
+func TestBoolExpressionIS_DISTINCT_FROM(t *testing.T) {
+	assertClauseSerialize(t, table1ColBool.IS_DISTINCT_FROM(table2ColBool), "(NOT(table1.col_bool <=> table2.col_bool))")
+}
+
+func TestStringCONCAT(t *testing.T) {
+	assertClauseSerialize(t, table3StrCol.CONCAT(table2ColStr), "(CONCAT(table3.col2, table2.col_str))")
+}
@@ -0,0 +1,78 @@
+package mariadb
+
+import "github.com/go-jet/jet/mysql"
+
+// Expression is common interface for all expressions.
+// Can be Bool, Int, Float, String, Date, Time, Timez, Timestamp or Timestampz expressions.
+type Expression = mysql.Expression
+
+// BoolExpression interface
+type BoolExpression = mysql.BoolExpression
+
+// StringExpression interface
+type StringExpression = mysql.StringExpression
+
+// IntegerExpression interface
+type IntegerExpression = mysql.IntegerExpression
+
+// FloatExpression interface
+type FloatExpression = mysql.FloatExpression
+
+// TimeExpression interface
+type TimeExpression = mysql.TimeExpression
+
+// DateExpression interface
+type DateExpression = mysql.DateExpression
+
+// DateTimeExpression interface
+type DateTimeExpression = mysql.DateTimeExpression
+
+// TimestampExpression interface
+type TimestampExpression = mysql.TimestampExpression
+
+// BoolExp is bool expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as bool expression.
+// Does not add sql cast to generated sql builder output.
+var BoolExp = mysql.BoolExp
+
+// StringExp is string expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as string expression.
+// Does not add sql cast to generated sql builder output.
+var StringExp = mysql.StringExp
+
+// IntExp is int expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as int expression.
+// Does not add sql cast to generated sql builder output.
+var IntExp = mysql.IntExp
+
+// FloatExp is date expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as float expression.
+// Does not add sql cast to generated sql builder output.
+var FloatExp = mysql.FloatExp
+
+// TimeExp is time expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as time expression.
+// Does not add sql cast to generated sql builder output.
+var TimeExp = mysql.TimeExp
+
+// DateExp is date expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as date expression.
+// Does not add sql cast to generated sql builder output.
+var DateExp = mysql.DateExp
+
+// DateTimeExp is timestamp expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timestamp expression.
+// Does not add sql cast to generated sql builder output.
+var DateTimeExp = mysql.DateTimeExp
+
+// TimestampExp is timestamp expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timestamp expression.
+// Does not add sql cast to generated sql builder output.
+var TimestampExp = mysql.TimestampExp
+
+// Raw can be used for any unsupported functions, operators or expressions.
+// For example: Raw("current_database()")
+var Raw = mysql.Raw
+
+// NewEnumValue creates new named enum value
+var NewEnumValue = mysql.NewEnumValue
@@ -0,0 +1,280 @@
+package mariadb
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+	"github.com/go-jet/jet/mysql"
+)
+
+// ROW is construct one table row from list of expressions.
+var ROW = mysql.ROW
+
+// ------------------ Mathematical functions ---------------//
+
+// ABSf calculates absolute value from float expression
+var ABSf = mysql.ABSf
+
+// ABSi calculates absolute value from int expression
+var ABSi = mysql.ABSi
+
+// POW calculates power of base with exponent
+var POW = mysql.POW
+
+// POWER calculates power of base with exponent
+var POWER = mysql.POWER
+
+// SQRT calculates square root of numeric expression
+var SQRT = mysql.SQRT
+
+// CBRT calculates cube root of numeric expression
+var CBRT = mysql.CBRT
+
+// CEIL calculates ceil of float expression
+var CEIL = mysql.CEIL
+
+// FLOOR calculates floor of float expression
+var FLOOR = mysql.FLOOR
+
+// ROUND calculates round of a float expressions with optional precision
+var ROUND = mysql.ROUND
+
+// SIGN returns sign of float expression
+var SIGN = mysql.SIGN
+
+// TRUNC calculates trunc of float expression with precision
+var TRUNC = mysql.TRUNC
+
+// TRUNCATE calculates trunc of float expression with precision
+var TRUNCATE = mysql.TRUNCATE
+
+// LN calculates natural algorithm of float expression
+var LN = mysql.LN
+
+// LOG calculates logarithm of float expression
+var LOG = mysql.LOG
+
+// ----------------- Aggregate functions  -------------------//
+
+// AVG is aggregate function used to calculate avg value from numeric expression
+var AVG = mysql.AVG
+
+// BIT_AND is aggregate function used to calculates the bitwise AND of all non-null input values, or null if none.
+var BIT_AND = mysql.BIT_AND
+
+// BIT_OR is aggregate function used to calculates the bitwise OR of all non-null input values, or null if none.
+var BIT_OR = mysql.BIT_OR
+
+// COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
+var COUNT = mysql.COUNT
+
+// MAX is aggregate function. Returns maximum value of expression across all input values
+var MAX = mysql.MAX
+
+// MAXi is aggregate function. Returns maximum value of int expression across all input values
+var MAXi = mysql.MAXi
+
+// MAXf is aggregate function. Returns maximum value of float expression across all input values
+var MAXf = mysql.MAXf
+
+// MIN is aggregate function. Returns minimum value of int expression across all input values
+var MIN = mysql.MIN
+
+// MINi is aggregate function. Returns minimum value of int expression across all input values
+var MINi = mysql.MINi
+
+// MINf is aggregate function. Returns minimum value of float expression across all input values
+var MINf = mysql.MINf
+
+// SUMi is aggregate function. Returns sum of integer expression.
+var SUMi = mysql.SUMi
+
+// SUMf is aggregate function. Returns sum of float expression.
+var SUMf = mysql.SUMf
+
+// -------------------- Window functions -----------------------//
+
+// ROW_NUMBER returns number of the current row within its partition, counting from 1
+var ROW_NUMBER = mysql.ROW_NUMBER
+
+// RANK of the current row with gaps; same as row_number of its first peer
+var RANK = mysql.RANK
+
+// DENSE_RANK returns rank of the current row without gaps; this function counts peer groups
+var DENSE_RANK = mysql.DENSE_RANK
+
+// PERCENT_RANK calculates relative rank of the current row: (rank - 1) / (total partition rows - 1)
+var PERCENT_RANK = mysql.PERCENT_RANK
+
+// CUME_DIST calculates cumulative distribution: (number of partition rows preceding or peer with current row) / total partition rows
+var CUME_DIST = mysql.CUME_DIST
+
+// NTILE returns integer ranging from 1 to the argument value, dividing the partition as equally as possible
+var NTILE = mysql.NTILE
+
+// LAG returns value evaluated at the row that is offset rows before the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+// Both offset and default are evaluated with respect to the current row.
+// If omitted, offset defaults to 1 and default to null
+var LAG = mysql.LAG
+
+// LEAD returns value evaluated at the row that is offset rows after the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+// Both offset and default are evaluated with respect to the current row.
+// If omitted, offset defaults to 1 and default to null
+var LEAD = mysql.LEAD
+
+// FIRST_VALUE returns value evaluated at the row that is the first row of the window frame
+var FIRST_VALUE = mysql.FIRST_VALUE
+
+// LAST_VALUE returns value evaluated at the row that is the last row of the window frame
+var LAST_VALUE = mysql.LAST_VALUE
+
+// NTH_VALUE returns value evaluated at the row that is the nth row of the window frame (counting from 1); null if no such row
+var NTH_VALUE = mysql.NTH_VALUE
+
+//--------------------- String functions ------------------//
+
+// BIT_LENGTH returns number of bits in string expression
+var BIT_LENGTH = mysql.BIT_LENGTH
+
+// CHAR_LENGTH returns number of characters in string expression
+var CHAR_LENGTH = mysql.CHAR_LENGTH
+
+// OCTET_LENGTH returns number of bytes in string expression
+var OCTET_LENGTH = mysql.OCTET_LENGTH
+
+// LOWER returns string expression in lower case
+var LOWER = mysql.LOWER
+
+// UPPER returns string expression in upper case
+var UPPER = mysql.UPPER
+
+// LTRIM removes the longest string containing only characters
+// from characters (a space by default) from the start of string
+var LTRIM = mysql.LTRIM
+
+// RTRIM removes the longest string containing only characters
+// from characters (a space by default) from the end of string
+var RTRIM = mysql.RTRIM
+
+// CONCAT adds two or more expressions together
+var CONCAT = mysql.CONCAT
+
+// CONCAT_WS adds two or more expressions together with a separator.
+var CONCAT_WS = mysql.CONCAT_WS
+
+// FORMAT formats a number to a format like "#,###,###.##", rounded to a specified number of decimal places, then it returns the result as a string.
+var FORMAT = mysql.FORMAT
+
+// LEFT returns first n characters in the string.
+// When n is negative, return all but last |n| characters.
+var LEFT = mysql.LEFT
+
+// RIGHT returns last n characters in the string.
+// When n is negative, return all but first |n| characters.
+var RIGHT = mysql.RIGHT
+
+// LENGTH returns number of characters in string with a given encoding
+var LENGTH = mysql.LENGTH
+
+// LPAD fills up the string to length length by prepending the characters
+// fill (a space by default). If the string is already longer than length
+// then it is truncated (on the right).
+var LPAD = mysql.LPAD
+
+// RPAD fills up the string to length length by appending the characters
+// fill (a space by default). If the string is already longer than length then it is truncated.
+var RPAD = mysql.RPAD
+
+// MD5 calculates the MD5 hash of string, returning the result in hexadecimal
+var MD5 = mysql.MD5
+
+// REPEAT repeats string the specified number of times
+var REPEAT = mysql.REPEAT
+
+// REPLACE replaces all occurrences in string of substring from with substring to
+var REPLACE = mysql.REPLACE
+
+// REVERSE returns reversed string.
+var REVERSE = mysql.REVERSE
+
+// SUBSTR extracts substring
+var SUBSTR = mysql.SUBSTR
+
+// REGEXP_LIKE Returns 1 if the string expr matches the regular expression specified by the pattern pat, 0 otherwise.
+var REGEXP_LIKE = mysql.REGEXP_LIKE
+
+//----------------- Date/Time Functions and Operators ------------//
+
+// CURRENT_DATE returns current date
+var CURRENT_DATE = mysql.CURRENT_DATE
+
+// CURRENT_TIME returns current time with time zone
+var CURRENT_TIME = mysql.CURRENT_TIME
+
+// CURRENT_TIMESTAMP returns current timestamp with time zone
+var CURRENT_TIMESTAMP = mysql.CURRENT_TIMESTAMP
+
+// NOW returns current datetime
+var NOW = mysql.NOW
+
+// TIMESTAMP return a datetime value based on the arguments:
+var TIMESTAMP = mysql.TIMESTAMP
+
+// UNIX_TIMESTAMP returns unix timestamp
+var UNIX_TIMESTAMP = mysql.UNIX_TIMESTAMP
+
+//----------- Comparison operators ---------------//
+
+// EXISTS checks for existence of the rows in subQuery
+var EXISTS = mysql.EXISTS
+
+// CASE create CASE operator with optional list of expressions
+var CASE = mysql.CASE
+
+//----------------- Bit operators ---------------//
+
+// BIT_NOT inverts every bit in integer expression
+var BIT_NOT = mysql.BIT_NOT
+
+// AND joins conditions with AND operator, skipping nil conditions
+var AND = mysql.AND
+
+// OR joins conditions with OR operator, skipping nil conditions
+var OR = mysql.OR
+
+// Conditional returns condition if include is true, otherwise nil. Condition is evaluated even if include is false.
+var Conditional = mysql.Conditional
+
+// ConditionalFunc returns condition created by conditionFunc if include is true, otherwise nil.
+// conditionFunc is called only if include is true.
+var ConditionalFunc = mysql.ConditionalFunc
+
+// ConditionalOrderBy returns orderBy if include is true, otherwise nil. Nil clauses are skipped in ORDER BY clause.
+var ConditionalOrderBy = mysql.ConditionalOrderBy
+
+//----------------- UUID and network functions ---------------//
+
+// UUID returns new universal unique identifier
+func UUID() StringExpression {
+	return jet.NewStringFunc("UUID")
+}
+
+// INET6_ATON converts IPv6 or IPv4 network address string into binary string
+func INET6_ATON(address StringExpression) StringExpression {
+	return jet.NewStringFunc("INET6_ATON", address)
+}
+
+// INET6_NTOA converts binary IPv6 or IPv4 network address into string representation
+func INET6_NTOA(address StringExpression) StringExpression {
+	return jet.NewStringFunc("INET6_NTOA", address)
+}
+
+// INET_ATON converts IPv4 network address string into integer
+func INET_ATON(address StringExpression) IntegerExpression {
+	return jet.NewIntegerFunc("INET_ATON", address)
+}
+
+// INET_NTOA converts IPv4 network address integer into string representation
+func INET_NTOA(address IntegerExpression) StringExpression {
+	return jet.NewStringFunc("INET_NTOA", address)
+}
@@ -0,0 +1,13 @@
+package mariadb
+
+import (
+	"testing"
+)
+
+func TestUUIDAndNetworkFunctions(t *testing.T) {
+	assertClauseSerialize(t, UUID(), "UUID()")
+	assertClauseSerialize(t, INET6_ATON(String("::1")), "INET6_ATON(?)", "::1")
+	assertClauseSerialize(t, INET6_NTOA(table3StrCol), "INET6_NTOA(table3.col2)")
+	assertClauseSerialize(t, INET_ATON(String("10.0.0.1")), "INET_ATON(?)", "10.0.0.1")
+	assertClauseSerialize(t, INET_NTOA(table3ColInt), "INET_NTOA(table3.col_int)")
+}
@@ -0,0 +1,110 @@
+package mariadb
+
+import (
+	"context"
+	"github.com/go-jet/jet/internal/jet"
+	"github.com/go-jet/jet/qrm"
+)
+
+// InsertStatement is interface for SQL INSERT statements
+type InsertStatement interface {
+	Statement
+	jet.ReturningStatement
+
+	// Insert row of values
+	VALUES(value interface{}, values ...interface{}) InsertStatement
+	// Insert row of values, where value for each column is extracted from filed of structure data.
+	// If data is not struct or there is no field for every column selected, this method will panic.
+	MODEL(data interface{}) InsertStatement
+	MODELS(data interface{}) InsertStatement
+
+	QUERY(selectStatement SelectStatement) InsertStatement
+
+	RETURNING(projections ...jet.Projection) InsertStatement
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() InsertStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() InsertStatement
+}
+
+func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
+	newInsert := &insertStatementImpl{}
+	newInsert.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newInsert,
+		&newInsert.Insert, &newInsert.ValuesQuery, &newInsert.Returning)
+
+	newInsert.Insert.Table = table
+	newInsert.Insert.Columns = columns
+
+	return newInsert
+}
+
+type insertStatementImpl struct {
+	jet.SerializerStatement
+
+	Insert      jet.ClauseInsert
+	ValuesQuery jet.ClauseValuesQuery
+	Returning   jet.ClauseReturning
+}
+
+func (i *insertStatementImpl) VALUES(value interface{}, values ...interface{}) InsertStatement {
+	i = i.edit()
+	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowFromValues(value, values))
+	return i
+}
+
+func (i *insertStatementImpl) MODEL(data interface{}) InsertStatement {
+	i = i.edit()
+	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowFromModel(i.Insert.GetColumns(), data))
+	return i
+}
+
+func (i *insertStatementImpl) MODELS(data interface{}) InsertStatement {
+	i = i.edit()
+	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowsFromModels(i.Insert.GetColumns(), data)...)
+	return i
+}
+
+func (i *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertStatement {
+	i = i.edit()
+	i.ValuesQuery.Query = selectStatement
+	return i
+}
+
+func (i *insertStatementImpl) RETURNING(projections ...jet.Projection) InsertStatement {
+	i = i.edit()
+	i.Returning.Projections = projections
+	return i
+}
+
+func (i *insertStatementImpl) ExecReturning(ctx context.Context, db qrm.DB, destination interface{}) (int64, error) {
+	return jet.ExecReturning(ctx, db, i, i.Returning, destination)
+}
+
+func (i *insertStatementImpl) Clone() InsertStatement {
+	return i.clone()
+}
+
+func (i *insertStatementImpl) Immutable() InsertStatement {
+	newInsert := i.clone()
+	jet.SetImmutable(newInsert.SerializerStatement)
+
+	return newInsert
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (i *insertStatementImpl) edit() *insertStatementImpl {
+	if !jet.IsImmutable(i.SerializerStatement) {
+		return i
+	}
+
+	return i.clone()
+}
+
+func (i *insertStatementImpl) clone() *insertStatementImpl {
+	newInsert := newInsertStatement(nil, nil).(*insertStatementImpl)
+	jet.CloneStatement(newInsert.SerializerStatement, i.SerializerStatement)
+
+	return newInsert
+}
@@ -0,0 +1,27 @@
+package mariadb
+
+import (
+	"testing"
+)
+
+func TestInvalidInsert(t *testing.T) {
+	assertStatementSqlErr(t, table1.INSERT(table1Col1), "jet: VALUES or QUERY has to be specified for INSERT statement")
+}
+
+func TestInsertValues(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1, table1ColFloat).VALUES(1, 2.1), `
+INSERT INTO db.table1 (col1, col_float) VALUES
+     (?, ?);
+`, 1, 2.1)
+}
+
+func TestInsertReturning(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1, table1ColFloat).
+		VALUES(1, 2.1).
+		RETURNING(table1Col1, table1ColFloat), `
+INSERT INTO db.table1 (col1, col_float) VALUES
+     (?, ?)
+RETURNING table1.col1 AS "table1.col1",
+          table1.col_float AS "table1.col_float";
+`, 1, 2.1)
+}
@@ -0,0 +1,70 @@
+package mariadb
+
+import "github.com/go-jet/jet/mysql"
+
+// Keywords
+var (
+	STAR    = mysql.STAR
+	NULL    = mysql.NULL
+	DEFAULT = mysql.DEFAULT
+)
+
+// Bool creates new bool literal expression
+var Bool = mysql.Bool
+
+// Int is constructor for integer expressions literals.
+var Int = mysql.Int
+
+// Float creates new float literal expression
+var Float = mysql.Float
+
+// String creates new string literal expression
+var String = mysql.String
+
+// Date creates new date literal
+var Date = mysql.Date
+
+// DateT creates new date literal from time.Time
+var DateT = mysql.DateT
+
+// Time creates new time literal
+var Time = mysql.Time
+
+// TimeT creates new time literal from time.Time
+var TimeT = mysql.TimeT
+
+// DateTime creates new datetime literal
+var DateTime = mysql.DateTime
+
+// DateTimeT creates new datetime literal from time.Time
+var DateTimeT = mysql.DateTimeT
+
+// Timestamp creates new timestamp literal
+var Timestamp = mysql.Timestamp
+
+// TimestampT creates new timestamp literal from time.Time
+var TimestampT = mysql.TimestampT
+
+// BoolParam creates named bool parameter, bound when prepared statement is executed
+var BoolParam = mysql.BoolParam
+
+// IntParam creates named integer parameter, bound when prepared statement is executed
+var IntParam = mysql.IntParam
+
+// FloatParam creates named float parameter, bound when prepared statement is executed
+var FloatParam = mysql.FloatParam
+
+// StringParam creates named string parameter, bound when prepared statement is executed
+var StringParam = mysql.StringParam
+
+// DateParam creates named date parameter, bound when prepared statement is executed
+var DateParam = mysql.DateParam
+
+// TimeParam creates named time parameter, bound when prepared statement is executed
+var TimeParam = mysql.TimeParam
+
+// DateTimeParam creates named datetime parameter, bound when prepared statement is executed
+var DateTimeParam = mysql.DateTimeParam
+
+// TimestampParam creates named timestamp parameter, bound when prepared statement is executed
+var TimestampParam = mysql.TimestampParam
@@ -0,0 +1,92 @@
+package mariadb
+
+import "github.com/go-jet/jet/internal/jet"
+
+// LockStatement is interface for MariaDB LOCK tables
+type LockStatement interface {
+	Statement
+	READ() Statement
+	WRITE() Statement
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() LockStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() LockStatement
+}
+
+// LOCK creates LockStatement from list of tables
+func LOCK(tables ...jet.SerializerTable) LockStatement {
+	newLock := &lockStatementImpl{
+		Lock:  jet.ClauseStatementBegin{Name: "LOCK TABLES", Tables: tables},
+		Read:  jet.ClauseOptional{Name: "READ"},
+		Write: jet.ClauseOptional{Name: "WRITE"},
+	}
+
+	newLock.SerializerStatement = jet.NewStatementImpl(Dialect, jet.LockStatementType, newLock, &newLock.Lock, &newLock.Read, &newLock.Write)
+
+	return newLock
+}
+
+type lockStatementImpl struct {
+	jet.SerializerStatement
+
+	Lock  jet.ClauseStatementBegin
+	Read  jet.ClauseOptional
+	Write jet.ClauseOptional
+}
+
+func (l *lockStatementImpl) READ() Statement {
+	l = l.edit()
+	l.Read.Show = true
+	return l
+}
+
+func (l *lockStatementImpl) WRITE() Statement {
+	l = l.edit()
+	l.Write.Show = true
+	return l
+}
+
+func (l *lockStatementImpl) Clone() LockStatement {
+	return l.clone()
+}
+
+func (l *lockStatementImpl) Immutable() LockStatement {
+	newLock := l.clone()
+	jet.SetImmutable(newLock.SerializerStatement)
+
+	return newLock
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (l *lockStatementImpl) edit() *lockStatementImpl {
+	if !jet.IsImmutable(l.SerializerStatement) {
+		return l
+	}
+
+	return l.clone()
+}
+
+func (l *lockStatementImpl) clone() *lockStatementImpl {
+	newLock := LOCK().(*lockStatementImpl)
+	jet.CloneStatement(newLock.SerializerStatement, l.SerializerStatement)
+
+	return newLock
+}
+
+// UNLOCK_TABLES explicitly releases any table locks held by the current session
+func UNLOCK_TABLES() Statement {
+	newUnlock := &unlockStatementImpl{
+		Unlock: jet.ClauseStatementBegin{Name: "UNLOCK TABLES"},
+	}
+
+	newUnlock.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UnLockStatementType, newUnlock, &newUnlock.Unlock)
+
+	return newUnlock
+}
+
+type unlockStatementImpl struct {
+	jet.SerializerStatement
+	Unlock jet.ClauseStatementBegin
+}
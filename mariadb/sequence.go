@@ -0,0 +1,65 @@
+package mariadb
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+	"strings"
+)
+
+// Sequence is interface for MariaDB sequences
+type Sequence interface {
+	SchemaName() string
+	SequenceName() string
+
+	// NEXTVAL advances sequence and returns new value
+	NEXTVAL() IntegerExpression
+	// LASTVAL returns value most recently obtained with NEXTVAL for this sequence in the current session
+	LASTVAL() IntegerExpression
+	// SETVAL sets sequence current value and returns it
+	SETVAL(value IntegerExpression) IntegerExpression
+}
+
+// NewSequence creates new sequence with schema name and sequence name
+func NewSequence(schemaName, name string) Sequence {
+	return &sequenceImpl{
+		schemaName: schemaName,
+		name:       name,
+	}
+}
+
+type sequenceImpl struct {
+	schemaName string
+	name       string
+}
+
+func (s *sequenceImpl) SchemaName() string {
+	return s.schemaName
+}
+
+func (s *sequenceImpl) SequenceName() string {
+	return s.name
+}
+
+func (s *sequenceImpl) NEXTVAL() IntegerExpression {
+	return jet.NewIntegerFunc("NEXTVAL", s.identifier())
+}
+
+func (s *sequenceImpl) LASTVAL() IntegerExpression {
+	return jet.NewIntegerFunc("LASTVAL", s.identifier())
+}
+
+func (s *sequenceImpl) SETVAL(value IntegerExpression) IntegerExpression {
+	return jet.NewIntegerFunc("SETVAL", s.identifier(), value)
+}
+
+// identifier returns sequence name qualified with schema name, for instance db.`Order Seq`
+func (s *sequenceImpl) identifier() Expression {
+	return Raw(quoteIdentifier(s.schemaName) + "." + quoteIdentifier(s.name))
+}
+
+func quoteIdentifier(name string) string {
+	if name == strings.ToLower(name) && !strings.ContainsAny(name, " .-`") {
+		return name
+	}
+
+	return "`" + strings.Replace(name, "`", "``", -1) + "`"
+}
@@ -0,0 +1,16 @@
+package mariadb
+
+import "testing"
+
+func TestSequence(t *testing.T) {
+	sequence := NewSequence("db", "table1_seq")
+
+	assertClauseSerialize(t, sequence.NEXTVAL(), "NEXTVAL(db.table1_seq)")
+	assertClauseSerialize(t, sequence.LASTVAL(), "LASTVAL(db.table1_seq)")
+	assertClauseSerialize(t, sequence.SETVAL(Int(11)), "SETVAL(db.table1_seq, ?)", int64(11))
+	assertClauseSerialize(t, NewSequence("db", "Table1Seq").NEXTVAL(), "NEXTVAL(db.`Table1Seq`)")
+
+	assertStatementSql(t, SELECT(sequence.NEXTVAL().AS("next")), `
+SELECT NEXTVAL(db.table1_seq) AS "next";
+`)
+}
@@ -0,0 +1,44 @@
+package mariadb
+
+import "github.com/go-jet/jet/mysql"
+
+// Statement is common interface for all statements(SELECT, INSERT, UPDATE, DELETE, LOCK)
+type Statement = mysql.Statement
+
+// FormatOption changes format of sql query returned by Sql and DebugSql
+type FormatOption = mysql.FormatOption
+
+// Sql query formats
+const (
+	Pretty     = mysql.Pretty
+	Compact    = mysql.Compact
+	Normalized = mysql.Normalized
+)
+
+// SerializeError is returned when statement can not be serialized
+type SerializeError = mysql.SerializeError
+
+// SetStrictMode turns on or off strict mode. In strict mode invalid statements and destinations panic,
+// instead of returning an error.
+var SetStrictMode = mysql.SetStrictMode
+
+// ExplainMapping explains how statement projections would be mapped onto destination, without running the statement
+var ExplainMapping = mysql.ExplainMapping
+
+// PreparedStatement is statement prepared over database connection, executed with named parameter values
+type PreparedStatement = mysql.PreparedStatement
+
+// StatementCache is bounded cache of statements prepared on database connection pool
+type StatementCache = mysql.StatementCache
+
+// NewStatementCache creates new cache of at most size statements prepared on db
+var NewStatementCache = mysql.NewStatementCache
+
+// CompiledStatement is statement serialized once, reused on every execution
+type CompiledStatement = mysql.CompiledStatement
+
+// BatchStatement is list of statements executed as single query, with result set of each statement mapped into its own destination
+type BatchStatement = mysql.BatchStatement
+
+// Projection is interface for all projection types. Types that can be part of, for instance SELECT clause.
+type Projection = mysql.Projection
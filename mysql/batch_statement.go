@@ -0,0 +1,10 @@
+package mysql
+
+import "github.com/go-jet/jet/internal/jet"
+
+// BATCH creates batch of MySQL statements, executed as single query in one database round trip.
+// Result set of each statement is mapped into its own destination. Database connection has to be opened
+// with multiStatements=true and interpolateParams=true options.
+func BATCH(statements ...Statement) BatchStatement {
+	return jet.NewBatchStatement(Dialect, statements...)
+}
@@ -0,0 +1,89 @@
+package mysql
+
+import (
+	"context"
+	"github.com/go-jet/jet/qrm"
+	"gotest.tools/assert"
+	"runtime"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	batch := BATCH(
+		SELECT(table1ColInt).FROM(table1).WHERE(table1ColInt.GT(Int(1))),
+		table1.UPDATE(table1ColInt).SET(Int(2)).WHERE(table1ColInt.EQ(Int(3))),
+		SELECT(table2ColStr).FROM(table2).WHERE(table2ColStr.EQ(String("str"))),
+	)
+
+	query, args := batch.Sql()
+
+	assert.Equal(t, query, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > ?;
+UPDATE db.table1
+SET col_int = ?
+WHERE table1.col_int = ?;
+SELECT table2.col_str AS "table2.col_str"
+FROM db.table2
+WHERE table2.col_str = ?;
+`)
+	assert.DeepEqual(t, args, []interface{}{int64(1), int64(2), int64(3), "str"})
+
+	assert.Equal(t, batch.DebugSql(Compact), `SELECT table1.col_int AS "table1.col_int" FROM db.table1 WHERE table1.col_int > 1; `+
+		`UPDATE db.table1 SET col_int = 2 WHERE table1.col_int = 3; `+
+		`SELECT table2.col_str AS "table2.col_str" FROM db.table2 WHERE table2.col_str = 'str';`)
+
+	var dest1, dest2, dest3 []struct{}
+	assert.Error(t, batch.Query(nil, &dest1, &dest2, &dest3), "jet: batch has 2 statements that return rows, but 3 destinations")
+}
+
+func TestBatchSetStatement(t *testing.T) {
+	batch := BATCH(
+		SELECT(table1ColInt).FROM(table1),
+		UNION(
+			SELECT(table1ColInt).FROM(table1),
+			SELECT(table2ColInt).FROM(table2),
+		).ORDER_BY(table1ColInt),
+	)
+
+	assert.Equal(t, batch.DebugSql(Compact), `SELECT table1.col_int AS "table1.col_int" FROM db.table1; `+
+		`(SELECT table1.col_int AS "table1.col_int" FROM db.table1) UNION (SELECT table2.col_int AS "table2.col_int" FROM db.table2) `+
+		`ORDER BY "table1.col_int";`)
+}
+
+func TestBatchSqlErr(t *testing.T) {
+	_, _, err := BATCH().SqlErr()
+	assert.Error(t, err, "jet: batch has no statements")
+
+	_, _, err = BATCH(
+		SELECT(table1ColInt).FROM(table1),
+		SELECT(table1ColInt).FROM(table1.INNER_JOIN(table2, nil)),
+	).SqlErr()
+	assert.Error(t, err, "jet: join condition is nil, in FROM clause")
+
+	_, _, err = BATCH(SELECT(table1ColInt).FROM(table1).Compile()).SqlErr()
+	assert.Error(t, err, "jet: compiled statement can not be used in batch")
+
+	_, _, err = BATCH(nil).SqlErr()
+	assert.Error(t, err, "jet: batch statement is nil")
+
+	_, _, err = BATCH(struct{ Statement }{SELECT(table1ColInt).FROM(table1)}).SqlErr()
+	assert.Error(t, err, "jet: statement can not be used in batch")
+
+	var dest []struct{}
+	assert.Error(t, BATCH().Query(nil, &dest), "jet: batch has no statements")
+}
+
+func TestBatchRuntimePanic(t *testing.T) {
+	defer func() {
+		_, isRuntimeError := recover().(runtime.Error)
+		assert.Assert(t, isRuntimeError)
+	}()
+
+	var dest1, dest2 []struct{}
+	_ = BATCH(SELECT(table1ColInt).FROM(table1), SELECT(table2ColInt).FROM(table2)).
+		QueryContext(context.Background(), struct{ qrm.DB }{}, &dest1, &dest2)
+
+	t.Fatal("runtime panic is not propagated")
+}
@@ -9,6 +9,12 @@ type DeleteStatement interface {
 	WHERE(expression BoolExpression) DeleteStatement
 	ORDER_BY(orderByClauses ...jet.OrderByClause) DeleteStatement
 	LIMIT(limit int64) DeleteStatement
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() DeleteStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() DeleteStatement
 }
 
 type deleteStatementImpl struct {
@@ -34,16 +40,46 @@ func newDeleteStatement(table Table) DeleteStatement {
 }
 
 func (d *deleteStatementImpl) WHERE(expression BoolExpression) DeleteStatement {
+	d = d.edit()
 	d.Where.Condition = expression
 	return d
 }
 
 func (d *deleteStatementImpl) ORDER_BY(orderByClauses ...jet.OrderByClause) DeleteStatement {
+	d = d.edit()
 	d.OrderBy.List = orderByClauses
 	return d
 }
 
 func (d *deleteStatementImpl) LIMIT(limit int64) DeleteStatement {
+	d = d.edit()
 	d.Limit.Count = limit
 	return d
 }
+
+func (d *deleteStatementImpl) Clone() DeleteStatement {
+	return d.clone()
+}
+
+func (d *deleteStatementImpl) Immutable() DeleteStatement {
+	newDelete := d.clone()
+	jet.SetImmutable(newDelete.SerializerStatement)
+
+	return newDelete
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (d *deleteStatementImpl) edit() *deleteStatementImpl {
+	if !jet.IsImmutable(d.SerializerStatement) {
+		return d
+	}
+
+	return d.clone()
+}
+
+func (d *deleteStatementImpl) clone() *deleteStatementImpl {
+	newDelete := newDeleteStatement(nil).(*deleteStatementImpl)
+	jet.CloneStatement(newDelete.SerializerStatement, d.SerializerStatement)
+
+	return newDelete
+}
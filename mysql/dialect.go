@@ -1,7 +1,9 @@
 package mysql
 
 import (
+	"encoding/hex"
 	"github.com/go-jet/jet/internal/jet"
+	"time"
 )
 
 // Dialect is implementation of MySQL dialect for SQL Builder serialisation.
@@ -27,11 +29,24 @@ func newDialect() jet.Dialect {
 		ArgumentPlaceholder: func(int) string {
 			return "?"
 		},
+		ArgumentToString: mysqlArgumentToString,
 	}
 
 	return jet.NewDialect(mySQLDialectParams)
 }
 
+func mysqlArgumentToString(value interface{}) (string, bool) {
+	switch bindVal := value.(type) {
+	case []byte:
+		return "X'" + hex.EncodeToString(bindVal) + "'", true
+	case time.Time:
+		// MySQL datetime literals do not have time zone, time is in UTC as with default MySQL driver location
+		return "'" + bindVal.UTC().Format("2006-01-02 15:04:05.999999") + "'", true
+	}
+
+	return "", false
+}
+
 func mysqlBitXor(expressions ...jet.Expression) jet.SerializeFunc {
 	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 		if len(expressions) < 2 {
@@ -1,7 +1,10 @@
 package mysql
 
 import (
+	"github.com/go-jet/jet/internal/jet"
+	"gotest.tools/assert"
 	"testing"
+	"time"
 )
 
 func TestBoolExpressionIS_DISTINCT_FROM(t *testing.T) {
@@ -60,3 +63,23 @@ func TestString_NOT_REGEXP_LIKE_operator(t *testing.T) {
 	assertClauseSerialize(t, table3StrCol.NOT_REGEXP_LIKE(String("JOHN"), false), "(table3.col2 NOT REGEXP ?)", "JOHN")
 	assertClauseSerialize(t, table3StrCol.NOT_REGEXP_LIKE(String("JOHN"), true), "(table3.col2 NOT REGEXP BINARY ?)", "JOHN")
 }
+
+func TestDialectCapabilities(t *testing.T) {
+	assert.Equal(t, Dialect.Supports(jet.CapabilityReturning), false)
+	assert.Equal(t, Dialect.Supports(jet.CapabilityWindowGroupsFrame), false)
+
+	assertClauseSerializeErr(t, ORDER_BY(table1Col1).GROUPS(PRECEDING(1)),
+		"jet: GROUPS window frame is not supported by MySQL dialect")
+}
+
+func TestDebugSqlLiterals(t *testing.T) {
+	timestamp := time.Date(2020, 2, 3, 4, 5, 6, 0, time.FixedZone("", 2*3600))
+
+	stmt := table1.INSERT(table1Col1, table1ColTimestamp, table1Col3).
+		VALUES(uint32(32), timestamp, []byte{0xde, 0xad, 0xbe, 0xef})
+
+	assert.Equal(t, stmt.DebugSql(), `
+INSERT INTO db.table1 (col1, col_timestamp, col3) VALUES
+     (32, '2020-02-03 02:05:06', X'deadbeef');
+`)
+}
@@ -251,6 +251,24 @@ var EXISTS = jet.EXISTS
 // CASE create CASE operator with optional list of expressions
 var CASE = jet.CASE
 
+//----------------- Logical operators ---------------//
+
+// AND joins conditions with AND operator, skipping nil conditions
+var AND = jet.AND
+
+// OR joins conditions with OR operator, skipping nil conditions
+var OR = jet.OR
+
+// Conditional returns condition if include is true, otherwise nil. Condition is evaluated even if include is false.
+var Conditional = jet.Conditional
+
+// ConditionalFunc returns condition created by conditionFunc if include is true, otherwise nil.
+// conditionFunc is called only if include is true.
+var ConditionalFunc = jet.ConditionalFunc
+
+// ConditionalOrderBy returns orderBy if include is true, otherwise nil. Nil clauses are skipped in ORDER BY clause.
+var ConditionalOrderBy = jet.ConditionalOrderBy
+
 //----------------- Bit operators ---------------//
 
 // BIT_NOT inverts every bit in integer expression
@@ -14,6 +14,12 @@ type InsertStatement interface {
 	MODELS(data interface{}) InsertStatement
 
 	QUERY(selectStatement SelectStatement) InsertStatement
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() InsertStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() InsertStatement
 }
 
 func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
@@ -35,21 +41,52 @@ type insertStatementImpl struct {
 }
 
 func (i *insertStatementImpl) VALUES(value interface{}, values ...interface{}) InsertStatement {
+	i = i.edit()
 	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowFromValues(value, values))
 	return i
 }
 
 func (i *insertStatementImpl) MODEL(data interface{}) InsertStatement {
+	i = i.edit()
 	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowFromModel(i.Insert.GetColumns(), data))
 	return i
 }
 
 func (i *insertStatementImpl) MODELS(data interface{}) InsertStatement {
+	i = i.edit()
 	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowsFromModels(i.Insert.GetColumns(), data)...)
 	return i
 }
 
 func (i *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertStatement {
+	i = i.edit()
 	i.ValuesQuery.Query = selectStatement
 	return i
 }
+
+func (i *insertStatementImpl) Clone() InsertStatement {
+	return i.clone()
+}
+
+func (i *insertStatementImpl) Immutable() InsertStatement {
+	newInsert := i.clone()
+	jet.SetImmutable(newInsert.SerializerStatement)
+
+	return newInsert
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (i *insertStatementImpl) edit() *insertStatementImpl {
+	if !jet.IsImmutable(i.SerializerStatement) {
+		return i
+	}
+
+	return i.clone()
+}
+
+func (i *insertStatementImpl) clone() *insertStatementImpl {
+	newInsert := newInsertStatement(nil, nil).(*insertStatementImpl)
+	jet.CloneStatement(newInsert.SerializerStatement, i.SerializerStatement)
+
+	return newInsert
+}
@@ -93,10 +93,6 @@ INSERT INTO db.table1 (col1, col_float) VALUES
 }
 
 func TestInsertValuesFromModelColumnMismatch(t *testing.T) {
-	defer func() {
-		r := recover()
-		assert.Equal(t, r, "missing struct field for column : col1")
-	}()
 	type Table1Model struct {
 		Col1Prim int
 		Col2     string
@@ -107,19 +103,20 @@ func TestInsertValuesFromModelColumnMismatch(t *testing.T) {
 		Col2:     "one",
 	}
 
-	table1.
+	stmt := table1.
 		INSERT(table1Col1, table1ColFloat).
 		MODEL(newData)
+
+	assertStatementSqlErr(t, stmt, "missing struct field for column : col1")
 }
 
 func TestInsertFromNonStructModel(t *testing.T) {
+	stmt := table2.INSERT(table2ColInt).MODEL([]int{})
 
-	defer func() {
-		r := recover()
-		assert.Equal(t, r, "jet: data has to be a struct")
-	}()
+	assertStatementSqlErr(t, stmt, "jet: data has to be a struct")
 
-	table2.INSERT(table2ColInt).MODEL([]int{})
+	_, _, err := stmt.SqlErr()
+	assert.Error(t, err, "jet: data has to be a struct, in VALUES clause")
 }
 
 func TestInsertDefaultValue(t *testing.T) {
@@ -133,3 +130,22 @@ INSERT INTO db.table1 (col1, col_float) VALUES
 
 	assertStatementSql(t, stmt, expectedSQL, "two")
 }
+
+func TestInsertClone(t *testing.T) {
+	base := table1.INSERT(table1Col1).VALUES(1)
+
+	clone := base.Clone().VALUES(2)
+	base.VALUES(3)
+
+	assertStatementSql(t, clone, `
+INSERT INTO db.table1 (col1) VALUES
+     (?),
+     (?);
+`, 1, 2)
+
+	assertStatementSql(t, base, `
+INSERT INTO db.table1 (col1) VALUES
+     (?),
+     (?);
+`, 1, 3)
+}
@@ -63,3 +63,27 @@ var Timestamp = func(year int, month time.Month, day, hour, minute, second int,
 var TimestampT = func(t time.Time) TimestampExpression {
 	return TIMESTAMP(StringExp(jet.TimestampT(t)))
 }
+
+// BoolParam creates named bool parameter, bound when prepared statement is executed
+var BoolParam = jet.BoolParam
+
+// IntParam creates named integer parameter, bound when prepared statement is executed
+var IntParam = jet.IntParam
+
+// FloatParam creates named float parameter, bound when prepared statement is executed
+var FloatParam = jet.FloatParam
+
+// StringParam creates named string parameter, bound when prepared statement is executed
+var StringParam = jet.StringParam
+
+// DateParam creates named date parameter, bound when prepared statement is executed
+var DateParam = jet.DateParam
+
+// TimeParam creates named time parameter, bound when prepared statement is executed
+var TimeParam = jet.TimeParam
+
+// DateTimeParam creates named datetime parameter, bound when prepared statement is executed
+var DateTimeParam = jet.TimestampParam
+
+// TimestampParam creates named timestamp parameter, bound when prepared statement is executed
+var TimestampParam = jet.TimestampParam
@@ -7,6 +7,12 @@ type LockStatement interface {
 	Statement
 	READ() Statement
 	WRITE() Statement
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() LockStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() LockStatement
 }
 
 // LOCK creates LockStatement from list of tables
@@ -31,15 +37,44 @@ type lockStatementImpl struct {
 }
 
 func (l *lockStatementImpl) READ() Statement {
+	l = l.edit()
 	l.Read.Show = true
 	return l
 }
 
 func (l *lockStatementImpl) WRITE() Statement {
+	l = l.edit()
 	l.Write.Show = true
 	return l
 }
 
+func (l *lockStatementImpl) Clone() LockStatement {
+	return l.clone()
+}
+
+func (l *lockStatementImpl) Immutable() LockStatement {
+	newLock := l.clone()
+	jet.SetImmutable(newLock.SerializerStatement)
+
+	return newLock
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (l *lockStatementImpl) edit() *lockStatementImpl {
+	if !jet.IsImmutable(l.SerializerStatement) {
+		return l
+	}
+
+	return l.clone()
+}
+
+func (l *lockStatementImpl) clone() *lockStatementImpl {
+	newLock := LOCK().(*lockStatementImpl)
+	jet.CloneStatement(newLock.SerializerStatement, l.SerializerStatement)
+
+	return newLock
+}
+
 // UNLOCK_TABLES explicitly releases any table locks held by the current session
 func UNLOCK_TABLES() Statement {
 	newUnlock := &unlockStatementImpl{
@@ -56,6 +56,12 @@ type SelectStatement interface {
 	UNION_ALL(rhs SelectStatement) setStatement
 
 	AsTable(alias string) SelectTable
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() SelectStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() SelectStatement
 }
 
 //SELECT creates new SelectStatement with list of projections
@@ -63,6 +69,20 @@ func SELECT(projection Projection, projections ...Projection) SelectStatement {
 	return newSelectStatement(nil, append([]Projection{projection}, projections...))
 }
 
+// CountQuery derives statement that counts rows of statement: SELECT COUNT(*) AS "count" FROM (statement) AS "count_query".
+// ORDER BY, LIMIT and OFFSET clauses of statement are dropped, so total count can be derived from page query.
+func CountQuery(statement SelectStatement) SelectStatement {
+	if selectStmt, ok := statement.(*selectStatementImpl); ok {
+		countedStmt := selectStmt.clone()
+		countedStmt.OrderBy.List = nil
+		countedStmt.Limit.Count = -1
+		countedStmt.Offset.Count = -1
+		statement = countedStmt
+	}
+
+	return SELECT(COUNT(STAR).AS("count")).FROM(statement.AsTable("count_query"))
+}
+
 func newSelectStatement(table ReadableTable, projections []Projection) SelectStatement {
 	newSelect := &selectStatementImpl{}
 	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect, &newSelect.Select,
@@ -99,56 +119,67 @@ type selectStatementImpl struct {
 }
 
 func (s *selectStatementImpl) DISTINCT() SelectStatement {
+	s = s.edit()
 	s.Select.Distinct = true
 	return s
 }
 
 func (s *selectStatementImpl) FROM(table ReadableTable) SelectStatement {
+	s = s.edit()
 	s.From.Table = table
 	return s
 }
 
 func (s *selectStatementImpl) WHERE(condition BoolExpression) SelectStatement {
+	s = s.edit()
 	s.Where.Condition = condition
 	return s
 }
 
 func (s *selectStatementImpl) GROUP_BY(groupByClauses ...jet.GroupByClause) SelectStatement {
+	s = s.edit()
 	s.GroupBy.List = groupByClauses
 	return s
 }
 
 func (s *selectStatementImpl) HAVING(boolExpression BoolExpression) SelectStatement {
+	s = s.edit()
 	s.Having.Condition = boolExpression
 	return s
 }
 
 func (s *selectStatementImpl) WINDOW(name string) windowExpand {
+	s = s.edit()
 	s.Window.Definitions = append(s.Window.Definitions, jet.WindowDefinition{Name: name})
 	return windowExpand{selectStatement: s}
 }
 
 func (s *selectStatementImpl) ORDER_BY(orderByClauses ...jet.OrderByClause) SelectStatement {
+	s = s.edit()
 	s.OrderBy.List = orderByClauses
 	return s
 }
 
 func (s *selectStatementImpl) LIMIT(limit int64) SelectStatement {
+	s = s.edit()
 	s.Limit.Count = limit
 	return s
 }
 
 func (s *selectStatementImpl) OFFSET(offset int64) SelectStatement {
+	s = s.edit()
 	s.Offset.Count = offset
 	return s
 }
 
 func (s *selectStatementImpl) FOR(lock RowLock) SelectStatement {
+	s = s.edit()
 	s.For.Lock = lock
 	return s
 }
 
 func (s *selectStatementImpl) LOCK_IN_SHARE_MODE() SelectStatement {
+	s = s.edit()
 	s.ShareLock.Show = true
 	return s
 }
@@ -157,6 +188,33 @@ func (s *selectStatementImpl) AsTable(alias string) SelectTable {
 	return newSelectTable(s, alias)
 }
 
+func (s *selectStatementImpl) Clone() SelectStatement {
+	return s.clone()
+}
+
+func (s *selectStatementImpl) Immutable() SelectStatement {
+	newSelect := s.clone()
+	jet.SetImmutable(newSelect.ExpressionStatement)
+
+	return newSelect
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (s *selectStatementImpl) edit() *selectStatementImpl {
+	if !jet.IsImmutable(s.ExpressionStatement) {
+		return s
+	}
+
+	return s.clone()
+}
+
+func (s *selectStatementImpl) clone() *selectStatementImpl {
+	newSelect := newSelectStatement(nil, nil).(*selectStatementImpl)
+	jet.CloneStatement(newSelect.ExpressionStatement, s.ExpressionStatement)
+
+	return newSelect
+}
+
 //-----------------------------------------------------
 
 type windowExpand struct {
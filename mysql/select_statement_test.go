@@ -132,3 +132,28 @@ FROM db.table1
 LOCK IN SHARE MODE;
 `)
 }
+
+func TestSelectConditionalJoinAndOrderBy(t *testing.T) {
+	selectWith := func(joinTable2, orderByFloat bool) SelectStatement {
+		return SELECT(table1ColInt).
+			FROM(ConditionalJoin(joinTable2, table1, func(table ReadableTable) ReadableTable {
+				return table.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))
+			})).
+			ORDER_BY(
+				ConditionalOrderBy(orderByFloat, table1ColFloat.DESC()),
+				table1ColInt.ASC(),
+			)
+	}
+
+	assertStatementSql(t, selectWith(false, false), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+ORDER BY table1.col_int ASC;
+`)
+	assertStatementSql(t, selectWith(true, true), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+ORDER BY table1.col_float DESC, table1.col_int ASC;
+`)
+}
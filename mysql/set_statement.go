@@ -23,6 +23,12 @@ type setStatement interface {
 	OFFSET(offset int64) setStatement
 
 	AsTable(alias string) SelectTable
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() setStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() setStatement
 }
 
 type setOperators interface {
@@ -71,16 +77,19 @@ func newSetStatementImpl(operator string, all bool, selects []jet.StatementWithP
 }
 
 func (s *setStatementImpl) ORDER_BY(orderByClauses ...jet.OrderByClause) setStatement {
+	s = s.edit()
 	s.setOperator.OrderBy.List = orderByClauses
 	return s
 }
 
 func (s *setStatementImpl) LIMIT(limit int64) setStatement {
+	s = s.edit()
 	s.setOperator.Limit.Count = limit
 	return s
 }
 
 func (s *setStatementImpl) OFFSET(offset int64) setStatement {
+	s = s.edit()
 	s.setOperator.Offset.Count = offset
 	return s
 }
@@ -89,6 +98,33 @@ func (s *setStatementImpl) AsTable(alias string) SelectTable {
 	return newSelectTable(s, alias)
 }
 
+func (s *setStatementImpl) Clone() setStatement {
+	return s.clone()
+}
+
+func (s *setStatementImpl) Immutable() setStatement {
+	newSetStatement := s.clone()
+	jet.SetImmutable(newSetStatement.ExpressionStatement)
+
+	return newSetStatement
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (s *setStatementImpl) edit() *setStatementImpl {
+	if !jet.IsImmutable(s.ExpressionStatement) {
+		return s
+	}
+
+	return s.clone()
+}
+
+func (s *setStatementImpl) clone() *setStatementImpl {
+	newSetStatement := newSetStatementImpl("", false, nil).(*setStatementImpl)
+	jet.CloneStatement(newSetStatement.ExpressionStatement, s.ExpressionStatement)
+
+	return newSetStatement
+}
+
 const (
 	union = "UNION"
 )
@@ -125,3 +125,13 @@ func newJoinTable(lhs jet.Serializer, rhs jet.Serializer, joinType jet.JoinType,
 
 	return newJoinTable
 }
+
+// ConditionalJoin returns table joined by join function if include is true, otherwise table unchanged.
+// join is called only if include is true.
+func ConditionalJoin(include bool, table ReadableTable, join func(table ReadableTable) ReadableTable) ReadableTable {
+	if !include {
+		return table
+	}
+
+	return join(table)
+}
@@ -5,5 +5,40 @@ import "github.com/go-jet/jet/internal/jet"
 // Statement is common interface for all statements(SELECT, INSERT, UPDATE, DELETE, LOCK)
 type Statement = jet.Statement
 
+// FormatOption changes format of sql query returned by Sql and DebugSql
+type FormatOption = jet.FormatOption
+
+// Sql query formats
+const (
+	Pretty     = jet.Pretty
+	Compact    = jet.Compact
+	Normalized = jet.Normalized
+)
+
+// SerializeError is returned when statement can not be serialized
+type SerializeError = jet.SerializeError
+
+// SetStrictMode turns on or off strict mode. In strict mode invalid statements and destinations panic,
+// instead of returning an error.
+var SetStrictMode = jet.SetStrictMode
+
+// ExplainMapping explains how statement projections would be mapped onto destination, without running the statement
+var ExplainMapping = jet.ExplainMapping
+
+// PreparedStatement is statement prepared over database connection, executed with named parameter values
+type PreparedStatement = jet.PreparedStatement
+
+// StatementCache is bounded cache of statements prepared on database connection pool
+type StatementCache = jet.StatementCache
+
+// NewStatementCache creates new cache of at most size statements prepared on db
+var NewStatementCache = jet.NewStatementCache
+
+// CompiledStatement is statement serialized once, reused on every execution
+type CompiledStatement = jet.CompiledStatement
+
+// BatchStatement is list of statements executed as single query, with result set of each statement mapped into its own destination
+type BatchStatement = jet.BatchStatement
+
 // Projection is interface for all projection types. Types that can be part of, for instance SELECT clause.
 type Projection = jet.Projection
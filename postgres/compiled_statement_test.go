@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+	"gotest.tools/assert"
+	"strconv"
+	"testing"
+)
+
+func TestCompiledStatement(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColInt.GT(Int(10)).AND(table1ColFloat.LT(FloatParam("max_float"))))
+
+	compiled := stmt.Compile()
+
+	query, args := stmt.Sql()
+	compiledQuery, compiledArgs := compiled.Sql()
+
+	assert.Equal(t, compiledQuery, query)
+	assert.DeepEqual(t, compiledArgs, args)
+	assert.Equal(t, compiled.DebugSql(), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE (table1.col_int > 10) AND (table1.col_float < :max_float);
+`)
+
+	assertStatementSql(t, compiled.Params(map[string]interface{}{"max_float": 2.2}), query, int64(10), 2.2)
+	assertStatementSqlErr(t, compiled.Params(map[string]interface{}{}), "jet: missing value for parameter max_float")
+
+	_, err := compiled.Params(nil).Exec(nil)
+	assert.Error(t, err, "jet: missing value for parameter max_float")
+}
+
+func TestCompiledStatementArgsCopy(t *testing.T) {
+	compiled := SELECT(Int(1)).Compile()
+
+	_, args := compiled.Sql()
+	args[0] = int64(2)
+
+	_, args = compiled.Sql()
+	assert.DeepEqual(t, args, []interface{}{int64(1)})
+}
+
+func TestCompiledStatementModifiedAfterCompile(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColInt.GT(Int(10)))
+
+	compiled := stmt.Compile()
+
+	stmt.WHERE(table1ColInt.GT(Int(20)).AND(table1ColFloat.LT(Float(1.1))))
+
+	query, args := compiled.Sql(Compact)
+	assert.Equal(t, query, `SELECT table1.col_int AS "table1.col_int" FROM db.table1 WHERE table1.col_int > $1;`)
+	assert.DeepEqual(t, args, []interface{}{int64(10)})
+
+	query, _ = compiled.Sql(Normalized)
+	assert.Equal(t, query, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > ?;
+`)
+	assert.Equal(t, compiled.DebugSql(Compact), `SELECT table1.col_int AS "table1.col_int" FROM db.table1 WHERE table1.col_int > 10;`)
+}
+
+func bigSelectStatement() SelectStatement {
+	var columns []jet.ColumnExpression
+
+	for i := 0; i < 300; i++ {
+		columns = append(columns, IntegerColumn("col"+strconv.Itoa(i)))
+	}
+
+	bigTable := NewTable("db", "big_table", columns[0], columns[1:]...)
+
+	var projections []Projection
+
+	for _, column := range columns {
+		projections = append(projections, column)
+	}
+
+	return SELECT(projections[0], projections[1:]...).
+		FROM(bigTable).
+		WHERE(columns[0].(ColumnInteger).GT(IntParam("min"))).
+		LIMIT(10)
+}
+
+func BenchmarkSelectSql(b *testing.B) {
+	stmt := bigSelectStatement()
+
+	for i := 0; i < b.N; i++ {
+		stmt.Sql()
+	}
+}
+
+func BenchmarkCompiledSelectSql(b *testing.B) {
+	stmt := bigSelectStatement().Compile()
+
+	for i := 0; i < b.N; i++ {
+		stmt.Params(map[string]interface{}{"min": 5}).Sql()
+	}
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"encoding/hex"
 	"github.com/go-jet/jet/internal/jet"
 	"strconv"
 )
@@ -24,11 +25,26 @@ func newDialect() jet.Dialect {
 		ArgumentPlaceholder: func(ord int) string {
 			return "$" + strconv.Itoa(ord)
 		},
+		ArgumentToString: postgresArgumentToString,
+		Capabilities: []jet.Capability{
+			jet.CapabilityReturning,
+			jet.CapabilityDistinctOn,
+			jet.CapabilityLateral,
+			jet.CapabilityWindowGroupsFrame,
+		},
 	}
 
 	return jet.NewDialect(dialectParams)
 }
 
+func postgresArgumentToString(value interface{}) (string, bool) {
+	if bytes, ok := value.([]byte); ok {
+		return `'\x` + hex.EncodeToString(bytes) + `'`, true
+	}
+
+	return "", false
+}
+
 func postgresCAST(expressions ...jet.Expression) jet.SerializeFunc {
 	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 		if len(expressions) < 2 {
@@ -1,6 +1,10 @@
 package postgres
 
-import "testing"
+import (
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
 
 func TestString_REGEXP_LIKE_operator(t *testing.T) {
 	assertClauseSerialize(t, table3StrCol.REGEXP_LIKE(table2ColStr), "(table3.col2 ~* table2.col_str)")
@@ -60,3 +64,16 @@ func TestNOT_IN(t *testing.T) {
      FROM db.table2
 )))`, int64(12))
 }
+
+func TestDebugSqlLiterals(t *testing.T) {
+	timestamp := time.Date(2020, 2, 3, 4, 5, 6, 0, time.FixedZone("", 2*3600))
+	intPtr := int16(-16)
+
+	stmt := table1.INSERT(table1Col1, table1Col3, table1ColFloat, table1ColTimestampz, table2ColStr).
+		VALUES(&intPtr, uint8(8), float32(1.1), timestamp, []byte{0xde, 0xad, 0xbe, 0xef})
+
+	assert.Equal(t, stmt.DebugSql(), `
+INSERT INTO db.table1 (col1, col3, col_float, col_timestampz, col_str) VALUES
+     (-16, 8, 1.1, '2020-02-03 04:05:06+02:00', '\xdeadbeef');
+`)
+}
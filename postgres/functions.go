@@ -285,6 +285,17 @@ var LOCALTIMESTAMP = jet.LOCALTIMESTAMP
 // NOW returns current date and time
 var NOW = jet.NOW
 
+// --------------- Sequence Manipulation Functions -------------//
+
+// NEXTVAL advances sequence and returns new value
+var NEXTVAL = jet.NEXTVAL
+
+// CURRVAL returns value most recently obtained with NEXTVAL for sequence
+var CURRVAL = jet.CURRVAL
+
+// SETVAL sets sequence current value and returns it
+var SETVAL = jet.SETVAL
+
 // --------------- Conditional Expressions Functions -------------//
 
 // COALESCE function returns the first of its arguments that is not null.
@@ -1,6 +1,8 @@
 package postgres
 
 import (
+	"github.com/go-jet/jet/internal/jet"
+	"github.com/go-jet/jet/internal/pg"
 	"gotest.tools/assert"
 	"testing"
 	"time"
@@ -91,10 +93,6 @@ INSERT INTO db.table1 (col1, col_float) VALUES
 }
 
 func TestInsertValuesFromModelColumnMismatch(t *testing.T) {
-	defer func() {
-		r := recover()
-		assert.Equal(t, r, "missing struct field for column : col1")
-	}()
 	type Table1Model struct {
 		Col1Prim int
 		Col2     string
@@ -105,19 +103,20 @@ func TestInsertValuesFromModelColumnMismatch(t *testing.T) {
 		Col2:     "one",
 	}
 
-	table1.
+	stmt := table1.
 		INSERT(table1Col1, table1ColFloat).
 		MODEL(newData)
+
+	assertStatementSqlErr(t, stmt, "missing struct field for column : col1")
 }
 
 func TestInsertFromNonStructModel(t *testing.T) {
+	stmt := table2.INSERT(table2ColInt).MODEL([]int{})
 
-	defer func() {
-		r := recover()
-		assert.Equal(t, r, "jet: data has to be a struct")
-	}()
+	assertStatementSqlErr(t, stmt, "jet: data has to be a struct")
 
-	table2.INSERT(table2ColInt).MODEL([]int{})
+	_, _, err := stmt.SqlErr()
+	assert.Error(t, err, "jet: data has to be a struct, in VALUES clause")
 }
 
 func TestInsertQuery(t *testing.T) {
@@ -145,3 +144,9 @@ INSERT INTO db.table1 (col1, col_float) VALUES
 
 	assertStatementSql(t, stmt, expectedSQL, "two")
 }
+
+func TestUpsertNotSupported(t *testing.T) {
+	upsert := pg.NewUpsertStatement(Dialect, table1, []jet.Column{table1Col1}).VALUES(1)
+
+	assertStatementSqlErr(t, upsert, "jet: UPSERT is not supported by PostgreSQL dialect")
+}
@@ -71,3 +71,30 @@ var Timestampz = func(year int, month time.Month, day, hour, minute, second int,
 var TimestampzT = func(t time.Time) TimestampzExpression {
 	return CAST(jet.TimestampzT(t)).AS_TIMESTAMPZ()
 }
+
+// BoolParam creates named bool parameter, bound when prepared statement is executed
+var BoolParam = jet.BoolParam
+
+// IntParam creates named integer parameter, bound when prepared statement is executed
+var IntParam = jet.IntParam
+
+// FloatParam creates named float parameter, bound when prepared statement is executed
+var FloatParam = jet.FloatParam
+
+// StringParam creates named string parameter, bound when prepared statement is executed
+var StringParam = jet.StringParam
+
+// DateParam creates named date parameter, bound when prepared statement is executed
+var DateParam = jet.DateParam
+
+// TimeParam creates named time parameter, bound when prepared statement is executed
+var TimeParam = jet.TimeParam
+
+// TimezParam creates named time with time zone parameter, bound when prepared statement is executed
+var TimezParam = jet.TimezParam
+
+// TimestampParam creates named timestamp parameter, bound when prepared statement is executed
+var TimestampParam = jet.TimestampParam
+
+// TimestampzParam creates named timestamp with time zone parameter, bound when prepared statement is executed
+var TimestampzParam = jet.TimestampzParam
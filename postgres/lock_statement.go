@@ -23,6 +23,12 @@ type LockStatement interface {
 
 	IN(lockMode TableLockMode) LockStatement
 	NOWAIT() LockStatement
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() LockStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() LockStatement
 }
 
 // LOCK creates LockStatement from list of tables
@@ -46,11 +52,40 @@ type lockStatementImpl struct {
 }
 
 func (l *lockStatementImpl) IN(lockMode TableLockMode) LockStatement {
+	l = l.edit()
 	l.In.LockMode = string(lockMode)
 	return l
 }
 
 func (l *lockStatementImpl) NOWAIT() LockStatement {
+	l = l.edit()
 	l.NoWait.Show = true
 	return l
 }
+
+func (l *lockStatementImpl) Clone() LockStatement {
+	return l.clone()
+}
+
+func (l *lockStatementImpl) Immutable() LockStatement {
+	newLock := l.clone()
+	jet.SetImmutable(newLock.SerializerStatement)
+
+	return newLock
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (l *lockStatementImpl) edit() *lockStatementImpl {
+	if !jet.IsImmutable(l.SerializerStatement) {
+		return l
+	}
+
+	return l.clone()
+}
+
+func (l *lockStatementImpl) clone() *lockStatementImpl {
+	newLock := LOCK().(*lockStatementImpl)
+	jet.CloneStatement(newLock.SerializerStatement, l.SerializerStatement)
+
+	return newLock
+}
@@ -7,3 +7,19 @@ var NOT = jet.NOT
 
 // BIT_NOT inverts every bit in integer expression result
 var BIT_NOT = jet.BIT_NOT
+
+// AND joins conditions with AND operator, skipping nil conditions
+var AND = jet.AND
+
+// OR joins conditions with OR operator, skipping nil conditions
+var OR = jet.OR
+
+// Conditional returns condition if include is true, otherwise nil. Condition is evaluated even if include is false.
+var Conditional = jet.Conditional
+
+// ConditionalFunc returns condition created by conditionFunc if include is true, otherwise nil.
+// conditionFunc is called only if include is true.
+var ConditionalFunc = jet.ConditionalFunc
+
+// ConditionalOrderBy returns orderBy if include is true, otherwise nil. Nil clauses are skipped in ORDER BY clause.
+var ConditionalOrderBy = jet.ConditionalOrderBy
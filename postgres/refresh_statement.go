@@ -0,0 +1,6 @@
+package postgres
+
+import "github.com/go-jet/jet/internal/pg"
+
+// RefreshMaterializedViewStatement is interface for PostgreSQL REFRESH MATERIALIZED VIEW statement
+type RefreshMaterializedViewStatement = pg.RefreshMaterializedViewStatement
@@ -1,6 +1,9 @@
 package postgres
 
 import (
+	"github.com/go-jet/jet/qrm"
+	"gotest.tools/assert"
+	"runtime"
 	"testing"
 )
 
@@ -32,6 +35,26 @@ func TestSelectDistinct(t *testing.T) {
 	assertStatementSql(t, SELECT(table1ColBool).DISTINCT().FROM(table1), `
 SELECT DISTINCT table1.col_bool AS "table1.col_bool"
 FROM db.table1;
+`)
+	assertStatementSql(t, SELECT(table1ColBool, table1ColInt).DISTINCT(table1ColBool).FROM(table1), `
+SELECT DISTINCT ON (table1.col_bool) table1.col_bool AS "table1.col_bool",
+     table1.col_int AS "table1.col_int"
+FROM db.table1;
+`)
+}
+
+func TestSelectLateral(t *testing.T) {
+	lateral := LATERAL(SELECT(table2ColInt).FROM(table2).WHERE(table2ColInt.EQ(table1ColInt)), "t2")
+
+	assertStatementSql(t, SELECT(table1ColInt, lateral.AllColumns()).FROM(table1.CROSS_JOIN(lateral)), `
+SELECT table1.col_int AS "table1.col_int",
+     t2."table2.col_int" AS "table2.col_int"
+FROM db.table1
+     CROSS JOIN LATERAL (
+          SELECT table2.col_int AS "table2.col_int"
+          FROM db.table2
+          WHERE table2.col_int = table1.col_int
+     ) AS t2;
 `)
 }
 
@@ -134,3 +157,239 @@ FROM db.table1
 FOR NO KEY UPDATE SKIP LOCKED;
 `)
 }
+
+func TestSelectOptionalFilters(t *testing.T) {
+	nameFilter, minFloat := "", 2.2
+
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(AND(
+			Conditional(nameFilter != "", table3StrCol.EQ(String(nameFilter))),
+			Conditional(minFloat > 0, table1ColFloat.GT_EQ(Float(minFloat))),
+		)).
+		ORDER_BY(nil, table1ColInt.DESC())
+
+	assertStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_float >= $1
+ORDER BY table1.col_int DESC;
+`, 2.2)
+
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).WHERE(AND(nil, nil)).ORDER_BY(nil), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1;
+`)
+}
+
+func TestSelectClone(t *testing.T) {
+	base := SELECT(table1ColInt).FROM(table1).WINDOW("w1").AS(PARTITION_BY(table1ColFloat))
+
+	filtered := base.Clone().WHERE(table1ColInt.GT(Int(10))).WINDOW("w2").AS(ORDER_BY(table1ColInt))
+	base.WINDOW("w3").AS(ORDER_BY(table1ColFloat))
+
+	assertStatementSql(t, filtered, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > $1
+WINDOW w1 AS (PARTITION BY table1.col_float), w2 AS (ORDER BY table1.col_int);
+`, int64(10))
+
+	assertStatementSql(t, base, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WINDOW w1 AS (PARTITION BY table1.col_float), w3 AS (ORDER BY table1.col_float);
+`)
+}
+
+func TestSelectImmutable(t *testing.T) {
+	base := SELECT(table1ColInt).FROM(table1).Immutable()
+
+	page := base.WHERE(table1ColInt.GT(Int(10))).LIMIT(10)
+
+	assertStatementSql(t, base, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1;
+`)
+
+	assertStatementSql(t, page, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > $1
+LIMIT $2;
+`, int64(10), int64(10))
+
+	assertStatementSql(t, page.OFFSET(20), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > $1
+LIMIT $2
+OFFSET $3;
+`, int64(10), int64(10), int64(20))
+
+	assertStatementSql(t, page, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > $1
+LIMIT $2;
+`, int64(10), int64(10))
+}
+
+func TestCountQuery(t *testing.T) {
+	page := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColInt.GT(Int(10))).
+		ORDER_BY(table1ColInt).
+		LIMIT(10).
+		OFFSET(20)
+
+	assertStatementSql(t, CountQuery(page), `
+SELECT COUNT(*) AS "count"
+FROM (
+          SELECT table1.col_int AS "table1.col_int"
+          FROM db.table1
+          WHERE table1.col_int > $1
+     ) AS count_query;
+`, int64(10))
+
+	assertStatementSql(t, page, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > $1
+ORDER BY table1.col_int
+LIMIT $2
+OFFSET $3;
+`, int64(10), int64(10), int64(20))
+}
+
+func TestSelectFormat(t *testing.T) {
+	subQuery := SELECT(table2ColInt).FROM(table2).WHERE(table2ColFloat.GT(Float(1.5))).AsTable("sub")
+
+	stmt := SELECT(table1ColInt, table1ColBool).
+		FROM(table1.INNER_JOIN(subQuery, table1ColInt.EQ(table2ColInt.From(subQuery)))).
+		WHERE(table1ColInt.IN(Int(1), Int(2)).AND(table1ColBool.EQ(Bool(true)))).
+		LIMIT(10)
+
+	query, args := stmt.Sql(Compact)
+	assert.Equal(t, query, `SELECT table1.col_int AS "table1.col_int", table1.col_bool AS "table1.col_bool" `+
+		`FROM db.table1 INNER JOIN (SELECT table2.col_int AS "table2.col_int" FROM db.table2 WHERE table2.col_float > $1) AS sub `+
+		`ON (table1.col_int = sub."table2.col_int") WHERE (table1.col_int IN ($2, $3)) AND (table1.col_bool = $4) LIMIT $5;`)
+	assert.DeepEqual(t, args, []interface{}{1.5, int64(1), int64(2), true, int64(10)})
+
+	assert.Equal(t, stmt.DebugSql(Compact), `SELECT table1.col_int AS "table1.col_int", table1.col_bool AS "table1.col_bool" `+
+		`FROM db.table1 INNER JOIN (SELECT table2.col_int AS "table2.col_int" FROM db.table2 WHERE table2.col_float > 1.5) AS sub `+
+		`ON (table1.col_int = sub."table2.col_int") WHERE (table1.col_int IN (1, 2)) AND (table1.col_bool = TRUE) LIMIT 10;`)
+
+	assert.Equal(t, stmt.DebugSql(Compact, Normalized), `SELECT table1.col_int AS "table1.col_int", table1.col_bool AS "table1.col_bool" `+
+		`FROM db.table1 INNER JOIN (SELECT table2.col_int AS "table2.col_int" FROM db.table2 WHERE table2.col_float > ?) AS sub `+
+		`ON (table1.col_int = sub."table2.col_int") WHERE (table1.col_int IN (?, ?)) AND (table1.col_bool = ?) LIMIT ?;`)
+
+	assert.Equal(t, stmt.DebugSql(Pretty), stmt.DebugSql())
+}
+
+func TestSelectSqlErr(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1.INNER_JOIN(table2, nil)).
+		WHERE(table1ColInt.GT(Int(1)))
+
+	_, _, err := stmt.SqlErr()
+	assert.Error(t, err, "jet: join condition is nil, in FROM clause")
+
+	serializeErr, ok := err.(*SerializeError)
+	assert.Assert(t, ok)
+	assert.Equal(t, serializeErr.Clause, "FROM")
+	assert.Equal(t, serializeErr.Message, "jet: join condition is nil")
+
+	assertStatementSqlErr(t, stmt, "jet: join condition is nil")
+
+	var dest []struct{}
+	assert.Error(t, stmt.Query(nil, &dest), "jet: join condition is nil, in FROM clause")
+
+	_, err = stmt.Exec(nil)
+	assert.Error(t, err, "jet: join condition is nil, in FROM clause")
+
+	_, err = stmt.Prepare(nil)
+	assert.Error(t, err, "jet: join condition is nil, in FROM clause")
+
+	_, _, err = stmt.Compile().SqlErr()
+	assert.Error(t, err, "jet: join condition is nil, in FROM clause")
+}
+
+func TestSelectStrictMode(t *testing.T) {
+	SetStrictMode(true)
+	defer SetStrictMode(false)
+
+	stmt := SELECT(table1ColInt).
+		GROUP_BY(nil)
+
+	defer func() {
+		assert.Equal(t, recover(), "jet: nil clause in GROUP BY list")
+	}()
+
+	var dest []struct{}
+	_ = stmt.Query(nil, &dest)
+}
+
+func TestSelectRuntimePanic(t *testing.T) {
+	defer func() {
+		_, isRuntimeError := recover().(runtime.Error)
+		assert.Assert(t, isRuntimeError)
+	}()
+
+	var dest []struct{}
+	_ = SELECT(table1ColInt).FROM(table1).Query(struct{ qrm.DB }{}, &dest)
+
+	t.Fatal("runtime panic is not propagated")
+}
+
+func TestSelectExplainMapping(t *testing.T) {
+	stmt := SELECT(table1ColInt, table1ColFloat.AS("float"), table2ColStr, COUNT(STAR)).
+		FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt)))
+
+	type Table1 struct {
+		ColInt   int
+		ColFloat float64
+	}
+
+	var dest []struct {
+		Table1
+		Float float64
+	}
+
+	mapping := ExplainMapping(stmt, &dest)
+
+	assert.Equal(t, mapping.String(), `Table1.ColInt int <- table1.col_int
+Table1.ColFloat float64 <- (not mapped)
+Float float64 <- float
+unmapped columns: table2.col_str, (unnamed)`)
+}
+
+func TestAsOfSystemTimeNotSupported(t *testing.T) {
+	assertStatementSqlErr(t, SELECT(table1ColInt).FROM(table1).AS_OF_SYSTEM_TIME(String("-10s")),
+		"jet: AS OF SYSTEM TIME is not supported by PostgreSQL dialect")
+}
+
+func TestSelectConditionalJoinAndOrderBy(t *testing.T) {
+	selectWith := func(joinTable2, orderByFloat bool) SelectStatement {
+		return SELECT(table1ColInt).
+			FROM(ConditionalJoin(joinTable2, table1, func(table ReadableTable) ReadableTable {
+				return table.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))
+			})).
+			ORDER_BY(
+				ConditionalOrderBy(orderByFloat, table1ColFloat.DESC()),
+				table1ColInt.ASC(),
+			)
+	}
+
+	assertStatementSql(t, selectWith(false, false), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+ORDER BY table1.col_int ASC;
+`)
+	assertStatementSql(t, selectWith(true, true), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+ORDER BY table1.col_float DESC, table1.col_int ASC;
+`)
+}
@@ -1,24 +1,11 @@
 package postgres
 
-import "github.com/go-jet/jet/internal/jet"
+import "github.com/go-jet/jet/internal/pg"
 
-// SelectTable is interface for MySQL sub-queries
-type SelectTable interface {
-	readableTable
-	jet.SelectTable
-}
-
-type selectTableImpl struct {
-	jet.SelectTable
-	readableTableInterfaceImpl
-}
-
-func newSelectTable(selectStmt jet.StatementWithProjections, alias string) SelectTable {
-	subQuery := &selectTableImpl{
-		SelectTable: jet.NewSelectTable(selectStmt, alias),
-	}
-
-	subQuery.readableTableInterfaceImpl.parent = subQuery
+// SelectTable is interface for PostgreSQL sub-queries
+type SelectTable = pg.SelectTable
 
-	return subQuery
+// LATERAL creates sub-query that can reference columns of FROM items appearing before it
+func LATERAL(selectStmt SelectStatement, alias string) SelectTable {
+	return pg.LATERAL(Dialect, selectStmt, alias)
 }
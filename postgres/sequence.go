@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+	"strings"
+)
+
+// Sequence is interface for PostgreSQL sequences
+type Sequence interface {
+	SchemaName() string
+	SequenceName() string
+
+	// NEXTVAL advances sequence and returns new value
+	NEXTVAL() IntegerExpression
+	// CURRVAL returns value most recently obtained with NEXTVAL for this sequence in the current session
+	CURRVAL() IntegerExpression
+	// SETVAL sets sequence current value and returns it
+	SETVAL(value IntegerExpression) IntegerExpression
+}
+
+// NewSequence creates new sequence with schema name and sequence name
+func NewSequence(schemaName, name string) Sequence {
+	return &sequenceImpl{
+		schemaName: schemaName,
+		name:       name,
+	}
+}
+
+type sequenceImpl struct {
+	schemaName string
+	name       string
+}
+
+func (s *sequenceImpl) SchemaName() string {
+	return s.schemaName
+}
+
+func (s *sequenceImpl) SequenceName() string {
+	return s.name
+}
+
+func (s *sequenceImpl) NEXTVAL() IntegerExpression {
+	return NEXTVAL(s.regclass())
+}
+
+func (s *sequenceImpl) CURRVAL() IntegerExpression {
+	return CURRVAL(s.regclass())
+}
+
+func (s *sequenceImpl) SETVAL(value IntegerExpression) IntegerExpression {
+	return SETVAL(s.regclass(), value)
+}
+
+// regclass returns sequence name literal, for instance 'dvds.actor_actor_id_seq'
+func (s *sequenceImpl) regclass() StringExpression {
+	return jet.StringExp(jet.FixedLiteral(quoteIdentifier(s.schemaName) + "." + quoteIdentifier(s.name)))
+}
+
+func quoteIdentifier(name string) string {
+	if name == strings.ToLower(name) {
+		return name
+	}
+
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
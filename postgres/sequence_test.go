@@ -0,0 +1,16 @@
+package postgres
+
+import "testing"
+
+func TestSequence(t *testing.T) {
+	sequence := NewSequence("db", "table1_col1_seq")
+
+	assertClauseSerialize(t, sequence.NEXTVAL(), `NEXTVAL('db.table1_col1_seq')`)
+	assertClauseSerialize(t, sequence.CURRVAL(), `CURRVAL('db.table1_col1_seq')`)
+	assertClauseSerialize(t, sequence.SETVAL(Int(11)), `SETVAL('db.table1_col1_seq', $1)`, int64(11))
+	assertClauseSerialize(t, NewSequence("db", "Table1Seq").NEXTVAL(), `NEXTVAL('db."Table1Seq"')`)
+
+	assertStatementSql(t, SELECT(sequence.NEXTVAL().AS("next")), `
+SELECT NEXTVAL('db.table1_col1_seq') AS "next";
+`)
+}
@@ -1,137 +1,58 @@
 package postgres
 
-import "github.com/go-jet/jet/internal/jet"
+import (
+	"github.com/go-jet/jet/internal/jet"
+	"github.com/go-jet/jet/internal/pg"
+)
 
-// Table is interface for MySQL tables
+// Table is interface for PostgreSQL tables
 type Table interface {
-	readableTable
-	writableTable
+	pg.Readable
+	pg.Writable
 	jet.SerializerTable
-}
-
-type readableTable interface {
-	// Generates a select query on the current tableName.
-	SELECT(projection Projection, projections ...Projection) SelectStatement
-
-	// Creates a inner join tableName Expression using onCondition.
-	INNER_JOIN(table ReadableTable, onCondition BoolExpression) ReadableTable
-
-	// Creates a left join tableName Expression using onCondition.
-	LEFT_JOIN(table ReadableTable, onCondition BoolExpression) ReadableTable
-
-	// Creates a right join tableName Expression using onCondition.
-	RIGHT_JOIN(table ReadableTable, onCondition BoolExpression) ReadableTable
-
-	// Creates a full join tableName Expression using onCondition.
-	FULL_JOIN(table ReadableTable, onCondition BoolExpression) ReadableTable
 
-	// Creates a cross join tableName Expression using onCondition.
-	CROSS_JOIN(table ReadableTable) ReadableTable
-}
-
-type writableTable interface {
-	INSERT(columns ...jet.Column) InsertStatement
-	UPDATE(column jet.Column, columns ...jet.Column) UpdateStatement
-	DELETE() DeleteStatement
+	// LOCK creates LOCK TABLE statement for this table
 	LOCK() LockStatement
 }
 
 // ReadableTable interface
-type ReadableTable interface {
-	readableTable
-	jet.Serializer
-}
+type ReadableTable = pg.ReadableTable
 
 // WritableTable interface
-type WritableTable interface {
-	jet.Table
-	writableTable
-	jet.Serializer
-}
-
-type readableTableInterfaceImpl struct {
-	parent ReadableTable
-}
-
-// Generates a select query on the current tableName.
-func (r *readableTableInterfaceImpl) SELECT(projection1 Projection, projections ...Projection) SelectStatement {
-	return newSelectStatement(r.parent, append([]Projection{projection1}, projections...))
-}
-
-// Creates a inner join tableName Expression using onCondition.
-func (r *readableTableInterfaceImpl) INNER_JOIN(table ReadableTable, onCondition BoolExpression) ReadableTable {
-	return newJoinTable(r.parent, table, jet.InnerJoin, onCondition)
-}
-
-// Creates a left join tableName Expression using onCondition.
-func (r *readableTableInterfaceImpl) LEFT_JOIN(table ReadableTable, onCondition BoolExpression) ReadableTable {
-	return newJoinTable(r.parent, table, jet.LeftJoin, onCondition)
-}
-
-// Creates a right join tableName Expression using onCondition.
-func (r *readableTableInterfaceImpl) RIGHT_JOIN(table ReadableTable, onCondition BoolExpression) ReadableTable {
-	return newJoinTable(r.parent, table, jet.RightJoin, onCondition)
-}
-
-func (r *readableTableInterfaceImpl) FULL_JOIN(table ReadableTable, onCondition BoolExpression) ReadableTable {
-	return newJoinTable(r.parent, table, jet.FullJoin, onCondition)
-}
-
-func (r *readableTableInterfaceImpl) CROSS_JOIN(table ReadableTable) ReadableTable {
-	return newJoinTable(r.parent, table, jet.CrossJoin, nil)
-}
-
-type writableTableInterfaceImpl struct {
-	parent WritableTable
-}
-
-func (w *writableTableInterfaceImpl) INSERT(columns ...jet.Column) InsertStatement {
-	return newInsertStatement(w.parent, jet.UnwidColumnList(columns))
-}
-
-func (w *writableTableInterfaceImpl) UPDATE(column jet.Column, columns ...jet.Column) UpdateStatement {
-	return newUpdateStatement(w.parent, jet.UnwindColumns(column, columns...))
-}
-
-func (w *writableTableInterfaceImpl) DELETE() DeleteStatement {
-	return newDeleteStatement(w.parent)
-}
-
-func (w *writableTableInterfaceImpl) LOCK() LockStatement {
-	return LOCK(w.parent)
-}
+type WritableTable = pg.WritableTable
 
 type tableImpl struct {
-	readableTableInterfaceImpl
-	writableTableInterfaceImpl
-
-	jet.SerializerTable
+	pg.Table
 }
 
 // NewTable creates new table with schema Name, table Name and list of columns
 func NewTable(schemaName, name string, column jet.ColumnExpression, columns ...jet.ColumnExpression) Table {
-
-	t := &tableImpl{
-		SerializerTable: jet.NewTable(schemaName, name, column, columns...),
+	return &tableImpl{
+		Table: pg.NewTable(Dialect, schemaName, name, column, columns...),
 	}
-
-	t.readableTableInterfaceImpl.parent = t
-	t.writableTableInterfaceImpl.parent = t
-
-	return t
 }
 
-type joinTable struct {
-	readableTableInterfaceImpl
-	jet.JoinTable
+func (t *tableImpl) LOCK() LockStatement {
+	return LOCK(t)
 }
 
-func newJoinTable(lhs jet.Serializer, rhs jet.Serializer, joinType jet.JoinType, onCondition BoolExpression) ReadableTable {
-	newJoinTable := &joinTable{
-		JoinTable: jet.NewJoinTable(lhs, rhs, joinType, onCondition),
-	}
+// MaterializedView is interface for PostgreSQL materialized views. Materialized views are read only.
+type MaterializedView = pg.MaterializedView
 
-	newJoinTable.readableTableInterfaceImpl.parent = newJoinTable
+// NewMaterializedView creates new materialized view with schema Name, view Name and list of columns
+func NewMaterializedView(schemaName, name string, column jet.ColumnExpression, columns ...jet.ColumnExpression) MaterializedView {
+	return pg.NewMaterializedView(Dialect, schemaName, name, column, columns...)
+}
 
-	return newJoinTable
+// TableFunction is interface for PostgreSQL set returning functions, callable as read only tables
+type TableFunction = pg.TableFunction
+
+// NewTableFunction creates new table function call with schema Name, function Name, list of function arguments
+// and list of result columns
+func NewTableFunction(schemaName, name string, args []Expression, column jet.ColumnExpression, columns ...jet.ColumnExpression) TableFunction {
+	return pg.NewTableFunction(Dialect, schemaName, name, args, column, columns...)
 }
+
+// ConditionalJoin returns table joined by join function if include is true, otherwise table unchanged.
+// join is called only if include is true.
+var ConditionalJoin = pg.ConditionalJoin
@@ -99,3 +99,40 @@ CROSS JOIN db.table2`)
 CROSS JOIN db.table2
 CROSS JOIN db.table3`)
 }
+
+func TestMaterializedView(t *testing.T) {
+	viewColInt := IntegerColumn("col_int")
+	view := NewMaterializedView("db", "mat_view", viewColInt)
+
+	assertStatementSql(t, view.SELECT(viewColInt).WHERE(viewColInt.GT(Int(2))), `
+SELECT mat_view.col_int AS "mat_view.col_int"
+FROM db.mat_view
+WHERE mat_view.col_int > $1;
+`, int64(2))
+	assertClauseSerialize(t, view.INNER_JOIN(table1, viewColInt.EQ(table1ColInt)),
+		`db.mat_view
+INNER JOIN db.table1 ON (mat_view.col_int = table1.col_int)`)
+
+	assertStatementSql(t, view.REFRESH(), `
+REFRESH MATERIALIZED VIEW db.mat_view;
+`)
+	assertStatementSql(t, view.REFRESH().CONCURRENTLY(), `
+REFRESH MATERIALIZED VIEW CONCURRENTLY db.mat_view;
+`)
+	assertStatementSql(t, view.REFRESH().WITH_NO_DATA(), `
+REFRESH MATERIALIZED VIEW db.mat_view WITH NO DATA;
+`)
+}
+
+func TestTableFunction(t *testing.T) {
+	funcColInt := IntegerColumn("col_int")
+	tableFunc := NewTableFunction("db", "table_func", []Expression{Int(1), String("str")}, funcColInt)
+
+	assertStatementSql(t, tableFunc.SELECT(funcColInt), `
+SELECT table_func.col_int AS "table_func.col_int"
+FROM db.table_func($1, $2);
+`, int64(1), "str")
+	assertClauseSerialize(t, table1.INNER_JOIN(tableFunc, table1ColInt.EQ(funcColInt)),
+		`db.table1
+INNER JOIN db.table_func($1, $2) ON (table1.col_int = table_func.col_int)`, int64(1), "str")
+}
@@ -0,0 +1,112 @@
+package qrm
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ConverterFunc converts column value, as returned by database driver, into destination value
+type ConverterFunc func(value driver.Value) (interface{}, error)
+
+var converters = struct {
+	sync.RWMutex
+	goTypes     map[reflect.Type]ConverterFunc
+	columnTypes map[string]ConverterFunc
+}{
+	goTypes:     map[reflect.Type]ConverterFunc{},
+	columnTypes: map[string]ConverterFunc{},
+}
+
+// RegisterConverter registers converter for destination fields of the same Go type as destination sample value.
+// Converter receives column value before any other conversion, and returns value of destination type.
+// For instance:
+//
+//	qrm.RegisterConverter(decimal.Decimal{}, func(value driver.Value) (interface{}, error) {
+//		return decimal.NewFromString(string(value.([]byte)))
+//	})
+//
+// Converters should be registered once, before any query is executed.
+func RegisterConverter(destination interface{}, converter ConverterFunc) {
+	registerGoTypeConverter(reflect.TypeOf(destination), converter)
+}
+
+func registerGoTypeConverter(destinationType reflect.Type, converter ConverterFunc) {
+	destinationType = indirectType(destinationType)
+
+	converters.Lock()
+	defer converters.Unlock()
+
+	converters.goTypes[destinationType] = converter
+
+	ClearMappingCache()
+}
+
+// RegisterColumnTypeConverter registers converter for columns of database type name, for instance INET.
+// Converted value is stored into any destination field it is assignable to.
+// Converters should be registered once, before any query is executed.
+func RegisterColumnTypeConverter(databaseTypeName string, converter ConverterFunc) {
+	converters.Lock()
+	defer converters.Unlock()
+
+	converters.columnTypes[strings.ToUpper(databaseTypeName)] = converter
+
+	ClearMappingCache()
+}
+
+func goTypeConverter(fieldType reflect.Type) ConverterFunc {
+	converters.RLock()
+	defer converters.RUnlock()
+
+	return converters.goTypes[indirectType(fieldType)]
+}
+
+func columnTypeConverter(databaseTypeName string) ConverterFunc {
+	converters.RLock()
+	defer converters.RUnlock()
+
+	return converters.columnTypes[strings.ToUpper(databaseTypeName)]
+}
+
+func hasConverters() bool {
+	converters.RLock()
+	defer converters.RUnlock()
+
+	return len(converters.goTypes) > 0 || len(converters.columnTypes) > 0
+}
+
+// convertedScanValue keeps column value as returned by database driver, so it can be passed to converters.
+// Value returns column type converter result, or value scanned into default scan type. Column value is scanned
+// into default scan type only when it is needed, so raw values that the default scan type does not accept can
+// still be converted.
+type convertedScanValue struct {
+	raw       driver.Value
+	scanned   sql.Scanner
+	isScanned bool
+	converter ConverterFunc
+}
+
+func (c *convertedScanValue) Scan(src interface{}) error {
+	c.raw = src
+	c.isScanned = false
+
+	return nil
+}
+
+func (c *convertedScanValue) Value() (driver.Value, error) {
+	if c.converter != nil && c.raw != nil {
+		return c.converter(c.raw)
+	}
+
+	if !c.isScanned {
+		if err := c.scanned.Scan(c.raw); err != nil {
+			return nil, err
+		}
+
+		c.isScanned = true
+	}
+
+	return c.scanned.(driver.Valuer).Value()
+}
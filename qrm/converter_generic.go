@@ -0,0 +1,23 @@
+//go:build go1.18
+// +build go1.18
+
+package qrm
+
+import (
+	"database/sql/driver"
+	"reflect"
+)
+
+// RegisterTypeConverter registers converter for destination fields of Go type T. It is type safe variant of
+// RegisterConverter, for instance:
+//
+//	qrm.RegisterTypeConverter(func(value driver.Value) (decimal.Decimal, error) {
+//		return decimal.NewFromString(string(value.([]byte)))
+//	})
+//
+// Converters should be registered once, before any query is executed.
+func RegisterTypeConverter[T any](converter func(value driver.Value) (T, error)) {
+	registerGoTypeConverter(reflect.TypeOf((*T)(nil)).Elem(), func(value driver.Value) (interface{}, error) {
+		return converter(value)
+	})
+}
@@ -0,0 +1,32 @@
+//go:build go1.18
+// +build go1.18
+
+package qrm
+
+import (
+	"database/sql/driver"
+	"gotest.tools/assert"
+	"reflect"
+	"testing"
+)
+
+func TestRegisterTypeConverter(t *testing.T) {
+	RegisterTypeConverter(func(value driver.Value) (address, error) {
+		return address{Host: value.(string)}, nil
+	})
+	defer delete(converters.goTypes, reflect.TypeOf(address{}))
+
+	type Device struct {
+		ID   int64 `sql:"primary_key"`
+		Host *address
+	}
+
+	scanContext := newColumnsScanContext([]string{"device.id", "device.host"}, []string{"INT8", "TEXT"})
+	scanTestRow(scanContext, int64(1), "127.0.0.1")
+
+	var dest []Device
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+
+	assert.NilError(t, err)
+	assert.DeepEqual(t, dest, []Device{{ID: 1, Host: &address{Host: "127.0.0.1"}}})
+}
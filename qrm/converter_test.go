@@ -0,0 +1,216 @@
+package qrm
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"gotest.tools/assert"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type money struct {
+	Cents int64
+}
+
+type address struct {
+	Host string
+}
+
+func registerTestConverters() func() {
+	RegisterConverter(money{}, func(value driver.Value) (interface{}, error) {
+		str, ok := value.(string)
+
+		if !ok {
+			return nil, errors.New("money has to be a string")
+		}
+
+		cents, err := strconv.ParseInt(strings.Replace(str, ".", "", 1), 10, 64)
+
+		return money{Cents: cents}, err
+	})
+
+	RegisterColumnTypeConverter("inet", func(value driver.Value) (interface{}, error) {
+		return address{Host: value.(string)}, nil
+	})
+
+	return func() {
+		delete(converters.goTypes, reflect.TypeOf(money{}))
+		delete(converters.columnTypes, "INET")
+	}
+}
+
+func scanTestRow(scanContext *scanContext, values ...interface{}) {
+	for i, value := range values {
+		err := scanContext.row[i].(sql.Scanner).Scan(value)
+		if err != nil {
+			panic(err)
+		}
+	}
+	scanContext.rowNum++
+}
+
+func TestConverters(t *testing.T) {
+	defer registerTestConverters()()
+
+	type Account struct {
+		ID      int64 `sql:"primary_key"`
+		Balance money
+		Limit   *money
+		Host    address
+		Host2   *address `alias:"account.host"`
+	}
+
+	scanContext := newColumnsScanContext(
+		[]string{"account.id", "account.balance", "account.limit", "account.host"},
+		[]string{"INT8", "NUMERIC", "NUMERIC", "INET"},
+	)
+
+	scanTestRow(scanContext, int64(1), "12.34", nil, "127.0.0.1")
+
+	var dest []Account
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+
+	assert.NilError(t, err)
+	assert.DeepEqual(t, dest, []Account{
+		{
+			ID:      1,
+			Balance: money{Cents: 1234},
+			Host:    address{Host: "127.0.0.1"},
+			Host2:   &address{Host: "127.0.0.1"},
+		},
+	})
+
+	assert.Equal(t, explainMapping(scanContext, scanContext.columnNames, reflect.TypeOf(&dest)).String(),
+		`ID int64 <- account.id
+Balance qrm.money <- account.balance
+Limit *qrm.money <- account.limit
+Host qrm.address <- account.host
+Host2 *qrm.address <- account.host`)
+}
+
+func TestConverterSlice(t *testing.T) {
+	defer registerTestConverters()()
+
+	scanContext := newColumnsScanContext([]string{"balance"}, []string{"NUMERIC"})
+
+	var dest []money
+
+	scanTestRow(scanContext, "1.50")
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+	assert.NilError(t, err)
+
+	scanTestRow(scanContext, nil)
+	_, err = mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, dest, []money{{Cents: 150}})
+}
+
+func TestConverterError(t *testing.T) {
+	defer registerTestConverters()()
+
+	type Account struct {
+		Balance money
+	}
+
+	SetStrictMapping(true)
+	defer SetStrictMapping(false)
+
+	scanContext := newColumnsScanContext([]string{"account.balance"}, []string{"INT8"})
+	scanTestRow(scanContext, int64(11))
+
+	var dest []Account
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+
+	assert.Error(t, err, "jet: money has to be a string, column 'account.balance' to field 'Account.Balance'")
+}
+
+func TestConverterSliceFieldError(t *testing.T) {
+	defer registerTestConverters()()
+
+	type Account struct {
+		ID       int64   `sql:"primary_key"`
+		Balances []money `alias:"account.balance"`
+	}
+
+	SetStrictMapping(true)
+	defer SetStrictMapping(false)
+
+	scanContext := newColumnsScanContext([]string{"account.id", "account.balance"}, []string{"INT8", "INT8"})
+	scanTestRow(scanContext, int64(1), int64(11))
+
+	var dest []Account
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+
+	assert.Error(t, err, "jet: money has to be a string, column 'account.balance' to field 'Balances'")
+	assert.Equal(t, err.(*ConversionError).Column, "account.balance")
+}
+
+func TestColumnTypeConverterError(t *testing.T) {
+	RegisterColumnTypeConverter("macaddr", func(value driver.Value) (interface{}, error) {
+		return nil, errors.New("invalid mac address")
+	})
+	defer delete(converters.columnTypes, "MACADDR")
+
+	type Device struct {
+		Mac string `sql:"primary_key"`
+	}
+
+	SetStrictMapping(true)
+	defer SetStrictMapping(false)
+
+	scanContext := newColumnsScanContext([]string{"device.mac"}, []string{"MACADDR"})
+	scanTestRow(scanContext, "00:00:5e:00:53:af")
+
+	var devices []Device
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&devices), nil)
+
+	assert.Error(t, err, "jet: invalid mac address, column 'device.mac' to field 'Device.Mac'")
+	assert.Error(t, err.(*ConversionError).Err, "invalid mac address")
+
+	var macs []string
+	_, err = mapRowToSlice(scanContext, "", reflect.ValueOf(&macs), nil)
+
+	assert.Error(t, err, "jet: invalid mac address, column 'device.mac' to field '[]string'")
+}
+
+type failingScanner struct{}
+
+func (f *failingScanner) Scan(value interface{}) error {
+	return errors.New("can't scan value")
+}
+
+func TestScannerError(t *testing.T) {
+	type Account struct {
+		ID      int64 `sql:"primary_key"`
+		Balance failingScanner
+	}
+
+	SetStrictMapping(true)
+	defer SetStrictMapping(false)
+
+	scanContext := newColumnsScanContext([]string{"account.id", "account.balance"}, []string{"INT8", "NUMERIC"})
+	scanTestRow(scanContext, int64(1), "12.34")
+
+	var dest []Account
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+
+	assert.Error(t, err, "jet: can't scan value, column 'account.balance' to field 'Account.Balance'")
+}
+
+func TestConverterRawValue(t *testing.T) {
+	defer registerTestConverters()()
+
+	scanContext := newColumnsScanContext([]string{"balance"}, []string{"INT8"})
+
+	scanTestRow(scanContext, "12.34") // not accepted by default INT8 scan type
+
+	var dest []money
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+
+	assert.NilError(t, err)
+	assert.DeepEqual(t, dest, []money{{Cents: 1234}})
+}
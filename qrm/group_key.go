@@ -0,0 +1,152 @@
+package qrm
+
+import (
+	"reflect"
+	"sync"
+)
+
+// GroupKeyFunc returns grouping key of destination object from query result row. Rows with the same grouping key
+// are mapped into the same destination object.
+type GroupKeyFunc func(row Row) interface{}
+
+type groupKeyRegistration struct {
+	fields  []string
+	keyFunc GroupKeyFunc
+}
+
+var groupKeys = struct {
+	sync.RWMutex
+	types map[reflect.Type]groupKeyRegistration
+}{
+	types: map[reflect.Type]groupKeyRegistration{},
+}
+
+// RegisterGroupKey registers destination struct fields, whose values identify unique destination objects.
+// Rows with the same values of key fields are mapped into the same destination object, and their nested slices are
+// grouped. Registered key overrides key from `sql:"primary_key"` and `sql:"group_key"` field tags.
+// Group keys should be registered once, before any query is executed.
+func RegisterGroupKey(destination interface{}, fields ...string) {
+	structType := indirectType(reflect.TypeOf(destination))
+
+	for _, field := range fields {
+		if _, ok := structType.FieldByName(field); !ok {
+			panic("jet: " + structType.String() + " has no field " + field)
+		}
+	}
+
+	registerGroupKey(structType, groupKeyRegistration{fields: fields})
+}
+
+// RegisterGroupKeyFunc registers grouping key function for destination struct type. Function receives query result
+// row, and returns grouping key value, for instance lower case email or combination of column values.
+// Group keys should be registered once, before any query is executed.
+func RegisterGroupKeyFunc(destination interface{}, keyFunc GroupKeyFunc) {
+	registerGroupKey(indirectType(reflect.TypeOf(destination)), groupKeyRegistration{keyFunc: keyFunc})
+}
+
+func registerGroupKey(structType reflect.Type, registration groupKeyRegistration) {
+	groupKeys.Lock()
+	defer groupKeys.Unlock()
+
+	groupKeys.types[structType] = registration
+
+	ClearMappingCache()
+}
+
+func registeredGroupKey(structType reflect.Type) (groupKeyRegistration, bool) {
+	groupKeys.RLock()
+	defer groupKeys.RUnlock()
+
+	registration, ok := groupKeys.types[structType]
+
+	return registration, ok
+}
+
+// registeredGroupKeyInfo returns group key info for registered key fields of struct type
+func (s *scanContext) registeredGroupKeyInfo(structType reflect.Type, typeNames []string, registration groupKeyRegistration) groupKeyInfo {
+	ret := groupKeyInfo{typeName: structType.Name(), keyFunc: registration.keyFunc}
+
+	for _, fieldName := range registration.fields {
+		field, _ := structType.FieldByName(fieldName)
+		fieldTypeNames := typeNames
+		fieldOwnerType := structType
+
+		// promoted field of embedded struct
+		for _, index := range field.Index[:len(field.Index)-1] {
+			embeddedField := fieldOwnerType.Field(index)
+			fieldTypeNames = embeddedTypeNames(fieldTypeNames, embeddedField)
+			fieldOwnerType = indirectType(embeddedField.Type)
+		}
+
+		if index := s.fieldColumnIndex(fieldTypeNames, field); index >= 0 {
+			ret.indexes = append(ret.indexes, index)
+		}
+	}
+
+	return ret
+}
+
+// nestedStructSlices returns fields of struct type, and of its embedded structs, that are slices of structs
+func nestedStructSlices(structType reflect.Type, path string) (paths []string, fields []reflect.StructField) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		if isIgnoredField(field) {
+			continue
+		}
+
+		fieldPath := joinPath(path, field.Name)
+
+		if isEmbeddedStruct(field) {
+			embeddedPaths, embeddedFields := nestedStructSlices(indirectType(field.Type), fieldPath)
+			paths = append(paths, embeddedPaths...)
+			fields = append(fields, embeddedFields...)
+			continue
+		}
+
+		fieldType := indirectType(field.Type)
+
+		if fieldType.Kind() != reflect.Slice || isSimpleModelType(fieldType) {
+			continue
+		}
+
+		elemType := indirectType(fieldType.Elem())
+
+		if elemType.Kind() == reflect.Struct && !isSimpleModelType(elemType) && !isDynamicRowType(elemType) {
+			paths = append(paths, fieldPath)
+			fields = append(fields, field)
+		}
+	}
+
+	return
+}
+
+// ambiguousGroupings returns paths of slices whose elements can not be grouped, because element type has no
+// grouping key. Element without grouping key is ambiguous if it has nested slices, because it is duplicated for
+// every nested slice row, or if it is one of many sibling slices, because it is duplicated for every row of siblings.
+func (s *scanContext) ambiguousGroupings(structType reflect.Type, parentField *reflect.StructField, path string) []string {
+	var ambiguous []string
+
+	nestedPaths, nestedFields := nestedStructSlices(structType, path)
+
+	if len(nestedPaths) > 0 && s.getGroupKeyInfo(structType, parentField).isEmpty() {
+		if path == "" {
+			path = structType.String()
+		}
+		ambiguous = append(ambiguous, path)
+	}
+
+	if len(nestedPaths) < 2 {
+		return ambiguous
+	}
+
+	for i, nestedField := range nestedFields {
+		elemType := indirectType(indirectType(nestedField.Type).Elem())
+
+		if s.getGroupKeyInfo(elemType, &nestedFields[i]).isEmpty() {
+			ambiguous = append(ambiguous, nestedPaths[i])
+		}
+	}
+
+	return ambiguous
+}
@@ -0,0 +1,119 @@
+package qrm
+
+import (
+	"gotest.tools/assert"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type Rental struct {
+	RentalID int32 `sql:"primary_key"`
+}
+
+type Customer struct {
+	Email   string
+	Country string
+	Name    string
+
+	Rentals []Rental
+}
+
+var customerColumns = []string{"customer.email", "customer.country", "customer.name", "rental.rental_id"}
+var customerColumnTypes = []string{"TEXT", "TEXT", "TEXT", "INT4"}
+
+func TestRegisterGroupKey(t *testing.T) {
+	RegisterGroupKey(Customer{}, "Email", "Country")
+	defer delete(groupKeys.types, reflect.TypeOf(Customer{}))
+
+	scanContext := newColumnsScanContext(customerColumns, customerColumnTypes)
+
+	var dest []Customer
+	mapTestRows(t, scanContext, &dest,
+		[]interface{}{"john@mail.com", "US", "John", int64(1)},
+		[]interface{}{"john@mail.com", "US", "John", int64(2)},
+		[]interface{}{"john@mail.com", "UK", "John", int64(3)},
+	)
+
+	assert.DeepEqual(t, dest, []Customer{
+		{Email: "john@mail.com", Country: "US", Name: "John", Rentals: []Rental{{RentalID: 1}, {RentalID: 2}}},
+		{Email: "john@mail.com", Country: "UK", Name: "John", Rentals: []Rental{{RentalID: 3}}},
+	})
+
+	assert.Equal(t, len(ExplainMapping(customerColumns, &dest).AmbiguousGroupings), 0)
+}
+
+func TestRegisterGroupKeyMissingField(t *testing.T) {
+	defer func() {
+		assert.Equal(t, recover(), "jet: qrm.Customer has no field Phone")
+	}()
+
+	RegisterGroupKey(&Customer{}, "Email", "Phone")
+}
+
+func TestRegisterGroupKeyFunc(t *testing.T) {
+	RegisterGroupKeyFunc(Customer{}, func(row Row) interface{} {
+		return strings.ToLower(row.GetString("customer.email"))
+	})
+	defer delete(groupKeys.types, reflect.TypeOf(Customer{}))
+
+	scanContext := newColumnsScanContext(customerColumns, customerColumnTypes)
+
+	var dest []*Customer
+	mapTestRows(t, scanContext, &dest,
+		[]interface{}{"john@mail.com", "US", "John", int64(1)},
+		[]interface{}{"John@Mail.com", "UK", "John", int64(2)},
+		[]interface{}{"mike@mail.com", "US", "Mike", int64(3)},
+	)
+
+	assert.Equal(t, len(dest), 2)
+	assert.Equal(t, dest[0].Country, "US")
+	assert.DeepEqual(t, dest[0].Rentals, []Rental{{RentalID: 1}, {RentalID: 2}})
+	assert.Equal(t, dest[1].Name, "Mike")
+	assert.DeepEqual(t, dest[1].Rentals, []Rental{{RentalID: 3}})
+}
+
+func TestGroupKeyTag(t *testing.T) {
+	type Customer struct {
+		Email   string `sql:"group_key"`
+		Country string `sql:"group_key"`
+
+		Rentals []Rental
+	}
+
+	scanContext := newColumnsScanContext(customerColumns, customerColumnTypes)
+
+	var dest []Customer
+	mapTestRows(t, scanContext, &dest,
+		[]interface{}{"john@mail.com", "US", "John", int64(1)},
+		[]interface{}{"john@mail.com", "UK", "John", int64(2)},
+		[]interface{}{"john@mail.com", "US", "John", int64(3)},
+	)
+
+	assert.DeepEqual(t, dest, []Customer{
+		{Email: "john@mail.com", Country: "US", Rentals: []Rental{{RentalID: 1}, {RentalID: 3}}},
+		{Email: "john@mail.com", Country: "UK", Rentals: []Rental{{RentalID: 2}}},
+	})
+}
+
+func TestAmbiguousGroupings(t *testing.T) {
+	type Payment struct {
+		Amount float64
+	}
+
+	type Store struct {
+		Customers []struct {
+			Customer
+			Payments []Payment
+		}
+	}
+
+	var dest []Store
+
+	mapping := ExplainMapping(append(customerColumns, "payment.amount"), &dest)
+
+	assert.DeepEqual(t, mapping.AmbiguousGroupings, []string{"qrm.Store", "Customers", "Customers.Payments"})
+	assert.Assert(t, strings.HasSuffix(mapping.String(), "ambiguous groupings: qrm.Store, Customers, Customers.Payments"))
+
+	assert.Error(t, mapping.err(), "jet: destination does not match query result, ambiguous groupings: qrm.Store, Customers, Customers.Payments")
+}
@@ -0,0 +1,391 @@
+package qrm
+
+import (
+	"context"
+	"github.com/go-jet/jet/internal/utils"
+	"reflect"
+	"strings"
+	"sync/atomic"
+)
+
+var strictMapping int32
+
+// SetStrictMapping turns on or off strict mapping. In strict mode, Query returns MappingError if any of the result
+// columns is not mapped to destination field, or if any of the destination fields is not mapped to result column.
+// Column values that can not be stored into destination fields are returned as ConversionError.
+// Strict mapping can be changed while queries are executed, and the change applies to subsequent queries.
+// SetStrictMapping sets default for all queries, and WithStrictMapping overrides it for a single query.
+func SetStrictMapping(strict bool) {
+	var value int32
+
+	if strict {
+		value = 1
+	}
+
+	atomic.StoreInt32(&strictMapping, value)
+}
+
+type strictMappingKey struct{}
+
+// WithStrictMapping returns copy of ctx that turns strict mapping on or off for queries executed with it,
+// regardless of SetStrictMapping default.
+func WithStrictMapping(ctx context.Context, strict bool) context.Context {
+	return context.WithValue(ctx, strictMappingKey{}, strict)
+}
+
+func isStrictMapping(ctx context.Context) bool {
+	if ctx != nil {
+		if strict, ok := ctx.Value(strictMappingKey{}).(bool); ok {
+			return strict
+		}
+	}
+
+	return atomic.LoadInt32(&strictMapping) == 1
+}
+
+// MappingError is returned by strict mapping, when query result columns and destination fields do not match
+type MappingError struct {
+	// UnmappedColumns are result columns not mapped to any destination field
+	UnmappedColumns []string
+	// UnfilledFields are destination field paths not mapped to any result column
+	UnfilledFields []string
+	// AmbiguousGroupings are destination slice paths whose elements can not be grouped, because they have no grouping key
+	AmbiguousGroupings []string
+}
+
+func (e *MappingError) Error() string {
+	var problems []string
+
+	if len(e.UnmappedColumns) > 0 {
+		problems = append(problems, "unmapped columns: "+strings.Join(e.UnmappedColumns, ", "))
+	}
+
+	if len(e.UnfilledFields) > 0 {
+		problems = append(problems, "unfilled fields: "+strings.Join(e.UnfilledFields, ", "))
+	}
+
+	if len(e.AmbiguousGroupings) > 0 {
+		problems = append(problems, "ambiguous groupings: "+strings.Join(e.AmbiguousGroupings, ", "))
+	}
+
+	return "jet: destination does not match query result, " + strings.Join(problems, "; ")
+}
+
+// ConversionError is returned by strict mapping, when column value can not be stored into destination field
+type ConversionError struct {
+	// Column is result column name
+	Column string
+	// Field is destination field path, for instance Actors.FirstName
+	Field string
+	// Err is the conversion or scan error
+	Err error
+}
+
+func (e *ConversionError) Error() string {
+	return "jet: " + strings.TrimPrefix(e.Err.Error(), "jet: ") +
+		", column '" + e.Column + "' to field '" + e.Field + "'"
+}
+
+// Unwrap returns underlying conversion error
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// Mapping describes how query result columns are mapped onto destination type
+type Mapping struct {
+	// Fields is the list of destination fields, in order of declaration
+	Fields []MappedField
+	// UnmappedColumns are result columns not mapped to any destination field
+	UnmappedColumns []string
+	// AmbiguousGroupings are destination slice paths whose elements can not be grouped, because they have no
+	// grouping key. Elements without grouping key are duplicated, if they have nested slices, or sibling slices.
+	AmbiguousGroupings []string
+}
+
+// MappedField is destination field with result column it is mapped to
+type MappedField struct {
+	// Field is destination field path, for instance Actors.FirstName
+	Field string
+	// Type is destination field type
+	Type string
+	// Column is result column mapped to the field, or empty string if field is not mapped
+	Column string
+}
+
+// UnfilledFields returns destination field paths not mapped to any result column
+func (m Mapping) UnfilledFields() []string {
+	var fields []string
+
+	for _, field := range m.Fields {
+		if field.Column == "" {
+			fields = append(fields, field.Field)
+		}
+	}
+
+	return fields
+}
+
+// String returns mapping explanation, one destination field per line
+func (m Mapping) String() string {
+	var lines []string
+
+	for _, field := range m.Fields {
+		column := field.Column
+
+		if column == "" {
+			column = "(not mapped)"
+		}
+
+		lines = append(lines, field.Field+" "+field.Type+" <- "+column)
+	}
+
+	if len(m.UnmappedColumns) > 0 {
+		var columns []string
+
+		for _, column := range m.UnmappedColumns {
+			if column == "" {
+				column = "(unnamed)"
+			}
+			columns = append(columns, column)
+		}
+
+		lines = append(lines, "unmapped columns: "+strings.Join(columns, ", "))
+	}
+
+	if len(m.AmbiguousGroupings) > 0 {
+		lines = append(lines, "ambiguous groupings: "+strings.Join(m.AmbiguousGroupings, ", "))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ExplainMapping explains how query result with columns would be mapped onto destination, without running the query.
+// Destination can be either pointer to struct or pointer to slice, the same as Query destination.
+func ExplainMapping(columns []string, destination interface{}) Mapping {
+	destinationType := reflect.TypeOf(destination)
+
+	if destinationType == nil {
+		panic("jet: destination is nil")
+	}
+
+	plan := newMappingPlan(columns)
+
+	return explainMapping(&scanContext{commonIdentToColumnIndex: plan.commonIdentToColumnIndex, plan: plan}, columns, destinationType)
+}
+
+func explainMapping(scanContext *scanContext, columns []string, destinationType reflect.Type) Mapping {
+	explainer := mappingExplainer{
+		scanContext: scanContext,
+		mappedIndex: make([]bool, len(columns)),
+		visited:     map[reflect.Type]bool{},
+	}
+
+	explainer.explainType(indirectType(destinationType), nil, "")
+
+	mapping := Mapping{Fields: explainer.fields, AmbiguousGroupings: explainer.ambiguousGroupings}
+
+	for i, column := range columns {
+		if !explainer.mappedIndex[i] {
+			mapping.UnmappedColumns = append(mapping.UnmappedColumns, column)
+		}
+	}
+
+	for i := range mapping.Fields {
+		if index := explainer.fieldIndexes[i]; index >= 0 {
+			mapping.Fields[i].Column = columns[index]
+		} else if index == allColumns {
+			mapping.Fields[i].Column = "*"
+		}
+	}
+
+	return mapping
+}
+
+// mappingExplainer walks destination type the same way as query result mapping does
+type mappingExplainer struct {
+	scanContext  *scanContext
+	mappedIndex  []bool
+	visited      map[reflect.Type]bool
+	fields       []MappedField
+	fieldIndexes []int
+
+	ambiguousGroupings []string
+}
+
+// allColumns is column index of dynamic row destinations, which are mapped from all result columns
+const allColumns = -2
+
+func (e *mappingExplainer) addField(path string, fieldType reflect.Type, columnIndex int) {
+	if path == "" {
+		path = fieldType.String()
+	}
+
+	e.fields = append(e.fields, MappedField{Field: path, Type: fieldType.String()})
+	e.fieldIndexes = append(e.fieldIndexes, columnIndex)
+
+	if columnIndex >= 0 {
+		e.mappedIndex[columnIndex] = true
+	}
+
+	if columnIndex == allColumns {
+		for i := range e.mappedIndex {
+			e.mappedIndex[i] = true
+		}
+	}
+}
+
+func (e *mappingExplainer) addAmbiguousGroupings(paths []string) {
+	for _, path := range paths {
+		if !utils.StringSliceContains(e.ambiguousGroupings, path) {
+			e.ambiguousGroupings = append(e.ambiguousGroupings, path)
+		}
+	}
+}
+
+// columnAt returns index if result has column at index, or -1 otherwise
+func (e *mappingExplainer) columnAt(index int) int {
+	if index < len(e.mappedIndex) {
+		return index
+	}
+
+	return -1
+}
+
+func (e *mappingExplainer) explainType(destType reflect.Type, parentField *reflect.StructField, path string) {
+	if isDynamicRowType(destType) {
+		e.addField(path, destType, allColumns)
+		return
+	}
+
+	switch destType.Kind() {
+	case reflect.Map:
+		e.addField(joinPath(path, "[key]"), destType.Key(), e.columnAt(0))
+
+		valueType := indirectType(destType.Elem())
+
+		if isSimpleModelType(valueType) {
+			e.addField(joinPath(path, "[value]"), destType.Elem(), e.columnAt(1))
+			return
+		}
+
+		e.explainType(valueType, nil, path)
+	case reflect.Slice:
+		elemType := indirectType(destType.Elem())
+
+		if !isSimpleModelType(elemType) {
+			if elemType.Kind() == reflect.Struct && !isDynamicRowType(elemType) {
+				e.addAmbiguousGroupings(e.scanContext.ambiguousGroupings(elemType, parentField, path))
+			}
+
+			e.explainType(elemType, parentField, path)
+			return
+		}
+
+		index := e.columnAt(0)
+
+		if parentField != nil {
+			typeName, columnName := getTypeAndFieldName("", *parentField)
+			index = e.scanContext.typeToColumnIndex(typeName, columnName)
+		}
+
+		e.addField(path, destType, index)
+	case reflect.Struct:
+		e.explainStruct(destType, []string{getTypeName(destType, parentField)}, path)
+	default:
+		e.addField(path, destType, -1)
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func (e *mappingExplainer) explainStruct(structType reflect.Type, typeNames []string, path string) {
+	if e.visited[structType] {
+		return
+	}
+
+	e.visited[structType] = true
+	defer delete(e.visited, structType)
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		if isIgnoredField(field) {
+			continue
+		}
+
+		fieldPath := joinPath(path, field.Name)
+
+		if isEmbeddedStruct(field) {
+			e.explainStruct(indirectType(field.Type), embeddedTypeNames(typeNames, field), fieldPath)
+			continue
+		}
+
+		columnIndex := e.scanContext.fieldColumnIndex(typeNames, field)
+
+		if implementsScannerType(field.Type) || isSimpleModelType(field.Type) || e.scanContext.hasColumnConverter(columnIndex) {
+			e.addField(fieldPath, field.Type, columnIndex)
+			continue
+		}
+
+		e.explainType(indirectType(field.Type), &field, fieldPath)
+	}
+}
+
+// err returns MappingError if result columns and destination fields do not match
+func (m Mapping) err() error {
+	unfilledFields := m.UnfilledFields()
+
+	if len(m.UnmappedColumns) == 0 && len(unfilledFields) == 0 && len(m.AmbiguousGroupings) == 0 {
+		return nil
+	}
+
+	return &MappingError{
+		UnmappedColumns:    m.UnmappedColumns,
+		UnfilledFields:     unfilledFields,
+		AmbiguousGroupings: m.AmbiguousGroupings,
+	}
+}
+
+func (s *scanContext) conversionError(columnIndex int, structType reflect.Type, field reflect.StructField, err error) error {
+	return &ConversionError{
+		Column: s.columnNames[columnIndex],
+		Field:  s.fieldPath(columnIndex, field.Type, field.Name, structType.Name()+"."+field.Name),
+		Err:    err,
+	}
+}
+
+// sliceConversionError returns ConversionError for base type slice mapped to column at columnIndex. Field is nil,
+// if slice is query destination.
+func (s *scanContext) sliceConversionError(columnIndex int, sliceType reflect.Type, field *reflect.StructField, err error) error {
+	fieldName := sliceType.String()
+
+	if field != nil {
+		fieldName = field.Name
+	}
+
+	return &ConversionError{
+		Column: s.columnNames[columnIndex],
+		Field:  s.fieldPath(columnIndex, sliceType, fieldName, fieldName),
+		Err:    err,
+	}
+}
+
+// fieldPath returns destination field path, mapped to column at columnIndex
+func (s *scanContext) fieldPath(columnIndex int, fieldType reflect.Type, fieldName, defaultPath string) string {
+	if s.mapping != nil {
+		for _, mappedField := range s.mapping.Fields {
+			if mappedField.Column == s.columnNames[columnIndex] && mappedField.Type == fieldType.String() &&
+				(mappedField.Field == fieldName || strings.HasSuffix(mappedField.Field, "."+fieldName)) {
+				return mappedField.Field
+			}
+		}
+	}
+
+	return defaultPath
+}
@@ -0,0 +1,124 @@
+package qrm
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// maxMappingPlans is the number of cached mapping plans, after which the cache is cleared
+const maxMappingPlans = 1000
+
+// mappingPlan is destination type mapping metadata for a set of query result columns. It is created on the first
+// query, and reused by every following query with the same destination type and the same result columns.
+type mappingPlan struct {
+	sync.RWMutex
+	commonIdentToColumnIndex map[string]int
+	typeInfoMap              map[string]typeInfo
+	groupKeyInfoCache        map[string]groupKeyInfo
+	mapping                  *Mapping // strict mapping explanation
+}
+
+func newMappingPlan(aliases []string) *mappingPlan {
+	return &mappingPlan{
+		commonIdentToColumnIndex: commonIdentToColumnIndexMap(aliases),
+		typeInfoMap:              make(map[string]typeInfo),
+		groupKeyInfoCache:        make(map[string]groupKeyInfo),
+	}
+}
+
+type mappingPlanKey struct {
+	destinationType reflect.Type
+	columns         string
+}
+
+var mappingPlans = struct {
+	sync.Mutex
+	plans map[mappingPlanKey]*mappingPlan
+}{
+	plans: map[mappingPlanKey]*mappingPlan{},
+}
+
+// cachedMappingPlan returns mapping plan of destination type for result columns, with column database type names
+func cachedMappingPlan(destinationType reflect.Type, aliases, databaseTypeNames []string) *mappingPlan {
+	key := mappingPlanKey{
+		destinationType: destinationType,
+		columns:         strings.Join(aliases, "\x00") + "\x00\x00" + strings.Join(databaseTypeNames, "\x00"),
+	}
+
+	mappingPlans.Lock()
+	defer mappingPlans.Unlock()
+
+	if plan, ok := mappingPlans.plans[key]; ok {
+		return plan
+	}
+
+	if len(mappingPlans.plans) >= maxMappingPlans {
+		mappingPlans.plans = map[mappingPlanKey]*mappingPlan{}
+	}
+
+	plan := newMappingPlan(aliases)
+	mappingPlans.plans[key] = plan
+
+	return plan
+}
+
+// ClearMappingCache removes cached destination mapping metadata. Metadata is cached for each destination type and
+// query result columns, and it is cleared automatically when new converter or group key is registered.
+func ClearMappingCache() {
+	mappingPlans.Lock()
+	defer mappingPlans.Unlock()
+
+	mappingPlans.plans = map[mappingPlanKey]*mappingPlan{}
+}
+
+func (p *mappingPlan) typeInfo(key string) (typeInfo, bool) {
+	p.RLock()
+	defer p.RUnlock()
+
+	typeInfo, ok := p.typeInfoMap[key]
+
+	return typeInfo, ok
+}
+
+func (p *mappingPlan) setTypeInfo(key string, typeInfo typeInfo) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.typeInfoMap[key] = typeInfo
+}
+
+func (p *mappingPlan) groupKeyInfo(key string) (groupKeyInfo, bool) {
+	p.RLock()
+	defer p.RUnlock()
+
+	groupKeyInfo, ok := p.groupKeyInfoCache[key]
+
+	return groupKeyInfo, ok
+}
+
+func (p *mappingPlan) setGroupKeyInfo(key string, groupKeyInfo groupKeyInfo) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.groupKeyInfoCache[key] = groupKeyInfo
+}
+
+// strictMapping returns mapping explanation of destination type, explained once per plan
+func (p *mappingPlan) strictMapping(scanContext *scanContext, destinationType reflect.Type) Mapping {
+	p.RLock()
+	mapping := p.mapping
+	p.RUnlock()
+
+	if mapping != nil {
+		return *mapping
+	}
+
+	newMapping := explainMapping(scanContext, scanContext.columnNames, destinationType)
+
+	p.Lock()
+	p.mapping = &newMapping
+	p.Unlock()
+
+	return newMapping
+}
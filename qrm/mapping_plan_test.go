@@ -0,0 +1,154 @@
+package qrm
+
+import (
+	"database/sql/driver"
+	"gotest.tools/assert"
+	"reflect"
+	"testing"
+)
+
+type benchLanguage struct {
+	LanguageID int32 `sql:"primary_key"`
+	Name       string
+}
+
+type benchActor struct {
+	ActorID   int32 `sql:"primary_key"`
+	FirstName string
+	LastName  string
+}
+
+type benchCategory struct {
+	CategoryID int32 `sql:"primary_key"`
+	Name       string
+}
+
+type benchFilm struct {
+	FilmID      int32 `sql:"primary_key"`
+	Title       string
+	Description *string
+	ReleaseYear *int32
+	RentalRate  float64
+
+	Language   benchLanguage
+	Actors     []benchActor
+	Categories []*benchCategory
+}
+
+var benchColumns = []string{
+	"benchFilm.film_id", "benchFilm.title", "benchFilm.description", "benchFilm.release_year", "benchFilm.rental_rate",
+	"benchLanguage.language_id", "benchLanguage.name",
+	"benchActor.actor_id", "benchActor.first_name", "benchActor.last_name",
+	"benchCategory.category_id", "benchCategory.name",
+}
+
+var benchColumnTypes = []string{"INT4", "TEXT", "TEXT", "INT4", "NUMERIC", "INT4", "TEXT", "INT4", "TEXT", "TEXT", "INT4", "TEXT"}
+
+func benchRows() [][]interface{} {
+	var rows [][]interface{}
+
+	for film := int64(1); film <= 10; film++ {
+		for actor := int64(1); actor <= 5; actor++ {
+			for category := int64(1); category <= 2; category++ {
+				rows = append(rows, []interface{}{
+					film, "Academy Dinosaur", "Epic drama", int64(2006), 0.99,
+					int64(1), "English",
+					actor, "Penelope", "Guiness",
+					category, "Documentary",
+				})
+			}
+		}
+	}
+
+	return rows
+}
+
+func benchmarkQueryMapping(b *testing.B, newScanContext func() *scanContext) {
+	rows := benchRows()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		scanContext := newScanContext()
+
+		var dest []benchFilm
+		destPtrValue := reflect.ValueOf(&dest)
+
+		for _, row := range rows {
+			scanTestRow(scanContext, row...)
+
+			if _, err := mapRowToDestinationPtr(scanContext, "", destPtrValue, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkQueryMapping(b *testing.B) {
+	benchmarkQueryMapping(b, func() *scanContext {
+		return newColumnsScanContext(benchColumns, benchColumnTypes)
+	})
+}
+
+func BenchmarkQueryMappingCachedPlan(b *testing.B) {
+	destinationType := reflect.TypeOf(&[]benchFilm{})
+
+	benchmarkQueryMapping(b, func() *scanContext {
+		plan := cachedMappingPlan(destinationType, benchColumns, benchColumnTypes)
+		return newPlanScanContext(plan, benchColumns, benchColumnTypes)
+	})
+}
+
+func TestCachedMappingPlan(t *testing.T) {
+	defer ClearMappingCache()
+
+	destinationType := reflect.TypeOf(&[]benchFilm{})
+
+	plan := cachedMappingPlan(destinationType, benchColumns, benchColumnTypes)
+
+	assert.Equal(t, cachedMappingPlan(destinationType, benchColumns, benchColumnTypes), plan)
+	assert.Assert(t, cachedMappingPlan(reflect.TypeOf(&[]benchActor{}), benchColumns, benchColumnTypes) != plan)
+	assert.Assert(t, cachedMappingPlan(destinationType, benchColumns[:3], benchColumnTypes[:3]) != plan)
+
+	scanContext := newPlanScanContext(plan, benchColumns, benchColumnTypes)
+	scanContext.getTypeInfo(reflect.TypeOf(benchFilm{}), nil)
+	assert.Equal(t, len(plan.typeInfoMap), 1)
+
+	RegisterConverter(money{}, func(value driver.Value) (interface{}, error) {
+		return money{}, nil
+	})
+	defer delete(converters.goTypes, reflect.TypeOf(money{}))
+
+	assert.Assert(t, cachedMappingPlan(destinationType, benchColumns, benchColumnTypes) != plan)
+}
+
+func TestCachedMappingPlanConcurrentQueries(t *testing.T) {
+	defer ClearMappingCache()
+
+	destinationType := reflect.TypeOf(&[]benchFilm{})
+	rows := benchRows()
+	done := make(chan []benchFilm)
+
+	for i := 0; i < 4; i++ {
+		go func() {
+			plan := cachedMappingPlan(destinationType, benchColumns, benchColumnTypes)
+			scanContext := newPlanScanContext(plan, benchColumns, benchColumnTypes)
+
+			var dest []benchFilm
+			for _, row := range rows {
+				scanTestRow(scanContext, row...)
+				_, _ = mapRowToDestinationPtr(scanContext, "", reflect.ValueOf(&dest), nil)
+			}
+
+			done <- dest
+		}()
+	}
+
+	for i := 0; i < 4; i++ {
+		dest := <-done
+		assert.Equal(t, len(dest), 10)
+		assert.Equal(t, len(dest[9].Actors), 5)
+		assert.Equal(t, len(dest[9].Categories), 2)
+	}
+}
@@ -0,0 +1,88 @@
+package qrm
+
+import (
+	"context"
+	"errors"
+	"gotest.tools/assert"
+	"reflect"
+	"testing"
+)
+
+type film struct {
+	FilmID int32 `sql:"primary_key"`
+	Title  string
+	Length *int16
+}
+
+type actor struct {
+	ActorID   int32 `sql:"primary_key"`
+	FirstName string
+}
+
+func TestExplainMapping(t *testing.T) {
+	var dest []struct {
+		Film film
+
+		Actors []actor
+		Tags   []string `alias:"tags"`
+	}
+
+	mapping := ExplainMapping([]string{"film.film_id", "film.title", "actor.actor_id", "actor.first_name", "tags", "film.rating"}, &dest)
+
+	assert.DeepEqual(t, mapping.Fields, []MappedField{
+		{Field: "Film.FilmID", Type: "int32", Column: "film.film_id"},
+		{Field: "Film.Title", Type: "string", Column: "film.title"},
+		{Field: "Film.Length", Type: "*int16"},
+		{Field: "Actors.ActorID", Type: "int32", Column: "actor.actor_id"},
+		{Field: "Actors.FirstName", Type: "string", Column: "actor.first_name"},
+		{Field: "Tags", Type: "[]string", Column: "tags"},
+	})
+	assert.DeepEqual(t, mapping.UnmappedColumns, []string{"film.rating"})
+	assert.DeepEqual(t, mapping.UnfilledFields(), []string{"Film.Length"})
+
+	assert.Equal(t, mapping.String(), `Film.FilmID int32 <- film.film_id
+Film.Title string <- film.title
+Film.Length *int16 <- (not mapped)
+Actors.ActorID int32 <- actor.actor_id
+Actors.FirstName string <- actor.first_name
+Tags []string <- tags
+unmapped columns: film.rating`)
+
+	assert.Error(t, mapping.err(), "jet: destination does not match query result, "+
+		"unmapped columns: film.rating; unfilled fields: Film.Length")
+}
+
+func TestExplainMappingBaseTypeSlice(t *testing.T) {
+	var dest []int64
+
+	mapping := ExplainMapping([]string{"count", "sum"}, &dest)
+
+	assert.DeepEqual(t, mapping.Fields, []MappedField{{Field: "[]int64", Type: "[]int64", Column: "count"}})
+	assert.DeepEqual(t, mapping.UnmappedColumns, []string{"sum"})
+}
+
+func TestConversionError(t *testing.T) {
+	scanContext := &scanContext{columnNames: []string{"film.title"}}
+
+	structType := reflect.TypeOf(film{})
+	field, _ := structType.FieldByName("Title")
+
+	err := scanContext.conversionError(0, structType, field, errors.New("jet: can't set int16 to string"))
+
+	assert.Error(t, err, "jet: can't set int16 to string, column 'film.title' to field 'film.Title'")
+	assert.Error(t, err.(*ConversionError).Unwrap(), "jet: can't set int16 to string")
+}
+
+func TestWithStrictMapping(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Assert(t, !isStrictMapping(ctx))
+	assert.Assert(t, isStrictMapping(WithStrictMapping(ctx, true)))
+
+	SetStrictMapping(true)
+	defer SetStrictMapping(false)
+
+	assert.Assert(t, isStrictMapping(ctx))
+	assert.Assert(t, isStrictMapping(nil))
+	assert.Assert(t, !isStrictMapping(WithStrictMapping(ctx, false)))
+}
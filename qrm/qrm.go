@@ -2,7 +2,9 @@ package qrm
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"github.com/go-jet/jet/internal/utils"
 	"reflect"
 )
@@ -12,24 +14,100 @@ var ErrNoRows = errors.New("qrm: no rows in result set")
 
 // Query executes Query Result Mapping (QRM) of `query` with list of parametrized arguments `arg` over database connection `db`
 // using context `ctx` into destination `destPtr`.
-// Destination can be either pointer to struct or pointer to slice of structs.
+// Destination can be either pointer to struct, pointer to slice of structs, pointers to structs or base types, or
+// pointer to map keyed by the first result column. Map values can be structs, pointers to structs, slices, base types
+// or maps, and rows with the same key are mapped into the same map value. Rows can be mapped into
+// map[string]interface{} or Row destinations as well.
+// Fields of anonymous embedded structs are mapped as fields of the parent struct, and if there is no such column,
+// as fields of embedded struct type. Unexported fields and fields with `alias:"-"` tag are not mapped.
 // If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
 func Query(ctx context.Context, db DB, query string, args []interface{}, destPtr interface{}) error {
 
 	utils.MustBeInitializedPtr(db, "jet: db is nil")
+	mustBeDestinationPtr(destPtr)
+
+	return mapDestination(destPtr, func(destPtr interface{}) (int64, error) {
+		return queryToDestination(ctx, db, query, args, destPtr)
+	})
+}
+
+// QueryBatch executes query with multiple statements, and maps result set of each statement into destination with
+// the same index. Destinations are the same as Query destinations. Database driver has to return multiple result
+// sets for one query. If destination is pointer to struct and its result set is empty, the rest of the result sets
+// are still mapped, and method returns qrm.ErrNoRows.
+func QueryBatch(ctx context.Context, db DB, query string, args []interface{}, destinations ...interface{}) error {
+
+	utils.MustBeInitializedPtr(db, "jet: db is nil")
+
+	for _, destPtr := range destinations {
+		mustBeDestinationPtr(destPtr)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var noRowsErr error
+
+	for i, destPtr := range destinations {
+		if i > 0 && !rows.NextResultSet() {
+			if err = rows.Err(); err != nil {
+				return err
+			}
+
+			return fmt.Errorf("jet: query returned %d result sets, expected %d", i, len(destinations))
+		}
+
+		err = mapDestination(destPtr, func(destPtr interface{}) (int64, error) {
+			return mapRowsToDestination(ctx, rows, destPtr)
+		})
+
+		if err == ErrNoRows {
+			noRowsErr = err
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Close(); err != nil {
+		return err
+	}
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	return noRowsErr
+}
+
+func mustBeDestinationPtr(destPtr interface{}) {
 	utils.MustBeInitializedPtr(destPtr, "jet: destination is nil")
-	utils.MustBe(destPtr, reflect.Ptr, "jet: destination has to be a pointer to slice or pointer to struct")
+	utils.MustBe(destPtr, reflect.Ptr, "jet: destination has to be a pointer to slice, pointer to struct or pointer to map")
+}
 
+// mapDestination maps query result into destination with mapRows. Struct destination is mapped through
+// temporary slice, and qrm.ErrNoRows is returned if query result set is empty.
+func mapDestination(destPtr interface{}, mapRows func(destPtr interface{}) (rowsProcessed int64, err error)) error {
 	destinationPtrType := reflect.TypeOf(destPtr)
 
-	if destinationPtrType.Elem().Kind() == reflect.Slice {
-		_, err := queryToSlice(ctx, db, query, args, destPtr)
+	if destinationPtrType.Elem().Kind() == reflect.Slice || destinationPtrType.Elem().Kind() == reflect.Map {
+		_, err := mapRows(destPtr)
 		return err
 	} else if destinationPtrType.Elem().Kind() == reflect.Struct {
 		tempSlicePtrValue := reflect.New(reflect.SliceOf(destinationPtrType))
 		tempSliceValue := tempSlicePtrValue.Elem()
 
-		rowsProcessed, err := queryToSlice(ctx, db, query, args, tempSlicePtrValue.Interface())
+		rowsProcessed, err := mapRows(tempSlicePtrValue.Interface())
 
 		if err != nil {
 			return err
@@ -52,11 +130,102 @@ func Query(ctx context.Context, db DB, query string, args []interface{}, destPtr
 		}
 		return nil
 	} else {
-		panic("jet: destination has to be a pointer to slice or pointer to struct")
+		panic("jet: destination has to be a pointer to slice, pointer to struct or pointer to map")
 	}
 }
 
-func queryToSlice(ctx context.Context, db DB, query string, args []interface{}, slicePtr interface{}) (rowsProcessed int64, err error) {
+// QueryReturning executes Query Result Mapping of statement with RETURNING clause, and returns number of rows returned
+// by the statement. Destination is the same as Query destination, except that rows returned into pointer to non-empty
+// slice are mapped in place, into slice element with the same index as the row. For instance, generated IDs of
+// inserted models are stored into models with the same order. Rows are matched to slice elements only by position,
+// so statement has to return exactly one row for each slice element, in the same order. If number of returned rows
+// differs from slice length (for instance, because of ON CONFLICT DO NOTHING), QueryReturning returns an error and
+// leaves slice elements unchanged. Statement is executed nevertheless. Positional matching is reliable only for
+// INSERT statements, because PostgreSQL and SQLite do not guarantee the order of rows returned by UPDATE or DELETE.
+// Row returned into pointer to struct, or into pointer to struct pointer, is mapped in place as well, so struct
+// fields not returned by the statement are kept.
+func QueryReturning(ctx context.Context, db DB, query string, args []interface{}, destPtr interface{}) (rowsReturned int64, err error) {
+
+	utils.MustBeInitializedPtr(db, "jet: db is nil")
+	mustBeDestinationPtr(destPtr)
+
+	destValue := reflect.ValueOf(destPtr).Elem()
+
+	switch {
+	case destValue.Kind() == reflect.Slice && destValue.Len() > 0:
+		rowsReturned, err = queryInPlace(ctx, db, query, args, destPtr)
+
+		if err == nil && rowsReturned != int64(destValue.Len()) {
+			err = fmt.Errorf("jet: statement returned %d rows, but destination slice has %d elements", rowsReturned, destValue.Len())
+		}
+
+		return rowsReturned, err
+
+	case destValue.Kind() == reflect.Struct:
+		return queryStructInPlace(ctx, db, query, args, destValue.Addr())
+
+	case destValue.Kind() == reflect.Ptr && destValue.Type().Elem().Kind() == reflect.Struct:
+		if !destValue.IsNil() {
+			return queryStructInPlace(ctx, db, query, args, destValue)
+		}
+
+		structPtrValue := reflect.New(destValue.Type().Elem())
+		rowsReturned, err = queryStructInPlace(ctx, db, query, args, structPtrValue)
+
+		if err == nil {
+			destValue.Set(structPtrValue)
+		}
+
+		return rowsReturned, err
+	}
+
+	err = mapDestination(destPtr, func(destPtr interface{}) (int64, error) {
+		rowsReturned, err = queryToDestination(ctx, db, query, args, destPtr)
+		return rowsReturned, err
+	})
+
+	return
+}
+
+// queryInPlace maps every row of query result into existing destination slice element with the same index
+func queryInPlace(ctx context.Context, db DB, query string, args []interface{}, slicePtr interface{}) (rowsProcessed int64, err error) {
+	return queryRows(ctx, db, query, args, func(rows *sql.Rows) (int64, error) {
+		return mapRowsInPlace(ctx, rows, slicePtr)
+	})
+}
+
+// queryStructInPlace maps single row of query result into existing struct that structPtrValue points to.
+// If query result set is empty, qrm.ErrNoRows is returned.
+func queryStructInPlace(ctx context.Context, db DB, query string, args []interface{}, structPtrValue reflect.Value) (rowsProcessed int64, err error) {
+	slicePtrValue := reflect.New(reflect.SliceOf(structPtrValue.Type()))
+	slicePtrValue.Elem().Set(reflect.Append(slicePtrValue.Elem(), structPtrValue))
+
+	rowsProcessed, err = queryInPlace(ctx, db, query, args, slicePtrValue.Interface())
+
+	if err != nil {
+		return rowsProcessed, err
+	}
+
+	if rowsProcessed == 0 {
+		return 0, ErrNoRows
+	}
+
+	if rowsProcessed > 1 {
+		return rowsProcessed, fmt.Errorf("jet: statement returned %d rows, but destination is single struct", rowsProcessed)
+	}
+
+	return rowsProcessed, nil
+}
+
+// queryToDestination maps every row of query result into destination slice or map
+func queryToDestination(ctx context.Context, db DB, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
+	return queryRows(ctx, db, query, args, func(rows *sql.Rows) (int64, error) {
+		return mapRowsToDestination(ctx, rows, destPtr)
+	})
+}
+
+// queryRows executes query and maps query result rows with mapRows
+func queryRows(ctx context.Context, db DB, query string, args []interface{}, mapRows func(rows *sql.Rows) (rowsProcessed int64, err error)) (rowsProcessed int64, err error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -68,7 +237,49 @@ func queryToSlice(ctx context.Context, db DB, query string, args []interface{},
 	}
 	defer rows.Close()
 
-	scanContext, err := newScanContext(rows)
+	rowsProcessed, err = mapRows(rows)
+
+	if err != nil {
+		return
+	}
+
+	err = rows.Close()
+	if err != nil {
+		return
+	}
+
+	err = rows.Err()
+
+	return
+}
+
+// newDestinationScanContext returns scan context for current result set of rows. In strict mapping mode, it returns
+// MappingError if result set columns do not match destination.
+func newDestinationScanContext(ctx context.Context, rows *sql.Rows, destinationType reflect.Type) (*scanContext, error) {
+	scanContext, err := newScanContext(rows, destinationType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	scanContext.strict = isStrictMapping(ctx)
+
+	if scanContext.strict {
+		mapping := scanContext.plan.strictMapping(scanContext, destinationType)
+
+		if err = mapping.err(); err != nil {
+			return nil, err
+		}
+
+		scanContext.mapping = &mapping
+	}
+
+	return scanContext, nil
+}
+
+// mapRowsToDestination maps every row of current result set into destination slice or map
+func mapRowsToDestination(ctx context.Context, rows *sql.Rows, destPtr interface{}) (rowsProcessed int64, err error) {
+	scanContext, err := newDestinationScanContext(ctx, rows, reflect.TypeOf(destPtr))
 
 	if err != nil {
 		return
@@ -78,7 +289,7 @@ func queryToSlice(ctx context.Context, db DB, query string, args []interface{},
 		return
 	}
 
-	slicePtrValue := reflect.ValueOf(slicePtr)
+	destPtrValue := reflect.ValueOf(destPtr)
 
 	for rows.Next() {
 		err = rows.Scan(scanContext.row...)
@@ -89,18 +300,57 @@ func queryToSlice(ctx context.Context, db DB, query string, args []interface{},
 
 		scanContext.rowNum++
 
-		_, err = mapRowToSlice(scanContext, "", slicePtrValue, nil)
+		_, err = mapRowToDestinationPtr(scanContext, "", destPtrValue, nil)
 
 		if err != nil {
 			return
 		}
 	}
 
-	err = rows.Close()
+	err = rows.Err()
+
+	if err != nil {
+		return
+	}
+
+	rowsProcessed = scanContext.rowNum
+
+	return
+}
+
+// mapRowsInPlace maps every row of current result set into destination slice element with the same index.
+// Rows are mapped into copies of slice elements, and slice elements are updated only if number of rows
+// matches slice length. Callers compare number of processed rows with slice length.
+func mapRowsInPlace(ctx context.Context, rows *sql.Rows, slicePtr interface{}) (rowsProcessed int64, err error) {
+	scanContext, err := newDestinationScanContext(ctx, rows, reflect.TypeOf(slicePtr))
+
 	if err != nil {
 		return
 	}
 
+	sliceValue := reflect.ValueOf(slicePtr).Elem()
+	sliceCopy := copySliceElems(sliceValue)
+
+	for rows.Next() {
+		err = rows.Scan(scanContext.row...)
+
+		if err != nil {
+			return
+		}
+
+		scanContext.rowNum++
+
+		if scanContext.rowNum > int64(sliceCopy.Len()) {
+			continue
+		}
+
+		err = mapRowToSliceElem(scanContext, sliceCopy.Index(int(scanContext.rowNum-1)))
+
+		if err != nil {
+			return
+		}
+	}
+
 	err = rows.Err()
 
 	if err != nil {
@@ -109,9 +359,94 @@ func queryToSlice(ctx context.Context, db DB, query string, args []interface{},
 
 	rowsProcessed = scanContext.rowNum
 
+	if rowsProcessed != int64(sliceValue.Len()) {
+		return
+	}
+
+	setSliceElems(sliceValue, sliceCopy)
+
 	return
 }
 
+// copySliceElems returns new slice with copies of slice elements. Pointer elements point to copies of pointed values.
+func copySliceElems(sliceValue reflect.Value) reflect.Value {
+	sliceCopy := reflect.MakeSlice(sliceValue.Type(), sliceValue.Len(), sliceValue.Len())
+	reflect.Copy(sliceCopy, sliceValue)
+
+	for i := 0; i < sliceCopy.Len(); i++ {
+		elem := sliceCopy.Index(i)
+
+		if elem.Kind() == reflect.Ptr && !elem.IsNil() {
+			elemCopy := reflect.New(elem.Type().Elem())
+			elemCopy.Elem().Set(elem.Elem())
+			elem.Set(elemCopy)
+		}
+	}
+
+	return sliceCopy
+}
+
+// setSliceElems sets slice elements to values of slice copy elements. Pointer elements keep pointing
+// to the same values.
+func setSliceElems(sliceValue, sliceCopy reflect.Value) {
+	for i := 0; i < sliceValue.Len(); i++ {
+		elem, elemCopy := sliceValue.Index(i), sliceCopy.Index(i)
+
+		if elem.Kind() == reflect.Ptr && !elem.IsNil() && !elemCopy.IsNil() {
+			elem.Elem().Set(elemCopy.Elem())
+			continue
+		}
+
+		elem.Set(elemCopy)
+	}
+}
+
+// mapRowToSliceElem maps current row into existing slice element
+func mapRowToSliceElem(scanContext *scanContext, elemValue reflect.Value) error {
+	elemType := indirectType(elemValue.Type())
+
+	if isDynamicRowType(elemType) {
+		newRowValue := newDynamicRowValue(scanContext, elemType)
+
+		if elemValue.Kind() == reflect.Ptr {
+			elemValue.Set(reflect.New(elemType))
+			elemValue.Elem().Set(newRowValue)
+		} else {
+			elemValue.Set(newRowValue)
+		}
+
+		return nil
+	}
+
+	if isSimpleModelType(elemType) {
+		cellValue, err := scanContext.rowElemErr(0)
+
+		if err == nil && cellValue != nil {
+			err = setReflectValue(reflect.ValueOf(cellValue), elemValue)
+		}
+
+		if err != nil && scanContext.strict {
+			return sliceConversionError(scanContext, 0, reflect.SliceOf(elemValue.Type()), nil, err)
+		}
+
+		return err
+	}
+
+	if elemType.Kind() != reflect.Struct {
+		return errors.New("jet: destination slice elements have to be structs, pointers to structs or base types")
+	}
+
+	if elemValue.Kind() == reflect.Ptr {
+		initializeValueIfNilPtr(elemValue)
+	} else {
+		elemValue = elemValue.Addr()
+	}
+
+	_, err := mapRowToStruct(scanContext, "", elemValue, nil)
+
+	return err
+}
+
 func mapRowToSlice(scanContext *scanContext, groupKey string, slicePtrValue reflect.Value, field *reflect.StructField) (updated bool, err error) {
 
 	sliceElemType := getSliceElemType(slicePtrValue)
@@ -121,6 +456,13 @@ func mapRowToSlice(scanContext *scanContext, groupKey string, slicePtrValue refl
 		return
 	}
 
+	if isDynamicRowType(sliceElemType) {
+		rowPtrValue := reflect.New(sliceElemType)
+		rowPtrValue.Elem().Set(newDynamicRowValue(scanContext, sliceElemType))
+
+		return true, appendElemToSlice(slicePtrValue, rowPtrValue)
+	}
+
 	utils.TypeMustBe(sliceElemType, reflect.Struct, "jet: unsupported slice element type"+fieldToString(field))
 
 	structGroupKey := scanContext.getGroupKey(sliceElemType, field)
@@ -155,6 +497,16 @@ func mapRowToSlice(scanContext *scanContext, groupKey string, slicePtrValue refl
 	return
 }
 
+// sliceConversionError returns ConversionError for base type slice elements in strict mapping mode. Otherwise,
+// conversion error panics.
+func sliceConversionError(scanContext *scanContext, columnIndex int, sliceType reflect.Type, field *reflect.StructField, err error) error {
+	if !scanContext.strict {
+		panic(err.Error())
+	}
+
+	return scanContext.sliceConversionError(columnIndex, sliceType, field, err)
+}
+
 func mapRowToBaseTypeSlice(scanContext *scanContext, slicePtrValue reflect.Value, field *reflect.StructField) (updated bool, err error) {
 	index := 0
 	if field != nil {
@@ -163,7 +515,25 @@ func mapRowToBaseTypeSlice(scanContext *scanContext, slicePtrValue reflect.Value
 			return
 		}
 	}
-	rowElemPtr := scanContext.rowElemValuePtr(index)
+	if converter := goTypeConverter(getSliceElemType(slicePtrValue)); converter != nil {
+		elemPtr := newElemPtrValueForSlice(slicePtrValue)
+
+		if scanContext.rowRawElem(index) == nil {
+			return
+		}
+
+		if err = setConvertedValue(scanContext, index, converter, elemPtr.Elem()); err != nil {
+			return false, sliceConversionError(scanContext, index, slicePtrValue.Type().Elem(), field, err)
+		}
+
+		return true, appendElemToSlice(slicePtrValue, elemPtr)
+	}
+
+	rowElemPtr, err := scanContext.rowElemValuePtr(index)
+
+	if err != nil {
+		return false, sliceConversionError(scanContext, index, slicePtrValue.Type().Elem(), field, err)
+	}
 
 	if !rowElemPtr.IsNil() {
 		updated = true
@@ -177,22 +547,46 @@ func mapRowToBaseTypeSlice(scanContext *scanContext, slicePtrValue reflect.Value
 }
 
 func mapRowToStruct(scanContext *scanContext, groupKey string, structPtrValue reflect.Value, parentField *reflect.StructField, onlySlices ...bool) (updated bool, err error) {
-	mapOnlySlices := len(onlySlices) > 0
 	structType := structPtrValue.Type().Elem()
+	typeNames := []string{getTypeName(structType, parentField)}
 
-	typeInf := scanContext.getTypeInfo(structType, parentField)
+	return mapRowToStructFields(scanContext, groupKey, structPtrValue, typeNames, len(onlySlices) > 0)
+}
+
+func mapRowToStructFields(scanContext *scanContext, groupKey string, structPtrValue reflect.Value, typeNames []string, mapOnlySlices bool) (updated bool, err error) {
+	structType := structPtrValue.Type().Elem()
+
+	typeInf := scanContext.getTypeNamesInfo(structType, typeNames)
 
 	structValue := structPtrValue.Elem()
 
 	for i := 0; i < structValue.NumField(); i++ {
 		field := structType.Field(i)
 		fieldValue := structValue.Field(i)
+		fieldMap := typeInf.fieldMappings[i]
 
-		if !fieldValue.CanSet() { // private field
+		if fieldMap.ignored {
 			continue
 		}
 
-		fieldMap := typeInf.fieldMappings[i]
+		if fieldMap.embedded {
+			var changed bool
+			changed, err = mapRowToEmbeddedStruct(scanContext, groupKey, fieldValue, fieldMap.typeNames, mapOnlySlices)
+
+			if err != nil {
+				return
+			}
+
+			if changed {
+				updated = true
+			}
+
+			continue
+		}
+
+		if !fieldValue.CanSet() { // private field
+			continue
+		}
 
 		if fieldMap.complexType {
 			var changed bool
@@ -211,7 +605,14 @@ func mapRowToStruct(scanContext *scanContext, groupKey string, structPtrValue re
 				continue
 			}
 
-			cellValue := scanContext.rowElem(fieldMap.columnIndex)
+			cellValue, err := scanContext.rowElemErr(fieldMap.columnIndex)
+
+			if err != nil {
+				if scanContext.strict {
+					return false, scanContext.conversionError(fieldMap.columnIndex, structType, field, err)
+				}
+				panic(err)
+			}
 
 			if cellValue == nil {
 				continue
@@ -220,16 +621,23 @@ func mapRowToStruct(scanContext *scanContext, groupKey string, structPtrValue re
 			initializeValueIfNilPtr(fieldValue)
 			updated = true
 
-			if fieldMap.implementsScanner {
-				scanner := getScanner(fieldValue)
+			if fieldMap.converter != nil {
+				err = setConvertedValue(scanContext, fieldMap.columnIndex, fieldMap.converter, fieldValue)
+			} else if fieldMap.implementsScanner {
+				err = getScanner(fieldValue).Scan(cellValue)
 
-				err = scanner.Scan(cellValue)
-
-				if err != nil {
+				if err != nil && !scanContext.strict {
 					panic("jet: " + err.Error() + ", " + fieldToString(&field) + " of type " + structType.String())
 				}
 			} else {
-				setReflectValue(reflect.ValueOf(cellValue), fieldValue)
+				err = setReflectValue(reflect.ValueOf(cellValue), fieldValue)
+			}
+
+			if err != nil {
+				if scanContext.strict {
+					return false, scanContext.conversionError(fieldMap.columnIndex, structType, field, err)
+				}
+				panic(err.Error())
 			}
 		}
 	}
@@ -237,6 +645,42 @@ func mapRowToStruct(scanContext *scanContext, groupKey string, structPtrValue re
 	return
 }
 
+// mapRowToEmbeddedStruct maps row into embedded struct fields. Embedded struct pointer is initialized only if
+// any of the embedded struct fields is set.
+func mapRowToEmbeddedStruct(scanContext *scanContext, groupKey string, fieldValue reflect.Value, typeNames []string, mapOnlySlices bool) (updated bool, err error) {
+	if fieldValue.Kind() != reflect.Ptr {
+		return mapRowToStructFields(scanContext, groupKey, fieldValue.Addr(), typeNames, mapOnlySlices)
+	}
+
+	if !fieldValue.IsNil() {
+		return mapRowToStructFields(scanContext, groupKey, fieldValue, typeNames, mapOnlySlices)
+	}
+
+	embeddedPtrValue := reflect.New(fieldValue.Type().Elem())
+
+	updated, err = mapRowToStructFields(scanContext, groupKey, embeddedPtrValue, typeNames, mapOnlySlices)
+
+	if updated && err == nil {
+		fieldValue.Set(embeddedPtrValue)
+	}
+
+	return
+}
+
+func setConvertedValue(scanContext *scanContext, columnIndex int, converter ConverterFunc, destination reflect.Value) error {
+	value, err := scanContext.convertRowElem(columnIndex, converter)
+
+	if err != nil {
+		return errors.New("jet: " + err.Error())
+	}
+
+	if value == nil {
+		return nil
+	}
+
+	return setReflectValue(reflect.ValueOf(value), destination)
+}
+
 func mapRowToDestinationValue(scanContext *scanContext, groupKey string, dest reflect.Value, structField *reflect.StructField) (updated bool, err error) {
 
 	var destPtrValue reflect.Value
@@ -270,7 +714,12 @@ func mapRowToDestinationPtr(scanContext *scanContext, groupKey string, destPtrVa
 
 	destValueKind := destPtrValue.Elem().Kind()
 
-	if destValueKind == reflect.Struct {
+	if isDynamicRowType(destPtrValue.Elem().Type()) {
+		destPtrValue.Elem().Set(newDynamicRowValue(scanContext, destPtrValue.Elem().Type()))
+		return true, nil
+	} else if destValueKind == reflect.Map {
+		return mapRowToMap(scanContext, groupKey, destPtrValue)
+	} else if destValueKind == reflect.Struct {
 		return mapRowToStruct(scanContext, groupKey, destPtrValue, structField)
 	} else if destValueKind == reflect.Slice {
 		return mapRowToSlice(scanContext, groupKey, destPtrValue, structField)
@@ -278,3 +727,68 @@ func mapRowToDestinationPtr(scanContext *scanContext, groupKey string, destPtrVa
 		panic("jet: unsupported dest type: " + structField.Name + " " + structField.Type.String())
 	}
 }
+
+// mapRowToMap maps row into map value, keyed by the first column value. Rows with the same key are mapped into
+// the same map value, so map values can group nested slices the same way slice destinations do.
+func mapRowToMap(scanContext *scanContext, groupKey string, mapPtrValue reflect.Value) (updated bool, err error) {
+	mapValue := mapPtrValue.Elem()
+	mapType := mapValue.Type()
+
+	keyCell := scanContext.rowElem(0)
+
+	if keyCell == nil {
+		return
+	}
+
+	key := reflect.New(mapType.Key()).Elem()
+
+	if err = setReflectValue(reflect.ValueOf(keyCell), key); err != nil {
+		return
+	}
+
+	if mapValue.IsNil() {
+		mapValue.Set(reflect.MakeMap(mapType))
+	}
+
+	valueType := mapType.Elem()
+	existingValue := mapValue.MapIndex(key)
+
+	var valuePtr reflect.Value
+
+	if valueType.Kind() == reflect.Ptr && existingValue.IsValid() {
+		valuePtr = existingValue
+	} else {
+		valuePtr = reflect.New(indirectType(valueType))
+
+		if existingValue.IsValid() {
+			valuePtr.Elem().Set(existingValue)
+		}
+	}
+
+	switch {
+	case isSimpleModelType(valuePtr.Elem().Type()):
+		if len(scanContext.row) < 2 {
+			return
+		}
+
+		if valueCell := scanContext.rowElem(1); valueCell != nil {
+			err = setReflectValue(reflect.ValueOf(valueCell), valuePtr.Elem())
+		}
+	case existingValue.IsValid() && valuePtr.Elem().Kind() == reflect.Struct && !isDynamicRowType(valuePtr.Elem().Type()):
+		_, err = mapRowToStruct(scanContext, groupKey+",map("+valueToString(key)+")", valuePtr, nil, true)
+	default:
+		_, err = mapRowToDestinationPtr(scanContext, groupKey+",map("+valueToString(key)+")", valuePtr, nil)
+	}
+
+	if err != nil {
+		return
+	}
+
+	if valueType.Kind() == reflect.Ptr {
+		mapValue.SetMapIndex(key, valuePtr)
+	} else {
+		mapValue.SetMapIndex(key, valuePtr.Elem())
+	}
+
+	return true, nil
+}
@@ -0,0 +1,250 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"gotest.tools/assert"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type Base struct {
+	ID         int32 `sql:"primary_key"`
+	LastUpdate *time.Time
+}
+
+type audit struct {
+	CreatedBy string
+}
+
+type Film struct {
+	Base
+	audit
+
+	Title    string
+	internal string
+	Ignored  string `alias:"-"`
+}
+
+func TestMapEmbeddedStruct(t *testing.T) {
+	scanContext := newColumnsScanContext(
+		[]string{"film.id", "film.title", "film.created_by", "film.internal", "film.ignored"},
+		[]string{"INT4", "TEXT", "TEXT", "TEXT", "TEXT"},
+	)
+
+	var dest []Film
+	mapTestRows(t, scanContext, &dest,
+		[]interface{}{int64(1), "Academy Dinosaur", "admin", "internal", "ignored"},
+		[]interface{}{int64(1), "Academy Dinosaur", "admin", "internal", "ignored"},
+		[]interface{}{int64(2), "Ace Goldfinger", nil, "internal", "ignored"},
+	)
+
+	assert.Equal(t, len(dest), 2)
+	assert.DeepEqual(t, dest[0].Base, Base{ID: 1})
+	assert.Equal(t, dest[0].CreatedBy, "admin")
+	assert.Equal(t, dest[0].Title, "Academy Dinosaur")
+	assert.Equal(t, dest[0].internal, "")
+	assert.Equal(t, dest[0].Ignored, "")
+	assert.DeepEqual(t, dest[1].Base, Base{ID: 2})
+	assert.Equal(t, dest[1].CreatedBy, "")
+
+	assert.Equal(t, ExplainMapping(scanContext.columnNames, &dest).String(), `Base.ID int32 <- film.id
+Base.LastUpdate *time.Time <- (not mapped)
+audit.CreatedBy string <- film.created_by
+Title string <- film.title
+unmapped columns: film.internal, film.ignored`)
+}
+
+func TestMapEmbeddedStructOwnTypeName(t *testing.T) {
+	type Language struct {
+		LanguageID int32 `sql:"primary_key"`
+		Name       string
+	}
+
+	type FilmWithLanguage struct {
+		Film
+		*Language
+
+		Actors []*struct {
+			ActorID   int32  `sql:"primary_key" alias:"actor.actor_id"`
+			FirstName string `alias:"actor.first_name"`
+		}
+	}
+
+	scanContext := newColumnsScanContext(
+		[]string{"film.id", "film.title", "language.language_id", "language.name", "actor.actor_id", "actor.first_name"},
+		[]string{"INT4", "TEXT", "INT4", "TEXT", "INT4", "TEXT"},
+	)
+
+	var dest []*FilmWithLanguage
+	mapTestRows(t, scanContext, &dest,
+		[]interface{}{int64(1), "Academy Dinosaur", int64(1), "English", int64(1), "Penelope"},
+		[]interface{}{int64(1), "Academy Dinosaur", int64(1), "English", int64(10), "Christian"},
+		[]interface{}{int64(2), "Ace Goldfinger", nil, nil, nil, nil},
+	)
+
+	assert.Equal(t, len(dest), 2)
+	assert.Equal(t, dest[0].Film.Title, "Academy Dinosaur")
+	assert.Equal(t, dest[0].Language.Name, "English")
+	assert.Equal(t, len(dest[0].Actors), 2)
+	assert.Equal(t, dest[0].Actors[1].FirstName, "Christian")
+
+	assert.Equal(t, dest[1].Film.ID, int32(2))
+	assert.Assert(t, dest[1].Language == nil)
+	assert.Equal(t, len(dest[1].Actors), 0)
+}
+
+func TestMapIntoMapOfMaps(t *testing.T) {
+	scanContext := newColumnsScanContext([]string{"film.id", "film.title"}, []string{"INT4", "TEXT"})
+
+	var dest map[int32]map[string]interface{}
+	mapTestRows(t, scanContext, &dest,
+		[]interface{}{int64(1), "Academy Dinosaur"},
+		[]interface{}{int64(2), "Ace Goldfinger"},
+		[]interface{}{int64(2), "Ace Goldfinger 2"},
+	)
+
+	assert.DeepEqual(t, dest, map[int32]map[string]interface{}{
+		1: {"film.id": int32(1), "film.title": "Academy Dinosaur"},
+		2: {"film.id": int32(2), "film.title": "Ace Goldfinger 2"},
+	})
+}
+
+func TestMapRowToSliceElem(t *testing.T) {
+	scanContext := newColumnsScanContext([]string{"film.id", "film.last_update"}, []string{"INT4", "TIMESTAMP"})
+	scanTestRow(scanContext, int64(10), nil)
+
+	films := []Film{{Title: "Academy Dinosaur"}}
+	assert.NilError(t, mapRowToSliceElem(scanContext, reflect.ValueOf(films).Index(0)))
+	assert.Equal(t, films[0].ID, int32(10))
+	assert.Equal(t, films[0].Title, "Academy Dinosaur")
+
+	filmPtrs := []*Film{nil}
+	assert.NilError(t, mapRowToSliceElem(scanContext, reflect.ValueOf(filmPtrs).Index(0)))
+	assert.Equal(t, filmPtrs[0].ID, int32(10))
+
+	ids := []int64{0}
+	assert.NilError(t, mapRowToSliceElem(scanContext, reflect.ValueOf(ids).Index(0)))
+	assert.DeepEqual(t, ids, []int64{10})
+
+	assert.Error(t, mapRowToSliceElem(scanContext, reflect.ValueOf([][]int64{{}}).Index(0)),
+		"jet: destination slice elements have to be structs, pointers to structs or base types")
+}
+
+// idsDriver returns rows with film.id column, one row for each id in the query text
+type idsDriver struct{}
+
+func (idsDriver) Open(name string) (driver.Conn, error) { return idsConn{}, nil }
+
+type idsConn struct{}
+
+func (idsConn) Prepare(query string) (driver.Stmt, error) { return idsStmt{query}, nil }
+func (idsConn) Close() error                              { return nil }
+func (idsConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+type idsStmt struct {
+	ids string
+}
+
+func (s idsStmt) Close() error                                    { return nil }
+func (s idsStmt) NumInput() int                                   { return -1 }
+func (s idsStmt) Exec(args []driver.Value) (driver.Result, error) { return driver.RowsAffected(0), nil }
+func (s idsStmt) Query(args []driver.Value) (driver.Rows, error)  { return &idsRows{ids: s.ids}, nil }
+
+type idsRows struct {
+	ids string
+}
+
+func (r *idsRows) Columns() []string                     { return []string{"film.id"} }
+func (r *idsRows) Close() error                          { return nil }
+func (r *idsRows) ColumnTypeDatabaseTypeName(int) string { return "INT4" }
+
+func (r *idsRows) Next(dest []driver.Value) error {
+	if r.ids == "" {
+		return io.EOF
+	}
+
+	dest[0] = int64(r.ids[0] - '0')
+	r.ids = r.ids[1:]
+
+	return nil
+}
+
+func init() {
+	sql.Register("jet_qrm_ids", idsDriver{})
+}
+
+func TestQueryReturningInPlace(t *testing.T) {
+	db, err := sql.Open("jet_qrm_ids", "")
+	assert.NilError(t, err)
+	defer db.Close()
+
+	films := []Film{{Title: "Academy Dinosaur"}, {Title: "Ace Goldfinger"}}
+
+	rowsAffected, err := QueryReturning(context.Background(), db, "12", nil, &films)
+	assert.NilError(t, err)
+	assert.Equal(t, rowsAffected, int64(2))
+	assert.Equal(t, films[0].ID, int32(1))
+	assert.Equal(t, films[1].ID, int32(2))
+	assert.Equal(t, films[1].Title, "Ace Goldfinger")
+
+	film := &Film{Title: "Adaptation Holes"}
+	filmPtrs := []*Film{film, nil}
+
+	_, err = QueryReturning(context.Background(), db, "34", nil, &filmPtrs)
+	assert.NilError(t, err)
+	assert.Assert(t, filmPtrs[0] == film)
+	assert.Equal(t, film.ID, int32(3))
+	assert.Equal(t, filmPtrs[1].ID, int32(4))
+
+	singleFilm := Film{Title: "Affair Prejudice"}
+
+	rowsAffected, err = QueryReturning(context.Background(), db, "5", nil, &singleFilm)
+	assert.NilError(t, err)
+	assert.Equal(t, rowsAffected, int64(1))
+	assert.Equal(t, singleFilm.ID, int32(5))
+	assert.Equal(t, singleFilm.Title, "Affair Prejudice")
+
+	_, err = QueryReturning(context.Background(), db, "6", nil, &film)
+	assert.NilError(t, err)
+	assert.Equal(t, film.ID, int32(6))
+	assert.Equal(t, film.Title, "Adaptation Holes")
+
+	var nilFilm *Film
+
+	_, err = QueryReturning(context.Background(), db, "7", nil, &nilFilm)
+	assert.NilError(t, err)
+	assert.Equal(t, nilFilm.ID, int32(7))
+
+	_, err = QueryReturning(context.Background(), db, "", nil, &singleFilm)
+	assert.Equal(t, err, ErrNoRows)
+	assert.Equal(t, singleFilm.ID, int32(5))
+
+	_, err = QueryReturning(context.Background(), db, "89", nil, &singleFilm)
+	assert.Error(t, err, "jet: statement returned 2 rows, but destination is single struct")
+	assert.Equal(t, singleFilm.ID, int32(5))
+}
+
+func TestQueryReturningRowCountMismatch(t *testing.T) {
+	db, err := sql.Open("jet_qrm_ids", "")
+	assert.NilError(t, err)
+	defer db.Close()
+
+	// second row is skipped by the database, for instance because of ON CONFLICT DO NOTHING
+	films := []*Film{{Title: "Academy Dinosaur"}, {Title: "Ace Goldfinger"}, {Title: "Adaptation Holes"}}
+
+	rowsAffected, err := QueryReturning(context.Background(), db, "13", nil, &films)
+	assert.Error(t, err, "jet: statement returned 2 rows, but destination slice has 3 elements")
+	assert.Equal(t, rowsAffected, int64(2))
+
+	for _, film := range films {
+		assert.Equal(t, film.ID, int32(0))
+	}
+
+	_, err = QueryReturning(context.Background(), db, "1234", nil, &films)
+	assert.Error(t, err, "jet: statement returned 4 rows, but destination slice has 3 elements")
+	assert.Equal(t, films[0].ID, int32(0))
+}
@@ -0,0 +1,108 @@
+//go:build go1.18
+// +build go1.18
+
+package qrm
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// Queryable is statement that executes query and maps query result into destination, for instance jet SELECT statement
+type Queryable interface {
+	QueryContext(ctx context.Context, db DB, destination interface{}) error
+}
+
+// QueryAll executes statement and returns every row of query result mapped into slice of T. Empty query result
+// is returned as empty slice.
+func QueryAll[T any](ctx context.Context, db DB, statement Queryable) ([]T, error) {
+	var dest []T
+
+	if err := statement.QueryContext(ctx, db, &dest); err != nil {
+		return nil, err
+	}
+
+	if dest == nil {
+		dest = []T{}
+	}
+
+	return dest, nil
+}
+
+// QueryOne executes statement and returns query result mapped into T. Nested slices of T are grouped the same way as
+// with Query. If query result set is empty, method returns qrm.ErrNoRows.
+func QueryOne[T any](ctx context.Context, db DB, statement Queryable) (T, error) {
+	var dest T
+
+	if reflect.TypeOf(&dest).Elem().Kind() == reflect.Struct {
+		err := statement.QueryContext(ctx, db, &dest)
+		return dest, err
+	}
+
+	var dests []T
+
+	if err := statement.QueryContext(ctx, db, &dests); err != nil {
+		return dest, err
+	}
+
+	if len(dests) == 0 {
+		return dest, ErrNoRows
+	}
+
+	return dests[0], nil
+}
+
+// QueryScalar executes statement that returns single column and single row, and returns column value converted to T.
+// Column value is converted with converter registered for T, if there is one.
+// NULL is returned as zero value of T. If query result set is empty, method returns qrm.ErrNoRows.
+func QueryScalar[T any](ctx context.Context, db DB, statement Queryable) (T, error) {
+	var rows []Row
+
+	if err := statement.QueryContext(ctx, db, &rows); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return scalarValue[T](rows)
+}
+
+func scalarValue[T any](rows []Row) (T, error) {
+	var value T
+
+	if len(rows) == 0 {
+		return value, ErrNoRows
+	}
+
+	if len(rows) > 1 {
+		return value, errors.New("jet: scalar query returned more than one row")
+	}
+
+	if len(rows[0].values) != 1 {
+		return value, errors.New("jet: scalar query has to return exactly one column")
+	}
+
+	if rows[0].values[0] == nil {
+		return value, nil
+	}
+
+	columnValue := rows[0].values[0]
+
+	if converter := goTypeConverter(reflect.TypeOf(&value).Elem()); converter != nil {
+		converted, err := converter(rows[0].rawValue(0))
+
+		if err != nil {
+			return value, errors.New("jet: " + err.Error())
+		}
+
+		if converted == nil {
+			return value, nil
+		}
+
+		columnValue = converted
+	}
+
+	err := setReflectValue(reflect.ValueOf(columnValue), reflect.ValueOf(&value).Elem())
+
+	return value, err
+}
@@ -0,0 +1,88 @@
+//go:build go1.18
+// +build go1.18
+
+package qrm
+
+import (
+	"context"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestScalarValue(t *testing.T) {
+	scanContext := newColumnsScanContext([]string{"count"}, []string{"INT8"})
+
+	var rows []Row
+	mapTestRows(t, scanContext, &rows, []interface{}{int64(11)})
+
+	count, err := scalarValue[int](rows)
+	assert.NilError(t, err)
+	assert.Equal(t, count, 11)
+
+	countPtr, err := scalarValue[*int64](rows)
+	assert.NilError(t, err)
+	assert.Equal(t, *countPtr, int64(11))
+
+	_, err = scalarValue[string](rows)
+	assert.Error(t, err, "jet: can't set int64 to string")
+
+	_, err = scalarValue[int]([]Row{})
+	assert.Equal(t, err, ErrNoRows)
+
+	mapTestRows(t, scanContext, &rows, []interface{}{nil})
+
+	_, err = scalarValue[int](rows)
+	assert.Error(t, err, "jet: scalar query returned more than one row")
+
+	nullCount, err := scalarValue[*int](rows[1:])
+	assert.NilError(t, err)
+	assert.Assert(t, nullCount == nil)
+}
+
+func TestScalarValueColumns(t *testing.T) {
+	scanContext := newColumnsScanContext([]string{"film.film_id", "film.title"}, []string{"INT4", "TEXT"})
+
+	var rows []Row
+	mapTestRows(t, scanContext, &rows, []interface{}{int64(1), "Academy Dinosaur"})
+
+	_, err := scalarValue[int](rows)
+	assert.Error(t, err, "jet: scalar query has to return exactly one column")
+}
+
+func TestScalarValueConverter(t *testing.T) {
+	defer registerTestConverters()()
+
+	scanContext := newColumnsScanContext([]string{"balance"}, []string{"NUMERIC"})
+
+	var rows []Row
+	mapTestRows(t, scanContext, &rows, []interface{}{"12.34"})
+
+	balance, err := scalarValue[money](rows)
+	assert.NilError(t, err)
+	assert.Equal(t, balance, money{Cents: 1234})
+
+	balancePtr, err := scalarValue[*money](rows)
+	assert.NilError(t, err)
+	assert.Equal(t, *balancePtr, money{Cents: 1234})
+
+	scanContext = newColumnsScanContext([]string{"balance"}, []string{"INT8"})
+	rows = nil
+	mapTestRows(t, scanContext, &rows, []interface{}{int64(12)})
+
+	_, err = scalarValue[money](rows)
+	assert.Error(t, err, "jet: money has to be a string")
+}
+
+type emptyQueryable struct{}
+
+func (emptyQueryable) QueryContext(ctx context.Context, db DB, destination interface{}) error {
+	return nil
+}
+
+func TestQueryAllEmpty(t *testing.T) {
+	dest, err := QueryAll[int](context.Background(), nil, emptyQueryable{})
+
+	assert.NilError(t, err)
+	assert.Assert(t, dest != nil)
+	assert.Equal(t, len(dest), 0)
+}
@@ -0,0 +1,188 @@
+package qrm
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Row is query result row, with column values accessible by column name. Destination can be pointer to Row,
+// for single row result, or pointer to slice of Rows.
+type Row struct {
+	columns   []string
+	values    []interface{}
+	rawValues []interface{} // column values as returned by database driver, set only if converters are registered
+}
+
+var rowType = reflect.TypeOf(Row{})
+var mapRowType = reflect.TypeOf(map[string]interface{}{})
+
+func newRow(scanContext *scanContext) Row {
+	values := make([]interface{}, len(scanContext.row))
+
+	var rawValues []interface{}
+
+	for i := range values {
+		values[i] = scanContext.rowElem(i)
+
+		if scanValue, ok := scanContext.row[i].(*convertedScanValue); ok {
+			if rawValues == nil {
+				rawValues = make([]interface{}, len(scanContext.row))
+			}
+
+			rawValues[i] = copyRawValue(scanValue.raw)
+		}
+	}
+
+	return Row{
+		columns:   scanContext.columnNames,
+		values:    values,
+		rawValues: rawValues,
+	}
+}
+
+// copyRawValue copies byte slice owned by database driver, because it is valid only until the next row is scanned
+func copyRawValue(value driver.Value) driver.Value {
+	if bytes, ok := value.([]byte); ok {
+		return append([]byte{}, bytes...)
+	}
+
+	return value
+}
+
+// rawValue returns column value at index as returned by database driver, so it can be passed to converters
+func (r Row) rawValue(index int) interface{} {
+	if r.rawValues != nil {
+		return r.rawValues[index]
+	}
+
+	return r.values[index]
+}
+
+func newMapRow(scanContext *scanContext) map[string]interface{} {
+	mapRow := make(map[string]interface{}, len(scanContext.row))
+
+	for i, column := range scanContext.columnNames {
+		mapRow[column] = scanContext.rowElem(i)
+	}
+
+	return mapRow
+}
+
+// isDynamicRowType returns true if destination type is Row or map[string]interface{}
+func isDynamicRowType(destType reflect.Type) bool {
+	return destType == rowType || destType == mapRowType
+}
+
+func newDynamicRowValue(scanContext *scanContext, destType reflect.Type) reflect.Value {
+	if destType == rowType {
+		return reflect.ValueOf(newRow(scanContext))
+	}
+
+	return reflect.ValueOf(newMapRow(scanContext))
+}
+
+// Columns returns row column names
+func (r Row) Columns() []string {
+	return r.columns
+}
+
+// Get returns column value, or nil if column value is NULL or column does not exist
+func (r Row) Get(column string) interface{} {
+	index := r.index(column)
+
+	if index < 0 {
+		return nil
+	}
+
+	return r.values[index]
+}
+
+// IsNull returns true if column value is NULL or column does not exist
+func (r Row) IsNull(column string) bool {
+	return r.Get(column) == nil
+}
+
+// GetString returns column value as string. NULL is returned as empty string.
+func (r Row) GetString(column string) string {
+	switch value := r.Get(column).(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case []byte:
+		return string(value)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// GetInt64 returns column value as int64. NULL is returned as 0.
+func (r Row) GetInt64(column string) int64 {
+	var value int64
+	r.scan(column, &value)
+	return value
+}
+
+// GetFloat64 returns column value as float64. NULL is returned as 0.
+func (r Row) GetFloat64(column string) float64 {
+	var value float64
+	r.scan(column, &value)
+	return value
+}
+
+// GetBool returns column value as bool. NULL is returned as false.
+func (r Row) GetBool(column string) bool {
+	var value bool
+	r.scan(column, &value)
+	return value
+}
+
+// GetTime returns column value as time.Time. NULL is returned as zero time.
+func (r Row) GetTime(column string) time.Time {
+	var value time.Time
+	r.scan(column, &value)
+	return value
+}
+
+// GetBytes returns column value as byte slice. NULL is returned as nil.
+func (r Row) GetBytes(column string) []byte {
+	var value []byte
+	r.scan(column, &value)
+	return value
+}
+
+// scan stores column value into destination. Panics if column value can not be converted to destination type.
+func (r Row) scan(column string, destination interface{}) {
+	value := r.Get(column)
+
+	if value == nil {
+		return
+	}
+
+	err := setReflectValue(reflect.ValueOf(value), reflect.ValueOf(destination).Elem())
+
+	if err != nil {
+		panic(err.Error() + ", column '" + column + "'")
+	}
+}
+
+// index returns index of column with the same name, or with the same name when compared case insensitive
+func (r Row) index(column string) int {
+	for i, name := range r.columns {
+		if name == column {
+			return i
+		}
+	}
+
+	commonIdentifier := toCommonIdentifier(column)
+
+	for i, name := range r.columns {
+		if toCommonIdentifier(name) == commonIdentifier {
+			return i
+		}
+	}
+
+	return -1
+}
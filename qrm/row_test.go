@@ -0,0 +1,123 @@
+package qrm
+
+import (
+	"gotest.tools/assert"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func mapTestRows(t *testing.T, scanContext *scanContext, destPtr interface{}, rows ...[]interface{}) {
+	for _, row := range rows {
+		scanTestRow(scanContext, row...)
+
+		_, err := mapRowToDestinationPtr(scanContext, "", reflect.ValueOf(destPtr), nil)
+		assert.NilError(t, err)
+	}
+}
+
+func TestRow(t *testing.T) {
+	scanContext := newColumnsScanContext(
+		[]string{"film.film_id", "film.title", "film.rental_rate", "film.last_update", "film.special"},
+		[]string{"INT4", "TEXT", "NUMERIC", "TIMESTAMP", "BOOL"},
+	)
+
+	lastUpdate := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var dest []Row
+	mapTestRows(t, scanContext, &dest,
+		[]interface{}{int64(1), "Academy Dinosaur", 0.99, lastUpdate, true},
+		[]interface{}{int64(2), nil, "4.99", nil, int64(0)},
+	)
+
+	assert.Equal(t, len(dest), 2)
+	assert.DeepEqual(t, dest[0].Columns(), []string{"film.film_id", "film.title", "film.rental_rate", "film.last_update", "film.special"})
+
+	assert.Equal(t, dest[0].Get("film.film_id"), int32(1))
+	assert.Equal(t, dest[0].GetInt64("film.film_id"), int64(1))
+	assert.Equal(t, dest[0].GetString("film.film_id"), "1")
+	assert.Equal(t, dest[0].GetString("Film.Title"), "Academy Dinosaur")
+	assert.Equal(t, dest[0].GetFloat64("film.rental_rate"), 0.99)
+	assert.Equal(t, dest[0].GetTime("film.last_update"), lastUpdate)
+	assert.Equal(t, dest[0].GetBool("film.special"), true)
+
+	assert.Assert(t, dest[1].IsNull("film.title"))
+	assert.Assert(t, dest[1].IsNull("film.unknown"))
+	assert.Equal(t, dest[1].GetString("film.title"), "")
+	assert.Equal(t, dest[1].GetFloat64("film.rental_rate"), 4.99)
+	assert.Equal(t, dest[1].GetTime("film.last_update"), time.Time{})
+	assert.Equal(t, dest[1].GetBool("film.special"), false)
+
+	defer func() {
+		assert.Equal(t, recover(), "jet: can't set string to int64, column 'film.title'")
+	}()
+
+	dest[0].GetInt64("film.title")
+}
+
+func TestMapDestinations(t *testing.T) {
+	scanContext := newColumnsScanContext([]string{"film.film_id", "film.title"}, []string{"INT4", "TEXT"})
+
+	var rows []map[string]interface{}
+	mapTestRows(t, scanContext, &rows, []interface{}{int64(1), "Academy Dinosaur"}, []interface{}{int64(2), nil})
+
+	assert.DeepEqual(t, rows, []map[string]interface{}{
+		{"film.film_id": int32(1), "film.title": "Academy Dinosaur"},
+		{"film.film_id": int32(2), "film.title": nil},
+	})
+
+	var titles map[int64]string
+	mapTestRows(t, scanContext, &titles, []interface{}{int64(1), "Academy Dinosaur"}, []interface{}{int64(2), "Ace Goldfinger"})
+
+	assert.DeepEqual(t, titles, map[int64]string{1: "Academy Dinosaur", 2: "Ace Goldfinger"})
+
+	type Film struct {
+		FilmID int32 `sql:"primary_key"`
+		Title  string
+	}
+
+	var films map[int32]*Film
+	mapTestRows(t, scanContext, &films, []interface{}{int64(1), "Academy Dinosaur"})
+
+	assert.DeepEqual(t, films, map[int32]*Film{1: {FilmID: 1, Title: "Academy Dinosaur"}})
+}
+
+func TestMapDestinationGrouping(t *testing.T) {
+	scanContext := newColumnsScanContext(
+		[]string{"language.name", "film.film_id", "film.title"},
+		[]string{"TEXT", "INT4", "TEXT"},
+	)
+
+	type Film struct {
+		FilmID int32 `sql:"primary_key"`
+		Title  string
+	}
+
+	var filmsByLanguage map[string][]Film
+	mapTestRows(t, scanContext, &filmsByLanguage,
+		[]interface{}{"English", int64(1), "Academy Dinosaur"},
+		[]interface{}{"English", int64(2), "Ace Goldfinger"},
+		[]interface{}{"Italian", int64(3), "Adaptation Holes"},
+		[]interface{}{"English", int64(1), "Academy Dinosaur"},
+	)
+
+	assert.DeepEqual(t, filmsByLanguage, map[string][]Film{
+		"English": {{FilmID: 1, Title: "Academy Dinosaur"}, {FilmID: 2, Title: "Ace Goldfinger"}},
+		"Italian": {{FilmID: 3, Title: "Adaptation Holes"}},
+	})
+
+	assert.Equal(t, ExplainMapping(scanContext.columnNames, &filmsByLanguage).String(), `[key] string <- language.name
+FilmID int32 <- film.film_id
+Title string <- film.title`)
+
+	var rowsByLanguage map[string]Row
+	assert.Equal(t, ExplainMapping(scanContext.columnNames, &rowsByLanguage).String(), `[key] string <- language.name
+qrm.Row qrm.Row <- *`)
+}
+
+func TestTryAssignIntegerToString(t *testing.T) {
+	var str string
+
+	assert.Assert(t, !tryAssign(reflect.ValueOf(int64(65)), reflect.ValueOf(&str).Elem()))
+	assert.Equal(t, str, "")
+}
@@ -1,6 +1,7 @@
 package qrm
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
@@ -12,13 +13,15 @@ import (
 type scanContext struct {
 	rowNum                   int64
 	row                      []interface{}
+	columnNames              []string
+	strict                   bool
+	mapping                  *Mapping // set only in strict mapping mode
 	uniqueDestObjectsMap     map[string]int
 	commonIdentToColumnIndex map[string]int
-	groupKeyInfoCache        map[string]groupKeyInfo
-	typeInfoMap              map[string]typeInfo
+	plan                     *mappingPlan
 }
 
-func newScanContext(rows *sql.Rows) (*scanContext, error) {
+func newScanContext(rows *sql.Rows, destinationType reflect.Type) (*scanContext, error) {
 	aliases, err := rows.Columns()
 
 	if err != nil {
@@ -31,6 +34,34 @@ func newScanContext(rows *sql.Rows) (*scanContext, error) {
 		return nil, err
 	}
 
+	var databaseTypeNames []string
+
+	for _, columnType := range columnTypes {
+		databaseTypeNames = append(databaseTypeNames, columnType.DatabaseTypeName())
+	}
+
+	plan := cachedMappingPlan(destinationType, aliases, databaseTypeNames)
+
+	return newPlanScanContext(plan, aliases, databaseTypeNames), nil
+}
+
+func newColumnsScanContext(aliases, databaseTypeNames []string) *scanContext {
+	return newPlanScanContext(newMappingPlan(aliases), aliases, databaseTypeNames)
+}
+
+func newPlanScanContext(plan *mappingPlan, aliases, databaseTypeNames []string) *scanContext {
+	return &scanContext{
+		row:                  createScanValue(databaseTypeNames),
+		columnNames:          aliases,
+		strict:               isStrictMapping(context.Background()),
+		uniqueDestObjectsMap: make(map[string]int),
+
+		commonIdentToColumnIndex: plan.commonIdentToColumnIndex,
+		plan:                     plan,
+	}
+}
+
+func commonIdentToColumnIndexMap(aliases []string) map[string]int {
 	commonIdentToColumnIndex := map[string]int{}
 
 	for i, alias := range aliases {
@@ -44,15 +75,7 @@ func newScanContext(rows *sql.Rows) (*scanContext, error) {
 		commonIdentToColumnIndex[commonIdentifier] = i
 	}
 
-	return &scanContext{
-		row:                  createScanValue(columnTypes),
-		uniqueDestObjectsMap: make(map[string]int),
-
-		groupKeyInfoCache:        make(map[string]groupKeyInfo),
-		commonIdentToColumnIndex: commonIdentToColumnIndex,
-
-		typeInfoMap: make(map[string]typeInfo),
-	}, nil
+	return commonIdentToColumnIndex
 }
 
 type typeInfo struct {
@@ -63,52 +86,89 @@ type fieldMapping struct {
 	complexType       bool // slice or struct
 	columnIndex       int
 	implementsScanner bool
+	converter         ConverterFunc
+	ignored           bool     // unexported or `alias:"-"` field
+	embedded          bool     // anonymous embedded struct, mapped as if its fields are fields of parent struct
+	typeNames         []string // type names of embedded struct fields
 }
 
 func (s *scanContext) getTypeInfo(structType reflect.Type, parentField *reflect.StructField) typeInfo {
+	return s.getTypeNamesInfo(structType, []string{getTypeName(structType, parentField)})
+}
 
-	typeMapKey := structType.String()
+// getTypeNamesInfo returns field mappings of struct type, whose fields are mapped to columns of the first of
+// typeNames with column for the field. Embedded structs are mapped with parent type names, and their own type name.
+func (s *scanContext) getTypeNamesInfo(structType reflect.Type, typeNames []string) typeInfo {
 
-	if parentField != nil {
-		typeMapKey += string(parentField.Tag)
-	}
+	typeMapKey := structType.String() + "(" + strings.Join(typeNames, ",") + ")"
 
-	if typeInfo, ok := s.typeInfoMap[typeMapKey]; ok {
+	if typeInfo, ok := s.plan.typeInfo(typeMapKey); ok {
 		return typeInfo
 	}
 
-	typeName := getTypeName(structType, parentField)
-
 	newTypeInfo := typeInfo{}
 
 	for i := 0; i < structType.NumField(); i++ {
 		field := structType.Field(i)
 
-		newTypeName, fieldName := getTypeAndFieldName(typeName, field)
-		columnIndex := s.typeToColumnIndex(newTypeName, fieldName)
+		if isIgnoredField(field) {
+			newTypeInfo.fieldMappings = append(newTypeInfo.fieldMappings, fieldMapping{ignored: true, columnIndex: -1})
+			continue
+		}
+
+		if isEmbeddedStruct(field) {
+			newTypeInfo.fieldMappings = append(newTypeInfo.fieldMappings, fieldMapping{
+				embedded:    true,
+				columnIndex: -1,
+				typeNames:   embeddedTypeNames(typeNames, field),
+			})
+			continue
+		}
+
+		columnIndex := s.fieldColumnIndex(typeNames, field)
 
 		fieldMap := fieldMapping{
 			columnIndex: columnIndex,
 		}
 
-		if implementsScannerType(field.Type) {
+		if converter := goTypeConverter(field.Type); converter != nil {
+			fieldMap.converter = converter
+		} else if implementsScannerType(field.Type) {
 			fieldMap.implementsScanner = true
-		} else if !isSimpleModelType(field.Type) {
+		} else if !isSimpleModelType(field.Type) && !s.hasColumnConverter(columnIndex) {
 			fieldMap.complexType = true
 		}
 
 		newTypeInfo.fieldMappings = append(newTypeInfo.fieldMappings, fieldMap)
 	}
 
-	s.typeInfoMap[typeMapKey] = newTypeInfo
+	s.plan.setTypeInfo(typeMapKey, newTypeInfo)
 
 	return newTypeInfo
 }
 
+// fieldColumnIndex returns index of the column field is mapped to, looking for the column of each of type names
+func (s *scanContext) fieldColumnIndex(typeNames []string, field reflect.StructField) int {
+	for _, typeName := range typeNames {
+		newTypeName, fieldName := getTypeAndFieldName(typeName, field)
+
+		if index := s.typeToColumnIndex(newTypeName, fieldName); index >= 0 {
+			return index
+		}
+	}
+
+	return -1
+}
+
 type groupKeyInfo struct {
 	typeName string
 	indexes  []int
 	subTypes []groupKeyInfo
+	keyFunc  GroupKeyFunc
+}
+
+func (g groupKeyInfo) isEmpty() bool {
+	return len(g.indexes) == 0 && len(g.subTypes) == 0 && g.keyFunc == nil
 }
 
 func (s *scanContext) getGroupKey(structType reflect.Type, structField *reflect.StructField) string {
@@ -119,26 +179,31 @@ func (s *scanContext) getGroupKey(structType reflect.Type, structField *reflect.
 		mapKey += structField.Type.String()
 	}
 
-	if groupKeyInfo, ok := s.groupKeyInfoCache[mapKey]; ok {
+	if groupKeyInfo, ok := s.plan.groupKeyInfo(mapKey); ok {
 		return s.constructGroupKey(groupKeyInfo)
 	}
 
 	groupKeyInfo := s.getGroupKeyInfo(structType, structField)
 
-	s.groupKeyInfoCache[mapKey] = groupKeyInfo
+	s.plan.setGroupKeyInfo(mapKey, groupKeyInfo)
 
 	return s.constructGroupKey(groupKeyInfo)
 }
 
 func (s *scanContext) constructGroupKey(groupKeyInfo groupKeyInfo) string {
-	if len(groupKeyInfo.indexes) == 0 && len(groupKeyInfo.subTypes) == 0 {
+	if groupKeyInfo.isEmpty() {
 		return fmt.Sprintf("|ROW:%d|", s.rowNum)
 	}
 
 	groupKeys := []string{}
 
+	if groupKeyInfo.keyFunc != nil {
+		key := groupKeyInfo.keyFunc(newRow(s))
+		groupKeys = append(groupKeys, valueToString(reflect.ValueOf(key)))
+	}
+
 	for _, index := range groupKeyInfo.indexes {
-		cellValue := s.rowElem(index)
+		cellValue := s.groupKeyElem(index)
 		subKey := valueToString(reflect.ValueOf(cellValue))
 
 		groupKeys = append(groupKeys, subKey)
@@ -153,15 +218,39 @@ func (s *scanContext) constructGroupKey(groupKeyInfo groupKeyInfo) string {
 }
 
 func (s *scanContext) getGroupKeyInfo(structType reflect.Type, parentField *reflect.StructField) groupKeyInfo {
-	ret := groupKeyInfo{typeName: structType.Name()}
+	typeNames := []string{getTypeName(structType, parentField)}
 
-	typeName := getTypeName(structType, parentField)
-	primaryKeyOverwrites := parentFieldPrimaryKeyOverwrite(parentField)
+	return s.getTypeNamesGroupKeyInfo(structType, typeNames, parentFieldPrimaryKeyOverwrite(parentField))
+}
+
+func (s *scanContext) getTypeNamesGroupKeyInfo(structType reflect.Type, typeNames []string, primaryKeyOverwrites []string) groupKeyInfo {
+	if registration, ok := registeredGroupKey(structType); ok {
+		return s.registeredGroupKeyInfo(structType, typeNames, registration)
+	}
+
+	ret := groupKeyInfo{typeName: structType.Name()}
 
 	for i := 0; i < structType.NumField(); i++ {
 		field := structType.Field(i)
 		fieldType := indirectType(field.Type)
 
+		if isIgnoredField(field) {
+			continue
+		}
+
+		if isEmbeddedStruct(field) {
+			embedded := s.getTypeNamesGroupKeyInfo(fieldType, embeddedTypeNames(typeNames, field), primaryKeyOverwrites)
+
+			if embedded.keyFunc != nil {
+				ret.subTypes = append(ret.subTypes, embedded)
+				continue
+			}
+
+			ret.indexes = append(ret.indexes, embedded.indexes...)
+			ret.subTypes = append(ret.subTypes, embedded.subTypes...)
+			continue
+		}
+
 		if !isSimpleModelType(fieldType) {
 			if fieldType.Kind() != reflect.Struct {
 				continue
@@ -169,14 +258,12 @@ func (s *scanContext) getGroupKeyInfo(structType reflect.Type, parentField *refl
 
 			subType := s.getGroupKeyInfo(fieldType, &field)
 
-			if len(subType.indexes) != 0 || len(subType.subTypes) != 0 {
+			if !subType.isEmpty() {
 				ret.subTypes = append(ret.subTypes, subType)
 			}
 		} else {
 			if isPrimaryKey(field, primaryKeyOverwrites) {
-				newTypeName, fieldName := getTypeAndFieldName(typeName, field)
-
-				index := s.typeToColumnIndex(newTypeName, fieldName)
+				index := s.fieldColumnIndex(typeNames, field)
 
 				if index < 0 {
 					continue
@@ -209,31 +296,81 @@ func (s *scanContext) typeToColumnIndex(typeName, fieldName string) int {
 }
 
 func (s *scanContext) rowElem(index int) interface{} {
+	value, err := s.rowElemErr(index)
+
+	utils.PanicOnError(err)
+
+	return value
+}
 
+// rowElemErr returns column value at index, or error if column type converter fails to convert the value
+func (s *scanContext) rowElemErr(index int) (interface{}, error) {
 	valuer, ok := s.row[index].(driver.Valuer)
 
 	utils.MustBeTrue(ok, "jet: internal error, scan value doesn't implement driver.Valuer")
 
-	value, err := valuer.Value()
+	return valuer.Value()
+}
 
-	utils.PanicOnError(err)
+// groupKeyElem returns column value used for grouping key. Column converted with column type converter is grouped
+// by value returned by database driver, so converter error is reported only when value is stored into destination.
+func (s *scanContext) groupKeyElem(index int) interface{} {
+	if s.hasColumnConverter(index) {
+		return s.rowRawElem(index)
+	}
 
-	return value
+	return s.rowElem(index)
+}
+
+// hasColumnConverter returns true if column at index is converted with column type converter
+func (s *scanContext) hasColumnConverter(index int) bool {
+	if index < 0 || index >= len(s.row) {
+		return false
+	}
+
+	scanValue, ok := s.row[index].(*convertedScanValue)
+
+	return ok && scanValue.converter != nil
+}
+
+// rowRawElem returns column value as returned by database driver
+func (s *scanContext) rowRawElem(index int) interface{} {
+	if scanValue, ok := s.row[index].(*convertedScanValue); ok {
+		return scanValue.raw
+	}
+
+	return s.rowElem(index)
 }
 
-func (s *scanContext) rowElemValuePtr(index int) reflect.Value {
-	rowElem := s.rowElem(index)
+// convertRowElem converts column value at index with converter
+func (s *scanContext) convertRowElem(index int, converter ConverterFunc) (interface{}, error) {
+	rawValue := s.rowRawElem(index)
+
+	if rawValue == nil {
+		return nil, nil
+	}
+
+	return converter(rawValue)
+}
+
+func (s *scanContext) rowElemValuePtr(index int) (reflect.Value, error) {
+	rowElem, err := s.rowElemErr(index)
+
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
 	rowElemValue := reflect.ValueOf(rowElem)
 
 	if rowElemValue.Kind() == reflect.Ptr {
-		return rowElemValue
+		return rowElemValue, nil
 	}
 
 	if rowElemValue.CanAddr() {
-		return rowElemValue.Addr()
+		return rowElemValue.Addr(), nil
 	}
 
 	newElem := reflect.New(rowElemValue.Type())
 	newElem.Elem().Set(rowElemValue)
-	return newElem
+	return newElem, nil
 }
@@ -2,11 +2,13 @@ package qrm
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/go-jet/jet/internal/utils"
 	"github.com/go-jet/jet/qrm/internal"
 	"github.com/google/uuid"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -97,6 +99,36 @@ func getTypeName(structType reflect.Type, parentField *reflect.StructField) stri
 	return toCommonIdentifier(aliasParts[0])
 }
 
+// isIgnoredField returns true for fields with `alias:"-"` tag, and for unexported fields. Exported fields of
+// unexported embedded struct are still mapped, the same as encoding/json does, unless embedded struct is a pointer.
+func isIgnoredField(field reflect.StructField) bool {
+	if field.Tag.Get("alias") == "-" {
+		return true
+	}
+
+	if field.PkgPath == "" {
+		return false
+	}
+
+	return !field.Anonymous || field.Type.Kind() != reflect.Struct
+}
+
+// isEmbeddedStruct returns true for anonymous embedded struct field without alias tag
+func isEmbeddedStruct(field reflect.StructField) bool {
+	fieldType := indirectType(field.Type)
+
+	return field.Anonymous && field.Tag.Get("alias") == "" && fieldType.Kind() == reflect.Struct &&
+		!isSimpleModelType(fieldType) && !implementsScannerType(fieldType)
+}
+
+// embeddedTypeNames returns type names of embedded struct fields. Fields are mapped to columns of parent type first,
+// and then to columns of embedded type.
+func embeddedTypeNames(parentTypeNames []string, field reflect.StructField) []string {
+	typeNames := append([]string{}, parentTypeNames...)
+
+	return append(typeNames, indirectType(field.Type).Name())
+}
+
 func getTypeAndFieldName(structType string, field reflect.StructField) (string, string) {
 	aliasTag := field.Tag.Get("alias")
 
@@ -169,7 +201,7 @@ func isSimpleModelType(objType reflect.Type) bool {
 		return true
 	}
 
-	return objType == timeType || objType == uuidType || objType == byteArrayType
+	return objType == timeType || objType == uuidType || objType == byteArrayType || goTypeConverter(objType) != nil
 }
 
 func isIntegerType(value reflect.Type) bool {
@@ -183,7 +215,7 @@ func isIntegerType(value reflect.Type) bool {
 }
 
 func tryAssign(source, destination reflect.Value) bool {
-	if source.Type().ConvertibleTo(destination.Type()) {
+	if source.Type().ConvertibleTo(destination.Type()) && !isRuneConversion(source.Type(), destination.Type()) {
 		source = source.Convert(destination.Type())
 	}
 
@@ -202,13 +234,75 @@ func tryAssign(source, destination reflect.Value) bool {
 		return true
 	}
 
+	if source.Kind() == reflect.String {
+		return trySetFromString(source.String(), destination)
+	}
+
+	return false
+}
+
+// isRuneConversion returns true for integer to string conversion, which converts integer into rune, not into number text
+func isRuneConversion(source, destination reflect.Type) bool {
+	if destination.Kind() != reflect.String {
+		return false
+	}
+
+	switch source.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+
 	return false
 }
 
-func setReflectValue(source, destination reflect.Value) {
+// trySetFromString parses string into numeric, bool or time.Time destination. Drivers, like SQLite,
+// do not report type of expression columns, and those columns are scanned as strings.
+func trySetFromString(str string, destination reflect.Value) bool {
+	switch destination.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		intValue, err := strconv.ParseInt(str, 10, 64)
+		if err != nil || destination.OverflowInt(intValue) {
+			return false
+		}
+		destination.SetInt(intValue)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		uintValue, err := strconv.ParseUint(str, 10, 64)
+		if err != nil || destination.OverflowUint(uintValue) {
+			return false
+		}
+		destination.SetUint(uintValue)
+	case reflect.Float32, reflect.Float64:
+		floatValue, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return false
+		}
+		destination.SetFloat(floatValue)
+	case reflect.Bool:
+		boolValue, err := strconv.ParseBool(str)
+		if err != nil {
+			return false
+		}
+		destination.SetBool(boolValue)
+	default:
+		if destination.Type() != timeType {
+			return false
+		}
+
+		var nullTime internal.NullTime
+		if err := nullTime.Scan(str); err != nil || !nullTime.Valid {
+			return false
+		}
+		destination.Set(reflect.ValueOf(nullTime.Time))
+	}
+
+	return true
+}
+
+func setReflectValue(source, destination reflect.Value) error {
 
 	if tryAssign(source, destination) {
-		return
+		return nil
 	}
 
 	if destination.Kind() == reflect.Ptr {
@@ -219,10 +313,10 @@ func setReflectValue(source, destination reflect.Value) {
 				}
 
 				if tryAssign(source.Elem(), destination.Elem()) {
-					return
+					return nil
 				}
 			} else {
-				return
+				return nil
 			}
 		} else {
 			if source.CanAddr() {
@@ -235,37 +329,48 @@ func setReflectValue(source, destination reflect.Value) {
 			}
 
 			if tryAssign(source, destination) {
-				return
+				return nil
 			}
 
+			initializeValueIfNilPtr(destination)
+
 			if tryAssign(source.Elem(), destination.Elem()) {
-				return
+				return nil
 			}
 		}
 	} else {
 		if source.Kind() == reflect.Ptr {
 			if source.IsNil() {
-				return
+				return nil
 			}
 			source = source.Elem()
 		}
 
 		if tryAssign(source, destination) {
-			return
+			return nil
 		}
 	}
 
-	panic("jet: can't set " + source.Type().String() + " to " + destination.Type().String())
+	return errors.New("jet: can't set " + source.Type().String() + " to " + destination.Type().String())
 }
 
-func createScanValue(columnTypes []*sql.ColumnType) []interface{} {
-	values := make([]interface{}, len(columnTypes))
+func createScanValue(databaseTypeNames []string) []interface{} {
+	values := make([]interface{}, len(databaseTypeNames))
+	withConverters := hasConverters()
 
-	for i, sqlColumnType := range columnTypes {
-		columnType := newScanType(sqlColumnType)
+	for i, databaseTypeName := range databaseTypeNames {
+		columnType := newScanType(databaseTypeName)
 
 		columnValue := reflect.New(columnType)
 
+		if withConverters {
+			values[i] = &convertedScanValue{
+				scanned:   columnValue.Interface().(sql.Scanner),
+				converter: columnTypeConverter(databaseTypeName),
+			}
+			continue
+		}
+
 		values[i] = columnValue.Interface()
 	}
 
@@ -293,24 +398,29 @@ var nullStringType = reflect.TypeOf(sql.NullString{})
 var nullTimeType = reflect.TypeOf(internal.NullTime{})
 var nullByteArrayType = reflect.TypeOf(internal.NullByteArray{})
 
-func newScanType(columnType *sql.ColumnType) reflect.Type {
+func newScanType(typeName string) reflect.Type {
+
+	// SQLite reports declared column type with modifiers, for instance NUMERIC(10,2)
+	if i := strings.Index(typeName, "("); i > 0 {
+		typeName = typeName[:i]
+	}
 
-	switch columnType.DatabaseTypeName() {
+	switch typeName {
 	case "TINYINT":
 		return nullInt8Type
 	case "INT2", "SMALLINT", "YEAR":
 		return nullInt16Type
 	case "INT4", "MEDIUMINT", "INT":
 		return nullInt32Type
-	case "INT8", "BIGINT":
+	case "INT8", "BIGINT", "INTEGER":
 		return nullInt64Type
 	case "CHAR", "VARCHAR", "TEXT", "", "_TEXT", "TSVECTOR", "BPCHAR", "UUID", "JSON", "JSONB", "INTERVAL", "POINT", "BIT", "VARBIT", "XML":
 		return nullStringType
 	case "FLOAT4":
 		return nullFloat32Type
-	case "FLOAT8", "NUMERIC", "DECIMAL", "FLOAT", "DOUBLE":
+	case "FLOAT8", "NUMERIC", "DECIMAL", "FLOAT", "DOUBLE", "REAL":
 		return nullFloat64Type
-	case "BOOL":
+	case "BOOL", "BOOLEAN":
 		return nullBoolType
 	case "BYTEA", "BINARY", "VARBINARY", "BLOB":
 		return nullByteArrayType
@@ -328,7 +438,7 @@ func isPrimaryKey(field reflect.StructField, primaryKeyOverwrites []string) bool
 
 	sqlTag := field.Tag.Get("sql")
 
-	return sqlTag == "primary_key"
+	return sqlTag == "primary_key" || sqlTag == "group_key"
 }
 
 func parentFieldPrimaryKeyOverwrite(parentField *reflect.StructField) []string {
@@ -33,3 +33,24 @@ func TestIsSimpleModelType(t *testing.T) {
 	assert.Equal(t, isSimpleModelType(reflect.TypeOf(complexModelType)), false)
 	assert.Equal(t, isSimpleModelType(reflect.TypeOf(&complexModelType)), false)
 }
+
+func TestSetReflectValueFromString(t *testing.T) {
+	var intValue int32
+	assert.NilError(t, setReflectValue(reflect.ValueOf("11"), reflect.ValueOf(&intValue).Elem()))
+	assert.Equal(t, intValue, int32(11))
+
+	var floatPtr *float64
+	assert.NilError(t, setReflectValue(reflect.ValueOf("1.5"), reflect.ValueOf(&floatPtr).Elem()))
+	assert.Equal(t, *floatPtr, 1.5)
+
+	var boolValue bool
+	assert.NilError(t, setReflectValue(reflect.ValueOf("1"), reflect.ValueOf(&boolValue).Elem()))
+	assert.Equal(t, boolValue, true)
+
+	var timeValue time.Time
+	assert.NilError(t, setReflectValue(reflect.ValueOf("2010-03-30 10:15:30"), reflect.ValueOf(&timeValue).Elem()))
+	assert.Equal(t, timeValue, time.Date(2010, time.March, 30, 10, 15, 30, 0, time.UTC))
+
+	var int8Value int8
+	assert.Assert(t, !trySetFromString("1000", reflect.ValueOf(&int8Value).Elem()))
+}
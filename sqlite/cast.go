@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+)
+
+type cast interface {
+	// Cast expressions as castType type
+	AS(castType string) Expression
+	// Cast expression AS text type
+	AS_TEXT() StringExpression
+	// Cast expression AS integer type
+	AS_INTEGER() IntegerExpression
+	// Cast expression AS real type
+	AS_REAL() FloatExpression
+	// Cast expression AS numeric type
+	AS_NUMERIC() FloatExpression
+	// Cast expression AS blob type
+	AS_BLOB() StringExpression
+}
+
+type castImpl struct {
+	jet.Cast
+}
+
+// CAST function converts a expr (of any type) into latter specified datatype.
+func CAST(expr Expression) cast {
+	castImpl := &castImpl{}
+
+	castImpl.Cast = jet.NewCastImpl(expr)
+
+	return castImpl
+}
+
+// AS casts expressions to castType
+func (c *castImpl) AS(castType string) Expression {
+	return c.Cast.AS(castType)
+}
+
+// AS_TEXT casts expression to TEXT type
+func (c *castImpl) AS_TEXT() StringExpression {
+	return StringExp(c.AS("TEXT"))
+}
+
+// AS_INTEGER casts expression to INTEGER type
+func (c *castImpl) AS_INTEGER() IntegerExpression {
+	return IntExp(c.AS("INTEGER"))
+}
+
+// AS_REAL casts expression to REAL type
+func (c *castImpl) AS_REAL() FloatExpression {
+	return FloatExp(c.AS("REAL"))
+}
+
+// AS_NUMERIC casts expression to NUMERIC type
+func (c *castImpl) AS_NUMERIC() FloatExpression {
+	return FloatExp(c.AS("NUMERIC"))
+}
+
+// AS_BLOB casts expression to BLOB type
+func (c *castImpl) AS_BLOB() StringExpression {
+	return StringExp(c.AS("BLOB"))
+}
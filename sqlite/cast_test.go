@@ -0,0 +1,14 @@
+package sqlite
+
+import (
+	"testing"
+)
+
+func TestCAST(t *testing.T) {
+	assertClauseSerialize(t, CAST(Float(11.22)).AS("bigint"), `CAST(? AS bigint)`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_TEXT(), `CAST(? AS TEXT)`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_INTEGER(), `CAST(? AS INTEGER)`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_REAL(), `CAST(? AS REAL)`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_NUMERIC(), `CAST(? AS NUMERIC)`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_BLOB(), `CAST(? AS BLOB)`)
+}
@@ -0,0 +1,85 @@
+package sqlite
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+)
+
+type clauseOnConflict struct {
+	Show      bool
+	Columns   []jet.Column
+	Where     jet.ClauseWhere
+	DoNothing bool
+	DoUpdate  conflictAction
+}
+
+func (c *clauseOnConflict) Serialize(statementType jet.StatementType, out *jet.SQLBuilder) {
+	if !c.Show {
+		return
+	}
+
+	out.NewLine()
+	out.WriteString("ON CONFLICT")
+
+	if len(c.Columns) > 0 {
+		out.WriteString("(")
+		jet.SerializeColumnNames(c.Columns, out)
+		out.WriteString(")")
+	}
+
+	c.Where.Serialize(statementType, out)
+
+	switch {
+	case c.DoNothing:
+		out.WriteString("DO NOTHING")
+	case c.DoUpdate != nil:
+		out.WriteString("DO UPDATE")
+		out.IncreaseIdent()
+		c.DoUpdate.Serialize(statementType, out)
+		out.DecreaseIdent()
+	default:
+		panic("jet: ON CONFLICT clause requires DO_NOTHING or DO_UPDATE action")
+	}
+}
+
+type conflictSet interface {
+	VALUES(value interface{}, values ...interface{}) conflictAction
+}
+
+type conflictAction interface {
+	jet.Clause
+
+	WHERE(condition BoolExpression) conflictAction
+}
+
+// SET creates update action for ON_CONFLICT DO_UPDATE clause of INSERT statement
+func SET(column jet.Column, columns ...jet.Column) conflictSet {
+	return &conflictActionImpl{
+		set: jet.ClauseSet{Columns: jet.UnwindColumns(column, columns...)},
+	}
+}
+
+// EXCLUDED references the value column would have had if there had been no conflict.
+// It can be used only in ON_CONFLICT DO_UPDATE clause of INSERT statement.
+func EXCLUDED(column jet.Column) Expression {
+	return Raw("excluded." + column.Name())
+}
+
+type conflictActionImpl struct {
+	set   jet.ClauseSet
+	where jet.ClauseWhere
+}
+
+func (c *conflictActionImpl) VALUES(value interface{}, values ...interface{}) conflictAction {
+	c.set.Values = jet.UnwindRowFromValues(value, values)
+	return c
+}
+
+func (c *conflictActionImpl) WHERE(condition BoolExpression) conflictAction {
+	c.where.Condition = condition
+	return c
+}
+
+func (c *conflictActionImpl) Serialize(statementType jet.StatementType, out *jet.SQLBuilder) {
+	c.set.Serialize(statementType, out)
+	c.where.Serialize(statementType, out)
+}
@@ -0,0 +1,17 @@
+package sqlite
+
+import (
+	"testing"
+)
+
+func TestDeleteUnconditionally(t *testing.T) {
+	assertStatementSqlErr(t, table1.DELETE(), `jet: WHERE clause not set`)
+}
+
+func TestDeleteWithWhereReturning(t *testing.T) {
+	assertStatementSql(t, table1.DELETE().WHERE(table1Col1.EQ(Int(1))).RETURNING(table1Col1), `
+DELETE FROM db.table1
+WHERE table1.col1 = ?
+RETURNING table1.col1 AS "table1.col1";
+`, int64(1))
+}
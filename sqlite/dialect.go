@@ -0,0 +1,115 @@
+package sqlite
+
+import (
+	"encoding/hex"
+	"github.com/go-jet/jet/internal/jet"
+)
+
+// Dialect is implementation of SQLite dialect for SQL Builder serialisation.
+var Dialect = newDialect()
+
+func newDialect() jet.Dialect {
+
+	operatorSerializeOverrides := map[string]jet.SerializeOverride{}
+	operatorSerializeOverrides[jet.StringRegexpLikeOperator] = sqliteREGEXPLIKEoperator
+	operatorSerializeOverrides[jet.StringNotRegexpLikeOperator] = sqliteNOTREGEXPLIKEoperator
+	operatorSerializeOverrides["IS DISTINCT FROM"] = sqliteISDISTINCTFROM
+	operatorSerializeOverrides["IS NOT DISTINCT FROM"] = sqliteISNOTDISTINCTFROM
+	operatorSerializeOverrides["#"] = sqliteBitXor
+
+	sqliteDialectParams := jet.DialectParams{
+		Name:                       "SQLite",
+		PackageName:                "sqlite",
+		OperatorSerializeOverrides: operatorSerializeOverrides,
+		AliasQuoteChar:             '"',
+		IdentifierQuoteChar:        '"',
+		ArgumentPlaceholder: func(int) string {
+			return "?"
+		},
+		ArgumentToString: sqliteArgumentToString,
+		Capabilities: []jet.Capability{
+			jet.CapabilityReturning,
+			jet.CapabilityWindowGroupsFrame,
+		},
+	}
+
+	return jet.NewDialect(sqliteDialectParams)
+}
+
+func sqliteArgumentToString(value interface{}) (string, bool) {
+	if bytes, ok := value.([]byte); ok {
+		return "X'" + hex.EncodeToString(bytes) + "'", true
+	}
+
+	return "", false
+}
+
+func sqliteBitXor(expressions ...jet.Expression) jet.SerializeFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator XOR")
+		}
+
+		lhs := expressions[0]
+		rhs := expressions[1]
+
+		// SQLite does not have XOR operator, (a | b) - (a & b) is used instead
+		out.WriteString("(")
+		jet.Serialize(lhs, statement, out, options...)
+		out.WriteString("|")
+		jet.Serialize(rhs, statement, out, options...)
+		out.WriteString(") - (")
+		jet.Serialize(lhs, statement, out, options...)
+		out.WriteString("&")
+		jet.Serialize(rhs, statement, out, options...)
+		out.WriteString(")")
+	}
+}
+
+func sqliteISNOTDISTINCTFROM(expressions ...jet.Expression) jet.SerializeFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator")
+		}
+
+		jet.Serialize(expressions[0], statement, out)
+		out.WriteString("IS")
+		jet.Serialize(expressions[1], statement, out)
+	}
+}
+
+func sqliteISDISTINCTFROM(expressions ...jet.Expression) jet.SerializeFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator")
+		}
+
+		jet.Serialize(expressions[0], statement, out)
+		out.WriteString("IS NOT")
+		jet.Serialize(expressions[1], statement, out)
+	}
+}
+
+func sqliteREGEXPLIKEoperator(expressions ...jet.Expression) jet.SerializeFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator")
+		}
+
+		jet.Serialize(expressions[0], statement, out, options...)
+		out.WriteString("REGEXP")
+		jet.Serialize(expressions[1], statement, out, options...)
+	}
+}
+
+func sqliteNOTREGEXPLIKEoperator(expressions ...jet.Expression) jet.SerializeFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator")
+		}
+
+		jet.Serialize(expressions[0], statement, out, options...)
+		out.WriteString("NOT REGEXP")
+		jet.Serialize(expressions[1], statement, out, options...)
+	}
+}
@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestBoolExpressionIS_DISTINCT_FROM(t *testing.T) {
+	assertClauseSerialize(t, table1ColBool.IS_DISTINCT_FROM(table2ColBool), "(table1.col_bool IS NOT table2.col_bool)")
+	assertClauseSerialize(t, table1ColBool.IS_DISTINCT_FROM(Bool(false)), "(table1.col_bool IS NOT ?)", false)
+}
+
+func TestBoolExpressionIS_NOT_DISTINCT_FROM(t *testing.T) {
+	assertClauseSerialize(t, table1ColBool.IS_NOT_DISTINCT_FROM(table2ColBool), "(table1.col_bool IS table2.col_bool)")
+	assertClauseSerialize(t, table1ColBool.IS_NOT_DISTINCT_FROM(Bool(false)), "(table1.col_bool IS ?)", false)
+}
+
+func TestIntegerExpressionDIV(t *testing.T) {
+	assertClauseSerialize(t, table1ColInt.DIV(table2ColInt), "(table1.col_int / table2.col_int)")
+	assertClauseSerialize(t, table1ColInt.DIV(Int(11)), "(table1.col_int / ?)", int64(11))
+}
+
+func TestIntExpressionBIT_XOR(t *testing.T) {
+	assertClauseSerialize(t, table1ColInt.BIT_XOR(table2ColInt), "((table1.col_int | table2.col_int) - (table1.col_int & table2.col_int))")
+	assertClauseSerialize(t, table1ColInt.BIT_XOR(Int(11)), "((table1.col_int | ?) - (table1.col_int & ?))", int64(11), int64(11))
+}
+
+func TestStringCONCAT(t *testing.T) {
+	assertClauseSerialize(t, table3StrCol.CONCAT(table2ColStr), "(table3.col2 || table2.col_str)")
+}
+
+func TestExists(t *testing.T) {
+	assertClauseSerialize(t, EXISTS(
+		table2.
+			SELECT(Int(1)).
+			WHERE(table1Col1.EQ(table2Col3)),
+	),
+		`(EXISTS (
+     SELECT ?
+     FROM db.table2
+     WHERE table1.col1 = table2.col3
+))`, int64(1))
+}
+
+func TestString_REGEXP_LIKE_operator(t *testing.T) {
+	assertClauseSerialize(t, table3StrCol.REGEXP_LIKE(table2ColStr), "(table3.col2 REGEXP table2.col_str)")
+	assertClauseSerialize(t, table3StrCol.REGEXP_LIKE(String("JOHN"), true), "(table3.col2 REGEXP ?)", "JOHN")
+}
+
+func TestString_NOT_REGEXP_LIKE_operator(t *testing.T) {
+	assertClauseSerialize(t, table3StrCol.NOT_REGEXP_LIKE(table2ColStr), "(table3.col2 NOT REGEXP table2.col_str)")
+	assertClauseSerialize(t, table3StrCol.NOT_REGEXP_LIKE(String("JOHN"), true), "(table3.col2 NOT REGEXP ?)", "JOHN")
+}
+
+func TestDebugSqlLiterals(t *testing.T) {
+	stmt := table1.INSERT(table1Col1, table1Col3).VALUES(int8(8), []byte{0xde, 0xad, 0xbe, 0xef})
+
+	assert.Equal(t, stmt.DebugSql(), `
+INSERT INTO db.table1 (col1, col3) VALUES
+     (8, X'deadbeef');
+`)
+}
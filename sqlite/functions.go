@@ -0,0 +1,225 @@
+package sqlite
+
+import "github.com/go-jet/jet/internal/jet"
+
+// ------------------ Mathematical functions ---------------//
+
+// ABSf calculates absolute value from float expression
+var ABSf = jet.ABSf
+
+// ABSi calculates absolute value from int expression
+var ABSi = jet.ABSi
+
+// ROUND calculates round of a float expressions with optional precision
+var ROUND = jet.ROUND
+
+// RANDOM returns pseudo-random integer between -9223372036854775808 and +9223372036854775807
+func RANDOM() IntegerExpression {
+	return jet.NewIntegerFunc("RANDOM")
+}
+
+// ----------------- Aggregate functions  -------------------//
+
+// AVG is aggregate function used to calculate avg value from numeric expression
+var AVG = jet.AVG
+
+// COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
+var COUNT = jet.COUNT
+
+// MAX is aggregate function. Returns maximum value of expression across all input values
+var MAX = jet.MAX
+
+// MAXi is aggregate function. Returns maximum value of int expression across all input values
+var MAXi = jet.MAXi
+
+// MAXf is aggregate function. Returns maximum value of float expression across all input values
+var MAXf = jet.MAXf
+
+// MIN is aggregate function. Returns minimum value of int expression across all input values
+var MIN = jet.MIN
+
+// MINi is aggregate function. Returns minimum value of int expression across all input values
+var MINi = jet.MINi
+
+// MINf is aggregate function. Returns minimum value of float expression across all input values
+var MINf = jet.MINf
+
+// SUMi is aggregate function. Returns sum of integer expression.
+var SUMi = jet.SUMi
+
+// SUMf is aggregate function. Returns sum of float expression.
+var SUMf = jet.SUMf
+
+// -------------------- Window functions -----------------------//
+
+// ROW_NUMBER returns number of the current row within its partition, counting from 1
+var ROW_NUMBER = jet.ROW_NUMBER
+
+// RANK of the current row with gaps; same as row_number of its first peer
+var RANK = jet.RANK
+
+// DENSE_RANK returns rank of the current row without gaps; this function counts peer groups
+var DENSE_RANK = jet.DENSE_RANK
+
+// PERCENT_RANK calculates relative rank of the current row: (rank - 1) / (total partition rows - 1)
+var PERCENT_RANK = jet.PERCENT_RANK
+
+// CUME_DIST calculates cumulative distribution: (number of partition rows preceding or peer with current row) / total partition rows
+var CUME_DIST = jet.CUME_DIST
+
+// NTILE returns integer ranging from 1 to the argument value, dividing the partition as equally as possible
+var NTILE = jet.NTILE
+
+// LAG returns value evaluated at the row that is offset rows before the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+// Both offset and default are evaluated with respect to the current row.
+// If omitted, offset defaults to 1 and default to null
+var LAG = jet.LAG
+
+// LEAD returns value evaluated at the row that is offset rows after the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+// Both offset and default are evaluated with respect to the current row.
+// If omitted, offset defaults to 1 and default to null
+var LEAD = jet.LEAD
+
+// FIRST_VALUE returns value evaluated at the row that is the first row of the window frame
+var FIRST_VALUE = jet.FIRST_VALUE
+
+// LAST_VALUE returns value evaluated at the row that is the last row of the window frame
+var LAST_VALUE = jet.LAST_VALUE
+
+// NTH_VALUE returns value evaluated at the row that is the nth row of the window frame (counting from 1); null if no such row
+var NTH_VALUE = jet.NTH_VALUE
+
+//--------------------- String functions ------------------//
+
+// LOWER returns string expression in lower case
+var LOWER = jet.LOWER
+
+// UPPER returns string expression in upper case
+var UPPER = jet.UPPER
+
+// TRIM removes the longest string containing only characters
+// from characters (a space by default) from the both ends of string
+func TRIM(str StringExpression, trimChars ...StringExpression) StringExpression {
+	return jet.NewStringFunc("TRIM", append([]Expression{str}, toExpressionList(trimChars)...)...)
+}
+
+// LTRIM removes the longest string containing only characters
+// from characters (a space by default) from the start of string
+var LTRIM = jet.LTRIM
+
+// RTRIM removes the longest string containing only characters
+// from characters (a space by default) from the end of string
+var RTRIM = jet.RTRIM
+
+// LENGTH returns number of characters in string
+func LENGTH(str StringExpression) IntegerExpression {
+	return jet.NewIntegerFunc("LENGTH", str)
+}
+
+// INSTR returns position of the first occurrence of substring in string, counting from 1, or 0 if not found
+func INSTR(str, substring StringExpression) IntegerExpression {
+	return jet.NewIntegerFunc("INSTR", str, substring)
+}
+
+// HEX returns upper-case hexadecimal rendering of the content of expression
+func HEX(expression Expression) StringExpression {
+	return jet.NewStringFunc("HEX", expression)
+}
+
+// REPLACE replaces all occurrences in string of substring from with substring to
+var REPLACE = jet.REPLACE
+
+// SUBSTR extracts substring
+var SUBSTR = jet.SUBSTR
+
+//----------------- Date/Time Functions and Operators ------------//
+
+// CURRENT_DATE returns current date
+var CURRENT_DATE = jet.CURRENT_DATE
+
+// CURRENT_TIME returns current time
+func CURRENT_TIME() TimeExpression {
+	return TimeExp(jet.CURRENT_TIME())
+}
+
+// CURRENT_TIMESTAMP returns current timestamp
+func CURRENT_TIMESTAMP() TimestampExpression {
+	return TimestampExp(jet.CURRENT_TIMESTAMP())
+}
+
+// DATE returns date of time value as text in YYYY-MM-DD format, after applying optional modifiers
+func DATE(timeValue Expression, modifiers ...StringExpression) DateExpression {
+	return jet.NewDateFunc("DATE", append([]Expression{timeValue}, toExpressionList(modifiers)...)...)
+}
+
+// TIME returns time of time value as text in HH:MM:SS format, after applying optional modifiers
+func TIME(timeValue Expression, modifiers ...StringExpression) TimeExpression {
+	return jet.NewTimeFunc("TIME", append([]Expression{timeValue}, toExpressionList(modifiers)...)...)
+}
+
+// DATETIME returns time value as text in YYYY-MM-DD HH:MM:SS format, after applying optional modifiers
+func DATETIME(timeValue Expression, modifiers ...StringExpression) DateTimeExpression {
+	return jet.NewTimestampFunc("DATETIME", append([]Expression{timeValue}, toExpressionList(modifiers)...)...)
+}
+
+// JULIANDAY returns the number of days since noon in Greenwich on November 24, 4714 B.C.
+func JULIANDAY(timeValue Expression, modifiers ...StringExpression) FloatExpression {
+	return jet.NewFloatFunc("JULIANDAY", append([]Expression{timeValue}, toExpressionList(modifiers)...)...)
+}
+
+// STRFTIME returns time value formatted according to the format string
+func STRFTIME(format StringExpression, timeValue Expression, modifiers ...StringExpression) StringExpression {
+	return jet.NewStringFunc("STRFTIME", append([]Expression{format, timeValue}, toExpressionList(modifiers)...)...)
+}
+
+//----------- Comparison operators ---------------//
+
+// EXISTS checks for existence of the rows in subQuery
+var EXISTS = jet.EXISTS
+
+// CASE create CASE operator with optional list of expressions
+var CASE = jet.CASE
+
+// COALESCE function returns the first of its arguments that is not null.
+var COALESCE = jet.COALESCE
+
+// NULLIF function returns a null value if value1 equals value2; otherwise it returns value1.
+var NULLIF = jet.NULLIF
+
+// IFNULL function returns a copy of its first non-NULL argument, or NULL if both arguments are NULL.
+var IFNULL = jet.IFNULL
+
+//----------------- Logical operators ---------------//
+
+// AND joins conditions with AND operator, skipping nil conditions
+var AND = jet.AND
+
+// OR joins conditions with OR operator, skipping nil conditions
+var OR = jet.OR
+
+// Conditional returns condition if include is true, otherwise nil. Condition is evaluated even if include is false.
+var Conditional = jet.Conditional
+
+// ConditionalFunc returns condition created by conditionFunc if include is true, otherwise nil.
+// conditionFunc is called only if include is true.
+var ConditionalFunc = jet.ConditionalFunc
+
+// ConditionalOrderBy returns orderBy if include is true, otherwise nil. Nil clauses are skipped in ORDER BY clause.
+var ConditionalOrderBy = jet.ConditionalOrderBy
+
+//----------------- Bit operators ---------------//
+
+// BIT_NOT inverts every bit in integer expression
+var BIT_NOT = jet.BIT_NOT
+
+func toExpressionList(expressions []StringExpression) []Expression {
+	var ret []Expression
+
+	for _, expression := range expressions {
+		ret = append(ret, expression)
+	}
+
+	return ret
+}
@@ -0,0 +1,30 @@
+package sqlite
+
+import (
+	"testing"
+)
+
+func TestStringFunctions(t *testing.T) {
+	assertClauseSerialize(t, LENGTH(table3StrCol), "LENGTH(table3.col2)")
+	assertClauseSerialize(t, INSTR(table3StrCol, String("a")), "INSTR(table3.col2, ?)", "a")
+	assertClauseSerialize(t, TRIM(table3StrCol), "TRIM(table3.col2)")
+	assertClauseSerialize(t, TRIM(table3StrCol, String("x")), "TRIM(table3.col2, ?)", "x")
+	assertClauseSerialize(t, HEX(table3StrCol), "HEX(table3.col2)")
+}
+
+func TestDateTimeFunctions(t *testing.T) {
+	assertClauseSerialize(t, DATE(table1ColDate), "DATE(table1.col_date)")
+	assertClauseSerialize(t, DATE(String("now"), String("start of month")), "DATE(?, ?)", "now", "start of month")
+	assertClauseSerialize(t, TIME(table1ColTimestamp), "TIME(table1.col_timestamp)")
+	assertClauseSerialize(t, DATETIME(table1ColTimestamp, String("+1 day")), "DATETIME(table1.col_timestamp, ?)", "+1 day")
+	assertClauseSerialize(t, JULIANDAY(table1ColDate), "JULIANDAY(table1.col_date)")
+	assertClauseSerialize(t, STRFTIME(String("%Y"), table1ColDate), "STRFTIME(?, table1.col_date)", "%Y")
+	assertClauseSerialize(t, CURRENT_DATE(), "CURRENT_DATE")
+	assertClauseSerialize(t, CURRENT_TIME(), "CURRENT_TIME")
+	assertClauseSerialize(t, CURRENT_TIMESTAMP(), "CURRENT_TIMESTAMP")
+}
+
+func TestOtherFunctions(t *testing.T) {
+	assertClauseSerialize(t, IFNULL(table1ColInt, Int(0)), "IFNULL(table1.col_int, ?)", int64(0))
+	assertClauseSerialize(t, RANDOM(), "RANDOM()")
+}
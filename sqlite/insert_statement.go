@@ -0,0 +1,166 @@
+package sqlite
+
+import (
+	"context"
+	"github.com/go-jet/jet/internal/jet"
+	"github.com/go-jet/jet/qrm"
+)
+
+// InsertStatement is interface for SQL INSERT statements
+type InsertStatement interface {
+	Statement
+	jet.ReturningStatement
+
+	// OR_REPLACE replaces rows that would violate uniqueness constraint
+	OR_REPLACE() InsertStatement
+	// OR_IGNORE skips rows that would violate a constraint
+	OR_IGNORE() InsertStatement
+
+	// Insert row of values
+	VALUES(value interface{}, values ...interface{}) InsertStatement
+	// Insert row of values, where value for each column is extracted from filed of structure data.
+	// If data is not struct or there is no field for every column selected, this method will panic.
+	MODEL(data interface{}) InsertStatement
+	MODELS(data interface{}) InsertStatement
+
+	// QUERY inserts rows returned by select statement. When used together with ON_CONFLICT,
+	// select statement has to contain WHERE clause, to avoid parsing ambiguity.
+	QUERY(selectStatement SelectStatement) InsertStatement
+
+	ON_CONFLICT(columns ...jet.Column) onConflict
+	RETURNING(projections ...jet.Projection) InsertStatement
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() InsertStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() InsertStatement
+}
+
+type onConflict interface {
+	WHERE(indexPredicate BoolExpression) conflictTarget
+	conflictTarget
+}
+
+type conflictTarget interface {
+	DO_NOTHING() InsertStatement
+	DO_UPDATE(action conflictAction) InsertStatement
+}
+
+func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
+	newInsert := &insertStatementImpl{}
+	newInsert.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newInsert,
+		&newInsert.Insert, &newInsert.ValuesQuery, &newInsert.OnConflict, &newInsert.Returning)
+
+	newInsert.Insert.Table = table
+	newInsert.Insert.Columns = columns
+
+	return newInsert
+}
+
+type insertStatementImpl struct {
+	jet.SerializerStatement
+
+	Insert      jet.ClauseInsert
+	ValuesQuery jet.ClauseValuesQuery
+	OnConflict  clauseOnConflict
+	Returning   jet.ClauseReturning
+}
+
+func (i *insertStatementImpl) OR_REPLACE() InsertStatement {
+	i = i.edit()
+	i.Insert.Modifier = "OR REPLACE"
+	return i
+}
+
+func (i *insertStatementImpl) OR_IGNORE() InsertStatement {
+	i = i.edit()
+	i.Insert.Modifier = "OR IGNORE"
+	return i
+}
+
+func (i *insertStatementImpl) VALUES(value interface{}, values ...interface{}) InsertStatement {
+	i = i.edit()
+	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowFromValues(value, values))
+	return i
+}
+
+func (i *insertStatementImpl) MODEL(data interface{}) InsertStatement {
+	i = i.edit()
+	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowFromModel(i.Insert.GetColumns(), data))
+	return i
+}
+
+func (i *insertStatementImpl) MODELS(data interface{}) InsertStatement {
+	i = i.edit()
+	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowsFromModels(i.Insert.GetColumns(), data)...)
+	return i
+}
+
+func (i *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertStatement {
+	i = i.edit()
+	i.ValuesQuery.Query = selectStatement
+	return i
+}
+
+func (i *insertStatementImpl) ON_CONFLICT(columns ...jet.Column) onConflict {
+	i = i.edit()
+	i.OnConflict.Show = true
+	i.OnConflict.Columns = jet.UnwidColumnList(columns)
+	return i
+}
+
+func (i *insertStatementImpl) WHERE(indexPredicate BoolExpression) conflictTarget {
+	i = i.edit()
+	i.OnConflict.Where.Condition = indexPredicate
+	return i
+}
+
+func (i *insertStatementImpl) DO_NOTHING() InsertStatement {
+	i = i.edit()
+	i.OnConflict.DoNothing = true
+	return i
+}
+
+func (i *insertStatementImpl) DO_UPDATE(action conflictAction) InsertStatement {
+	i = i.edit()
+	i.OnConflict.DoUpdate = action
+	return i
+}
+
+func (i *insertStatementImpl) RETURNING(projections ...jet.Projection) InsertStatement {
+	i = i.edit()
+	i.Returning.Projections = projections
+	return i
+}
+
+func (i *insertStatementImpl) ExecReturning(ctx context.Context, db qrm.DB, destination interface{}) (int64, error) {
+	return jet.ExecReturning(ctx, db, i, i.Returning, destination)
+}
+
+func (i *insertStatementImpl) Clone() InsertStatement {
+	return i.clone()
+}
+
+func (i *insertStatementImpl) Immutable() InsertStatement {
+	newInsert := i.clone()
+	jet.SetImmutable(newInsert.SerializerStatement)
+
+	return newInsert
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (i *insertStatementImpl) edit() *insertStatementImpl {
+	if !jet.IsImmutable(i.SerializerStatement) {
+		return i
+	}
+
+	return i.clone()
+}
+
+func (i *insertStatementImpl) clone() *insertStatementImpl {
+	newInsert := newInsertStatement(nil, nil).(*insertStatementImpl)
+	jet.CloneStatement(newInsert.SerializerStatement, i.SerializerStatement)
+
+	return newInsert
+}
@@ -0,0 +1,92 @@
+package sqlite
+
+import (
+	"context"
+	"github.com/go-jet/jet/qrm"
+	"gotest.tools/assert"
+	"runtime"
+	"testing"
+)
+
+func TestInvalidInsert(t *testing.T) {
+	assertStatementSqlErr(t, table1.INSERT(table1Col1), "jet: VALUES or QUERY has to be specified for INSERT statement")
+	assertStatementSqlErr(t, table1.INSERT(table1Col1).VALUES(1).ON_CONFLICT(table1Col1).DO_UPDATE(nil), "jet: ON CONFLICT clause requires DO_NOTHING or DO_UPDATE action")
+}
+
+func TestInsertValues(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1, table1ColFloat).VALUES(1, 2.1).VALUES(3, 4.3), `
+INSERT INTO db.table1 (col1, col_float) VALUES
+     (?, ?),
+     (?, ?);
+`, 1, 2.1, 3, 4.3)
+}
+
+func TestInsertOrReplace(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1).OR_REPLACE().VALUES(1), `
+INSERT OR REPLACE INTO db.table1 (col1) VALUES
+     (?);
+`, 1)
+	assertStatementSql(t, table1.INSERT(table1Col1).OR_IGNORE().VALUES(1), `
+INSERT OR IGNORE INTO db.table1 (col1) VALUES
+     (?);
+`, 1)
+}
+
+func TestInsertOnConflictDoNothing(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1).VALUES(1).ON_CONFLICT().DO_NOTHING(), `
+INSERT INTO db.table1 (col1) VALUES
+     (?)
+ON CONFLICT DO NOTHING;
+`, 1)
+	assertStatementSql(t, table1.INSERT(table1Col1).VALUES(1).ON_CONFLICT(table1Col1).WHERE(table1ColBool).DO_NOTHING(), `
+INSERT INTO db.table1 (col1) VALUES
+     (?)
+ON CONFLICT (col1)
+WHERE table1.col_bool DO NOTHING;
+`, 1)
+}
+
+func TestInsertOnConflictDoUpdate(t *testing.T) {
+	stmt := table1.INSERT(table1Col1, table1ColInt).
+		VALUES(1, 2).
+		ON_CONFLICT(table1Col1).
+		DO_UPDATE(
+			SET(table1ColInt, table1ColFloat).
+				VALUES(EXCLUDED(table1ColInt), Float(1.1)).
+				WHERE(table1ColInt.LT(Int(10))),
+		).
+		RETURNING(table1Col1)
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_int) VALUES
+     (?, ?)
+ON CONFLICT (col1) DO UPDATE
+     SET col_int = excluded.col_int, 
+         col_float = ?
+     WHERE table1.col_int < ?
+RETURNING table1.col1 AS "table1.col1";
+`, 1, 2, 1.1, int64(10))
+}
+
+func TestInsertQueryReturning(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1).QUERY(SELECT(table2Col3).FROM(table2)).RETURNING(table1Col1, table1ColInt), `
+INSERT INTO db.table1 (col1) (
+     SELECT table2.col3 AS "table2.col3"
+     FROM db.table2
+)
+RETURNING table1.col1 AS "table1.col1",
+          table1.col_int AS "table1.col_int";
+`)
+}
+
+func TestInsertExecReturningRuntimePanic(t *testing.T) {
+	defer func() {
+		_, isRuntimeError := recover().(runtime.Error)
+		assert.Assert(t, isRuntimeError)
+	}()
+
+	var dest []struct{}
+	_, _ = table1.INSERT(table1Col1).VALUES(1).RETURNING(table1Col1).ExecReturning(context.Background(), struct{ qrm.DB }{}, &dest)
+
+	t.Fatal("runtime panic is not propagated")
+}
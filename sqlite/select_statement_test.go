@@ -0,0 +1,51 @@
+package sqlite
+
+import (
+	"testing"
+)
+
+func TestInvalidSelect(t *testing.T) {
+	assertStatementSqlErr(t, SELECT(nil), "jet: Projection is nil")
+}
+
+func TestSelectFrom(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt, table2ColFloat).FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))), `
+SELECT table1.col_int AS "table1.col_int",
+     table2.col_float AS "table2.col_float"
+FROM db.table1
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int);
+`)
+}
+
+func TestSelectWhereGroupByOrderByLimitOffset(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt, COUNT(table1Col1)).
+		FROM(table1).
+		WHERE(table1ColBool.EQ(Bool(true))).
+		GROUP_BY(table1ColInt).
+		HAVING(COUNT(table1Col1).GT(Int(1))).
+		ORDER_BY(table1ColInt.DESC()).
+		LIMIT(10).
+		OFFSET(20), `
+SELECT table1.col_int AS "table1.col_int",
+     COUNT(table1.col1)
+FROM db.table1
+WHERE table1.col_bool = ?
+GROUP BY table1.col_int
+HAVING COUNT(table1.col1) > ?
+ORDER BY table1.col_int DESC
+LIMIT ?
+OFFSET ?;
+`, true, int64(1), int64(10), int64(20))
+}
+
+func TestSelectSubQuery(t *testing.T) {
+	subQuery := SELECT(table1ColInt).FROM(table1).AsTable("sub")
+
+	assertStatementSql(t, SELECT(table1ColInt.From(subQuery)).FROM(subQuery), `
+SELECT sub."table1.col_int" AS "table1.col_int"
+FROM (
+          SELECT table1.col_int AS "table1.col_int"
+          FROM db.table1
+     ) AS sub;
+`)
+}
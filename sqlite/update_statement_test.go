@@ -0,0 +1,24 @@
+package sqlite
+
+import (
+	"testing"
+)
+
+func TestUpdateWithValues(t *testing.T) {
+	stmt := table1.UPDATE(table1ColInt, table1ColFloat).
+		SET(1, 22.2).
+		WHERE(table1ColInt.GT_EQ(Int(33))).
+		RETURNING(table1ColInt)
+
+	assertStatementSql(t, stmt, `
+UPDATE db.table1
+SET col_int = ?, 
+    col_float = ?
+WHERE table1.col_int >= ?
+RETURNING table1.col_int AS "table1.col_int";
+`, 1, 22.2, int64(33))
+}
+
+func TestUpdateWithoutWhere(t *testing.T) {
+	assertStatementSqlErr(t, table1.UPDATE(table1ColInt).SET(1), "jet: WHERE clause not set")
+}
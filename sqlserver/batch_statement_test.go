@@ -0,0 +1,218 @@
+package sqlserver
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"gotest.tools/assert"
+	"io"
+	"testing"
+)
+
+// resultSetsDriver returns the same result sets for every query, and records the last query with its arguments
+type resultSetsDriver struct {
+	resultSets []resultSet
+	query      string
+	args       []driver.Value
+}
+
+type resultSet struct {
+	columns []string
+	types   []string
+	rows    [][]driver.Value
+}
+
+func (d *resultSetsDriver) Open(name string) (driver.Conn, error) {
+	return resultSetsConn{d}, nil
+}
+
+type resultSetsConn struct {
+	driver *resultSetsDriver
+}
+
+func (c resultSetsConn) Prepare(query string) (driver.Stmt, error) {
+	return resultSetsStmt{c.driver, query}, nil
+}
+
+func (c resultSetsConn) Close() error              { return nil }
+func (c resultSetsConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type resultSetsStmt struct {
+	driver *resultSetsDriver
+	query  string
+}
+
+func (s resultSetsStmt) Close() error  { return nil }
+func (s resultSetsStmt) NumInput() int { return -1 }
+
+func (s resultSetsStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+
+func (s resultSetsStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.driver.query, s.driver.args = s.query, args
+
+	return &resultSetsRows{resultSets: s.driver.resultSets}, nil
+}
+
+type resultSetsRows struct {
+	resultSets []resultSet
+	current    int
+	row        int
+}
+
+func (r *resultSetsRows) Columns() []string { return r.resultSets[r.current].columns }
+func (r *resultSetsRows) Close() error      { return nil }
+
+func (r *resultSetsRows) ColumnTypeDatabaseTypeName(index int) string {
+	return r.resultSets[r.current].types[index]
+}
+
+func (r *resultSetsRows) Next(dest []driver.Value) error {
+	rows := r.resultSets[r.current].rows
+
+	if r.row >= len(rows) {
+		return io.EOF
+	}
+
+	copy(dest, rows[r.row])
+	r.row++
+
+	return nil
+}
+
+func (r *resultSetsRows) HasNextResultSet() bool {
+	return r.current+1 < len(r.resultSets)
+}
+
+func (r *resultSetsRows) NextResultSet() error {
+	if !r.HasNextResultSet() {
+		return io.EOF
+	}
+
+	r.current++
+	r.row = 0
+
+	return nil
+}
+
+var testDriver = &resultSetsDriver{}
+
+func init() {
+	sql.Register("jet_sqlserver_result_sets", testDriver)
+}
+
+func TestBatch(t *testing.T) {
+	batch := BATCH(
+		SELECT(table1ColInt).FROM(table1).WHERE(table1ColInt.GT(Int(1))),
+		SELECT(table2ColStr).FROM(table2).WHERE(table2ColStr.EQ(String("str"))),
+	)
+
+	query, args := batch.Sql()
+
+	assert.Equal(t, query, `
+SELECT table1.col_int AS [table1.col_int]
+FROM db.table1
+WHERE table1.col_int > @p1;
+SELECT table2.col_str AS [table2.col_str]
+FROM db.table2
+WHERE table2.col_str = @p2;
+`)
+	assert.DeepEqual(t, args, []interface{}{int64(1), "str"})
+}
+
+func TestBatchQuery(t *testing.T) {
+	db, err := sql.Open("jet_sqlserver_result_sets", "")
+	assert.NilError(t, err)
+	defer db.Close()
+
+	testDriver.resultSets = []resultSet{
+		{
+			columns: []string{"table1.col_int"},
+			types:   []string{"INT"},
+			rows:    [][]driver.Value{{int64(2)}, {int64(3)}},
+		},
+		{
+			columns: []string{"table2.col_str"},
+			types:   []string{"VARCHAR"},
+			rows:    [][]driver.Value{{"str"}},
+		},
+	}
+
+	type Table1 struct {
+		ColInt int32 `sql:"primary_key"`
+	}
+
+	type Table2 struct {
+		ColStr string
+	}
+
+	var table1Rows []Table1
+	var table2Row Table2
+
+	err = BATCH(
+		SELECT(table1ColInt).FROM(table1).WHERE(table1ColInt.GT(Int(1))),
+		SELECT(table2ColStr).FROM(table2).WHERE(table2ColStr.EQ(String("str"))),
+	).Query(db, &table1Rows, &table2Row)
+
+	assert.NilError(t, err)
+	assert.DeepEqual(t, table1Rows, []Table1{{ColInt: 2}, {ColInt: 3}})
+	assert.Equal(t, table2Row.ColStr, "str")
+	assert.DeepEqual(t, testDriver.args, []driver.Value{int64(1), "str"})
+
+	var table2Rows []Table2
+
+	err = BATCH(
+		SELECT(table1ColInt).FROM(table1),
+		SELECT(table2ColStr).FROM(table2),
+		SELECT(table2ColStr).FROM(table2),
+	).Query(db, &table1Rows, &table2Rows, &table2Rows)
+
+	assert.Error(t, err, "jet: query returned 2 result sets, expected 3")
+}
+
+func TestBatchQueryStatementsWithoutRows(t *testing.T) {
+	db, err := sql.Open("jet_sqlserver_result_sets", "")
+	assert.NilError(t, err)
+	defer db.Close()
+
+	testDriver.resultSets = []resultSet{
+		{
+			columns: []string{"table1.col_int"},
+			types:   []string{"INT"},
+			rows:    [][]driver.Value{{int64(2)}},
+		},
+		{
+			columns: []string{"table2.col_str"},
+			types:   []string{"VARCHAR"},
+			rows:    [][]driver.Value{{"str"}},
+		},
+	}
+
+	type Table1 struct {
+		ColInt int32
+	}
+
+	type Table2 struct {
+		ColStr string
+	}
+
+	var table1Row Table1
+	var table2Row Table2
+
+	err = BATCH(
+		table1.UPDATE(table1ColInt).SET(Int(2)).OUTPUT(table1ColInt).WHERE(table1ColInt.EQ(Int(1))),
+		table2.UPDATE(table2ColStr).SET(String("str")).WHERE(table2ColInt.EQ(Int(1))),
+		SELECT(table2ColStr).FROM(table2),
+	).Query(db, &table1Row, &table2Row)
+
+	assert.NilError(t, err)
+	assert.Equal(t, table1Row.ColInt, int32(2))
+	assert.Equal(t, table2Row.ColStr, "str")
+
+	err = BATCH(
+		table2.UPDATE(table2ColStr).SET(String("str")).WHERE(table2ColInt.EQ(Int(1))),
+		SELECT(table2ColStr).FROM(table2),
+	).Query(db, &table1Row, &table2Row)
+
+	assert.Error(t, err, "jet: batch has 1 statements that return rows, but 2 destinations")
+}
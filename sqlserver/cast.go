@@ -0,0 +1,104 @@
+package sqlserver
+
+import (
+	"strconv"
+
+	"github.com/go-jet/jet/internal/jet"
+)
+
+type cast interface {
+	// Cast expressions as castType type
+	AS(castType string) Expression
+	// Cast expression AS bit type
+	AS_BIT() BoolExpression
+	// Cast expression AS int type
+	AS_INT() IntegerExpression
+	// Cast expression AS bigint type
+	AS_BIGINT() IntegerExpression
+	// Cast expression AS decimal type
+	AS_DECIMAL() FloatExpression
+	// Cast expression AS float type
+	AS_FLOAT() FloatExpression
+	// Cast expression AS nvarchar type with optional length
+	AS_NVARCHAR(length ...int) StringExpression
+	// Cast expression AS date type
+	AS_DATE() DateExpression
+	// Cast expression AS time type
+	AS_TIME() TimeExpression
+	// Cast expression AS datetime2 type
+	AS_DATETIME2() DateTimeExpression
+	// Cast expression AS datetimeoffset type
+	AS_DATETIMEOFFSET() TimestampzExpression
+}
+
+type castImpl struct {
+	jet.Cast
+}
+
+// CAST function converts a expr (of any type) into latter specified datatype.
+func CAST(expr Expression) cast {
+	castImpl := &castImpl{}
+
+	castImpl.Cast = jet.NewCastImpl(expr)
+
+	return castImpl
+}
+
+// AS casts expressions to castType
+func (c *castImpl) AS(castType string) Expression {
+	return c.Cast.AS(castType)
+}
+
+// AS_BIT casts expression to BIT type
+func (c *castImpl) AS_BIT() BoolExpression {
+	return BoolExp(c.AS("BIT"))
+}
+
+// AS_INT casts expression to INT type
+func (c *castImpl) AS_INT() IntegerExpression {
+	return IntExp(c.AS("INT"))
+}
+
+// AS_BIGINT casts expression to BIGINT type
+func (c *castImpl) AS_BIGINT() IntegerExpression {
+	return IntExp(c.AS("BIGINT"))
+}
+
+// AS_DECIMAL casts expression to DECIMAL type
+func (c *castImpl) AS_DECIMAL() FloatExpression {
+	return FloatExp(c.AS("DECIMAL"))
+}
+
+// AS_FLOAT casts expression to FLOAT type
+func (c *castImpl) AS_FLOAT() FloatExpression {
+	return FloatExp(c.AS("FLOAT"))
+}
+
+// AS_NVARCHAR casts expression to NVARCHAR type with optional length
+func (c *castImpl) AS_NVARCHAR(length ...int) StringExpression {
+	if len(length) > 0 {
+		return StringExp(c.AS("NVARCHAR(" + strconv.Itoa(length[0]) + ")"))
+	}
+
+	return StringExp(c.AS("NVARCHAR(MAX)"))
+}
+
+// AS_DATE casts expression to DATE type
+func (c *castImpl) AS_DATE() DateExpression {
+	return DateExp(c.AS("DATE"))
+}
+
+// AS_TIME casts expression to TIME type
+func (c *castImpl) AS_TIME() TimeExpression {
+	return TimeExp(c.AS("TIME"))
+}
+
+// AS_DATETIME2 casts expression to DATETIME2 type
+func (c *castImpl) AS_DATETIME2() DateTimeExpression {
+	return DateTimeExp(c.AS("DATETIME2"))
+}
+
+// AS_DATETIMEOFFSET casts expression to DATETIMEOFFSET type
+func (c *castImpl) AS_DATETIMEOFFSET() TimestampzExpression {
+	return TimestampzExp(c.AS("DATETIMEOFFSET"))
+}
@@ -0,0 +1,20 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestCAST(t *testing.T) {
+	assertClauseSerialize(t, CAST(Float(11.22)).AS("bigint"), `CAST(@p1 AS bigint)`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_BIT(), `CAST(@p1 AS BIT)`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_INT(), `CAST(@p1 AS INT)`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_BIGINT(), `CAST(@p1 AS BIGINT)`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_DECIMAL(), `CAST(@p1 AS DECIMAL)`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_FLOAT(), `CAST(@p1 AS FLOAT)`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_NVARCHAR(), `CAST(@p1 AS NVARCHAR(MAX))`)
+	assertClauseSerialize(t, CAST(Int(22)).AS_NVARCHAR(20), `CAST(@p1 AS NVARCHAR(20))`)
+	assertClauseSerialize(t, CAST(String("2020-01-01")).AS_DATE(), `CAST(@p1 AS DATE)`)
+	assertClauseSerialize(t, CAST(String("10:00:00")).AS_TIME(), `CAST(@p1 AS TIME)`)
+	assertClauseSerialize(t, CAST(String("2020-01-01 10:00:00")).AS_DATETIME2(), `CAST(@p1 AS DATETIME2)`)
+	assertClauseSerialize(t, CAST(String("2020-01-01 10:00:00 +01:00")).AS_DATETIMEOFFSET(), `CAST(@p1 AS DATETIMEOFFSET)`)
+}
@@ -0,0 +1,136 @@
+package sqlserver
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+)
+
+type clauseSelect struct {
+	jet.ClauseSelect
+	Top int64
+}
+
+func (s *clauseSelect) Serialize(statementType jet.StatementType, out *jet.SQLBuilder) {
+	out.NewLine()
+	out.WriteString("SELECT")
+
+	if s.Distinct {
+		out.WriteString("DISTINCT")
+	}
+
+	if s.Top >= 0 {
+		out.WriteString("TOP (")
+		jet.Serialize(Int(s.Top), statementType, out)
+		out.WriteString(")")
+	}
+
+	if len(s.Projections) == 0 {
+		panic("jet: SELECT clause has to have at least one projection")
+	}
+
+	out.WriteProjections(statementType, s.Projections)
+}
+
+// clauseOffsetFetch is SQL Server replacement for LIMIT and OFFSET clauses.
+// It is valid only after ORDER BY clause.
+type clauseOffsetFetch struct {
+	Offset int64
+	Fetch  int64
+}
+
+func (o *clauseOffsetFetch) Serialize(statementType jet.StatementType, out *jet.SQLBuilder) {
+	if o.Offset < 0 && o.Fetch < 0 {
+		return
+	}
+
+	offset := o.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	out.NewLine()
+	out.WriteString("OFFSET")
+	jet.Serialize(Int(offset), statementType, out)
+	out.WriteString("ROWS")
+
+	if o.Fetch >= 0 {
+		out.NewLine()
+		out.WriteString("FETCH NEXT")
+		jet.Serialize(Int(o.Fetch), statementType, out)
+		out.WriteString("ROWS ONLY")
+	}
+}
+
+const (
+	inserted = "INSERTED"
+	deleted  = "DELETED"
+)
+
+// INSERTED references the new value of the column modified by INSERT, UPDATE or MERGE statement.
+// It can be used only in OUTPUT clause.
+func INSERTED(column jet.Column) Expression {
+	return Raw(inserted + "." + column.Name())
+}
+
+// DELETED references the old value of the column modified by UPDATE, DELETE or MERGE statement.
+// It can be used only in OUTPUT clause.
+func DELETED(column jet.Column) Expression {
+	return Raw(deleted + "." + column.Name())
+}
+
+// clauseOutput embeds RETURNING clause, so that statement with OUTPUT clause has projections, like statement with RETURNING
+type clauseOutput struct {
+	jet.ClauseReturning
+	Prefix string
+}
+
+func (o *clauseOutput) Serialize(statementType jet.StatementType, out *jet.SQLBuilder) {
+	if len(o.Projections) == 0 {
+		return
+	}
+
+	out.NewLine()
+	out.WriteString("OUTPUT")
+	out.IncreaseIdent()
+	o.serializeProjections(statementType, o.Projections, true, out)
+	out.DecreaseIdent()
+}
+
+func (o *clauseOutput) serializeProjections(statementType jet.StatementType, projections []jet.Projection, first bool, out *jet.SQLBuilder) bool {
+	for _, projection := range projections {
+		if columnList, ok := projection.(jet.ColumnList); ok {
+			columns := make([]jet.Projection, 0, len(columnList))
+			for _, column := range columnList {
+				columns = append(columns, column)
+			}
+			first = o.serializeProjections(statementType, columns, first, out)
+			continue
+		}
+
+		if !first {
+			out.WriteString(",")
+			out.NewLine()
+		}
+		first = false
+
+		column, ok := projection.(jet.ColumnExpression)
+
+		if !ok {
+			jet.SerializeForProjection(projection, statementType, out)
+			continue
+		}
+
+		// column values are read from INSERTED or DELETED pseudo table, but aliased
+		// with table name, so query result can be mapped into destination model
+		out.WriteString(o.Prefix + ".")
+		out.WriteIdentifier(column.Name())
+		out.WriteString("AS")
+
+		if column.TableName() != "" {
+			out.WriteAlias(column.TableName() + "." + column.Name())
+		} else {
+			out.WriteAlias(column.Name())
+		}
+	}
+
+	return first
+}
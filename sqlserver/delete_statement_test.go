@@ -0,0 +1,23 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestDeleteUnconditionally(t *testing.T) {
+	assertStatementSqlErr(t, table1.DELETE(), `jet: WHERE clause not set`)
+}
+
+func TestDeleteOutput(t *testing.T) {
+	assertStatementSql(t, table1.DELETE().WHERE(table1Col1.EQ(Int(1))).OUTPUT(ColumnList{table1Col1, table1ColInt, table1ColFloat, table1Col3, table1ColBool, table1ColDate, table1ColTimestamp}), `
+DELETE FROM db.table1
+OUTPUT DELETED.col1 AS [table1.col1],
+     DELETED.col_int AS [table1.col_int],
+     DELETED.col_float AS [table1.col_float],
+     DELETED.col3 AS [table1.col3],
+     DELETED.col_bool AS [table1.col_bool],
+     DELETED.col_date AS [table1.col_date],
+     DELETED.col_timestamp AS [table1.col_timestamp]
+WHERE table1.col1 = @p1;
+`, int64(1))
+}
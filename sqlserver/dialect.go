@@ -0,0 +1,91 @@
+package sqlserver
+
+import (
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/go-jet/jet/internal/jet"
+)
+
+// Dialect is implementation of SQL Server dialect for SQL Builder serialisation.
+var Dialect = newDialect()
+
+func newDialect() jet.Dialect {
+
+	operatorSerializeOverrides := map[string]jet.SerializeOverride{}
+	operatorSerializeOverrides[jet.StringConcatOperator] = sqlServerCONCAToperator
+	operatorSerializeOverrides["#"] = sqlServerBitXor
+
+	functionSerializeOverrides := map[string]jet.SerializeOverride{}
+	functionSerializeOverrides["IFNULL"] = sqlServerISNULLfunction
+
+	sqlServerDialectParams := jet.DialectParams{
+		Name:                       "SQLServer",
+		PackageName:                "sqlserver",
+		OperatorSerializeOverrides: operatorSerializeOverrides,
+		FunctionSerializeOverrides: functionSerializeOverrides,
+		AliasQuoteChar:             '[',
+		IdentifierQuoteChar:        '[',
+		ArgumentPlaceholder: func(ord int) string {
+			return "@p" + strconv.Itoa(ord)
+		},
+		ArgumentToString: sqlServerArgumentToString,
+	}
+
+	return jet.NewDialect(sqlServerDialectParams)
+}
+
+func sqlServerArgumentToString(value interface{}) (string, bool) {
+	switch bindVal := value.(type) {
+	case bool:
+		if bindVal {
+			return "1", true
+		}
+		return "0", true
+	case []byte:
+		return "0x" + hex.EncodeToString(bindVal), true
+	case time.Time:
+		return "'" + bindVal.Format("2006-01-02 15:04:05.9999999 -07:00") + "'", true
+	}
+
+	return "", false
+}
+
+func sqlServerBitXor(expressions ...jet.Expression) jet.SerializeFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator XOR")
+		}
+
+		jet.Serialize(expressions[0], statement, out, options...)
+		out.WriteString("^")
+		jet.Serialize(expressions[1], statement, out, options...)
+	}
+}
+
+func sqlServerCONCAToperator(expressions ...jet.Expression) jet.SerializeFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator")
+		}
+
+		jet.Serialize(expressions[0], statement, out, options...)
+		out.WriteString("+")
+		jet.Serialize(expressions[1], statement, out, options...)
+	}
+}
+
+func sqlServerISNULLfunction(expressions ...jet.Expression) jet.SerializeFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for function ISNULL")
+		}
+
+		out.WriteString("ISNULL(")
+		jet.Serialize(expressions[0], statement, out, options...)
+		out.WriteString(", ")
+		jet.Serialize(expressions[1], statement, out, options...)
+		out.WriteString(")")
+	}
+}
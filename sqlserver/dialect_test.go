@@ -0,0 +1,56 @@
+package sqlserver
+
+import (
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+func TestIdentifierQuote(t *testing.T) {
+	orderID := IntegerColumn("OrderID")
+	NewTable("dbo", "Orders", orderID)
+
+	assertClauseSerialize(t, orderID, "[Orders].[OrderID]")
+	assertProjectionSerialize(t, table1Col1.AS("table.col"), "table1.col1 AS [table.col]")
+}
+
+func TestIntExpressionBIT_XOR(t *testing.T) {
+	assertClauseSerialize(t, table1ColInt.BIT_XOR(table2ColInt), "(table1.col_int ^ table2.col_int)")
+	assertClauseSerialize(t, table1ColInt.BIT_XOR(Int(11)), "(table1.col_int ^ @p1)", int64(11))
+}
+
+func TestStringCONCAT(t *testing.T) {
+	assertClauseSerialize(t, table3StrCol.CONCAT(table2ColStr), "(table3.col2 + table2.col_str)")
+	assertClauseSerialize(t, table3StrCol.CONCAT(String("x")), "(table3.col2 + @p1)", "x")
+}
+
+func TestArgumentPlaceholders(t *testing.T) {
+	assertClauseSerialize(t, table1ColInt.ADD(Int(1)).GT(Int(2)), "((table1.col_int + @p1) > @p2)",
+		int64(1), int64(2))
+}
+
+func TestExists(t *testing.T) {
+	assertClauseSerialize(t, EXISTS(
+		table2.
+			SELECT(Int(1)).
+			WHERE(table1Col1.EQ(table2Col3)),
+	),
+		`(EXISTS (
+     SELECT @p1
+     FROM db.table2
+     WHERE table1.col1 = table2.col3
+))`, int64(1))
+}
+
+func TestDebugSqlLiterals(t *testing.T) {
+	timestamp := time.Date(2020, 2, 3, 4, 5, 6, 100, time.FixedZone("", 2*3600))
+
+	stmt := table1.INSERT(table1ColBool, table1ColTimestamp, table1Col3).
+		VALUES(true, timestamp, []byte{0xde, 0xad, 0xbe, 0xef})
+
+	assert.Equal(t, stmt.DebugSql(), `
+INSERT INTO db.table1 (col_bool, col_timestamp, col3)
+VALUES
+     (1, '2020-02-03 04:05:06.0000001 +02:00', 0xdeadbeef);
+`)
+}
@@ -0,0 +1,227 @@
+package sqlserver
+
+import "github.com/go-jet/jet/internal/jet"
+
+// ------------------ Mathematical functions ---------------//
+
+// ABSf calculates absolute value from float expression
+var ABSf = jet.ABSf
+
+// ABSi calculates absolute value from int expression
+var ABSi = jet.ABSi
+
+// ROUND rounds float expression to the specified precision
+func ROUND(floatExpression FloatExpression, precision IntegerExpression) FloatExpression {
+	return jet.ROUND(floatExpression, precision)
+}
+
+// CEILING returns the smallest integer greater than, or equal to, float expression
+func CEILING(floatExpression FloatExpression) FloatExpression {
+	return jet.NewFloatFunc("CEILING", floatExpression)
+}
+
+// FLOOR returns the largest integer less than or equal to float expression
+var FLOOR = jet.FLOOR
+
+// POWER returns the value of base raised to the power of exponent
+var POWER = jet.POWER
+
+// SQRT returns the square root of numeric expression
+var SQRT = jet.SQRT
+
+// SIGN returns sign of float expression
+var SIGN = jet.SIGN
+
+// LOG returns natural logarithm of float expression
+var LOG = jet.LOG
+
+// RAND returns pseudo-random float value from 0 through 1, exclusive
+func RAND() FloatExpression {
+	return jet.NewFloatFunc("RAND")
+}
+
+// ----------------- Aggregate functions  -------------------//
+
+// AVG is aggregate function used to calculate avg value from numeric expression
+var AVG = jet.AVG
+
+// COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
+var COUNT = jet.COUNT
+
+// MAX is aggregate function. Returns maximum value of expression across all input values
+var MAX = jet.MAX
+
+// MAXi is aggregate function. Returns maximum value of int expression across all input values
+var MAXi = jet.MAXi
+
+// MAXf is aggregate function. Returns maximum value of float expression across all input values
+var MAXf = jet.MAXf
+
+// MIN is aggregate function. Returns minimum value of int expression across all input values
+var MIN = jet.MIN
+
+// MINi is aggregate function. Returns minimum value of int expression across all input values
+var MINi = jet.MINi
+
+// MINf is aggregate function. Returns minimum value of float expression across all input values
+var MINf = jet.MINf
+
+// SUMi is aggregate function. Returns sum of integer expression.
+var SUMi = jet.SUMi
+
+// SUMf is aggregate function. Returns sum of float expression.
+var SUMf = jet.SUMf
+
+// -------------------- Window functions -----------------------//
+
+// ROW_NUMBER returns number of the current row within its partition, counting from 1
+var ROW_NUMBER = jet.ROW_NUMBER
+
+// RANK of the current row with gaps; same as row_number of its first peer
+var RANK = jet.RANK
+
+// DENSE_RANK returns rank of the current row without gaps; this function counts peer groups
+var DENSE_RANK = jet.DENSE_RANK
+
+// PERCENT_RANK calculates relative rank of the current row: (rank - 1) / (total partition rows - 1)
+var PERCENT_RANK = jet.PERCENT_RANK
+
+// CUME_DIST calculates cumulative distribution: (number of partition rows preceding or peer with current row) / total partition rows
+var CUME_DIST = jet.CUME_DIST
+
+// NTILE returns integer ranging from 1 to the argument value, dividing the partition as equally as possible
+var NTILE = jet.NTILE
+
+// LAG returns value evaluated at the row that is offset rows before the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+// Both offset and default are evaluated with respect to the current row.
+// If omitted, offset defaults to 1 and default to null
+var LAG = jet.LAG
+
+// LEAD returns value evaluated at the row that is offset rows after the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+// Both offset and default are evaluated with respect to the current row.
+// If omitted, offset defaults to 1 and default to null
+var LEAD = jet.LEAD
+
+// FIRST_VALUE returns value evaluated at the row that is the first row of the window frame
+var FIRST_VALUE = jet.FIRST_VALUE
+
+// LAST_VALUE returns value evaluated at the row that is the last row of the window frame
+var LAST_VALUE = jet.LAST_VALUE
+
+//--------------------- String functions ------------------//
+
+// LOWER returns string expression in lower case
+var LOWER = jet.LOWER
+
+// UPPER returns string expression in upper case
+var UPPER = jet.UPPER
+
+// LTRIM removes leading spaces from string
+func LTRIM(str StringExpression) StringExpression {
+	return jet.LTRIM(str)
+}
+
+// RTRIM removes trailing spaces from string
+func RTRIM(str StringExpression) StringExpression {
+	return jet.RTRIM(str)
+}
+
+// LEN returns number of characters in string, excluding trailing spaces
+func LEN(str StringExpression) IntegerExpression {
+	return jet.NewIntegerFunc("LEN", str)
+}
+
+// SUBSTRING extracts length characters from string, starting at position start (counting from 1)
+func SUBSTRING(str StringExpression, start, length IntegerExpression) StringExpression {
+	return jet.NewStringFunc("SUBSTRING", str, start, length)
+}
+
+// CHARINDEX returns position of the first occurrence of substring in string, counting from 1, or 0 if not found
+func CHARINDEX(substring, str StringExpression, startLocation ...IntegerExpression) IntegerExpression {
+	if len(startLocation) > 0 {
+		return jet.NewIntegerFunc("CHARINDEX", substring, str, startLocation[0])
+	}
+
+	return jet.NewIntegerFunc("CHARINDEX", substring, str)
+}
+
+// REPLACE replaces all occurrences in string of substring from with substring to
+var REPLACE = jet.REPLACE
+
+// CONCAT adds two or more expressions together
+var CONCAT = jet.CONCAT
+
+// LEFT returns first n characters in the string
+var LEFT = jet.LEFT
+
+// RIGHT returns last n characters in the string
+var RIGHT = jet.RIGHT
+
+// REVERSE returns reversed string
+var REVERSE = jet.REVERSE
+
+//----------------- Date/Time Functions and Operators ------------//
+
+// GETDATE returns current database system timestamp as datetime value
+func GETDATE() DateTimeExpression {
+	return jet.NewTimestampFunc("GETDATE")
+}
+
+// SYSDATETIME returns current database system timestamp as datetime2 value
+func SYSDATETIME() DateTimeExpression {
+	return jet.NewTimestampFunc("SYSDATETIME")
+}
+
+// SYSDATETIMEOFFSET returns current database system timestamp as datetimeoffset value
+func SYSDATETIMEOFFSET() TimestampzExpression {
+	return TimestampzExp(jet.NewTimestampFunc("SYSDATETIMEOFFSET"))
+}
+
+// CURRENT_TIMESTAMP returns current database system timestamp as datetime value
+func CURRENT_TIMESTAMP() DateTimeExpression {
+	return TimestampExp(jet.CURRENT_TIMESTAMP())
+}
+
+//----------- Comparison operators ---------------//
+
+// EXISTS checks for existence of the rows in subQuery
+var EXISTS = jet.EXISTS
+
+// CASE create CASE operator with optional list of expressions
+var CASE = jet.CASE
+
+// COALESCE function returns the first of its arguments that is not null.
+var COALESCE = jet.COALESCE
+
+// NULLIF function returns a null value if value1 equals value2; otherwise it returns value1.
+var NULLIF = jet.NULLIF
+
+// ISNULL function returns replacement if value is NULL; otherwise it returns value.
+func ISNULL(value, replacement Expression) Expression {
+	return jet.IFNULL(value, replacement)
+}
+
+//----------------- Logical operators ---------------//
+
+// AND joins conditions with AND operator, skipping nil conditions
+var AND = jet.AND
+
+// OR joins conditions with OR operator, skipping nil conditions
+var OR = jet.OR
+
+// Conditional returns condition if include is true, otherwise nil. Condition is evaluated even if include is false.
+var Conditional = jet.Conditional
+
+// ConditionalFunc returns condition created by conditionFunc if include is true, otherwise nil.
+// conditionFunc is called only if include is true.
+var ConditionalFunc = jet.ConditionalFunc
+
+// ConditionalOrderBy returns orderBy if include is true, otherwise nil. Nil clauses are skipped in ORDER BY clause.
+var ConditionalOrderBy = jet.ConditionalOrderBy
+
+//----------------- Bit operators ---------------//
+
+// BIT_NOT inverts every bit in integer expression
+var BIT_NOT = jet.BIT_NOT
@@ -0,0 +1,32 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestMathFunctions(t *testing.T) {
+	assertClauseSerialize(t, ROUND(table1ColFloat, Int(2)), "ROUND(table1.col_float, @p1)", int64(2))
+	assertClauseSerialize(t, CEILING(table1ColFloat), "CEILING(table1.col_float)")
+	assertClauseSerialize(t, RAND(), "RAND()")
+}
+
+func TestStringFunctions(t *testing.T) {
+	assertClauseSerialize(t, LEN(table3StrCol), "LEN(table3.col2)")
+	assertClauseSerialize(t, SUBSTRING(table3StrCol, Int(1), Int(3)), "SUBSTRING(table3.col2, @p1, @p2)", int64(1), int64(3))
+	assertClauseSerialize(t, CHARINDEX(String("a"), table3StrCol), "CHARINDEX(@p1, table3.col2)", "a")
+	assertClauseSerialize(t, CHARINDEX(String("a"), table3StrCol, Int(2)), "CHARINDEX(@p1, table3.col2, @p2)", "a", int64(2))
+	assertClauseSerialize(t, LTRIM(table3StrCol), "LTRIM(table3.col2)")
+	assertClauseSerialize(t, CONCAT(table3StrCol, String("a")), "CONCAT(table3.col2, @p1)", "a")
+}
+
+func TestDateTimeFunctions(t *testing.T) {
+	assertClauseSerialize(t, GETDATE(), "GETDATE()")
+	assertClauseSerialize(t, SYSDATETIME(), "SYSDATETIME()")
+	assertClauseSerialize(t, SYSDATETIMEOFFSET(), "SYSDATETIMEOFFSET()")
+	assertClauseSerialize(t, CURRENT_TIMESTAMP(), "CURRENT_TIMESTAMP")
+}
+
+func TestOtherFunctions(t *testing.T) {
+	assertClauseSerialize(t, ISNULL(table1ColInt, Int(0)), "ISNULL(table1.col_int, @p1)", int64(0))
+	assertClauseSerialize(t, NULLIF(table1ColInt, Int(0)), "NULLIF(table1.col_int, @p1)", int64(0))
+}
@@ -0,0 +1,42 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestInvalidInsert(t *testing.T) {
+	assertStatementSqlErr(t, table1.INSERT(table1Col1), "jet: VALUES or QUERY has to be specified for INSERT statement")
+}
+
+func TestInsertValues(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1, table1ColFloat).VALUES(1, 2.1).VALUES(3, 4.3), `
+INSERT INTO db.table1 (col1, col_float)
+VALUES
+     (@p1, @p2),
+     (@p3, @p4);
+`, 1, 2.1, 3, 4.3)
+}
+
+func TestInsertOutput(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1, table1ColFloat).
+		VALUES(1, 2.1).
+		OUTPUT(table1Col1, INSERTED(table1ColFloat).AS("float"), ColumnList{table1ColBool, table1ColDate}), `
+INSERT INTO db.table1 (col1, col_float)
+OUTPUT INSERTED.col1 AS [table1.col1],
+     INSERTED.col_float AS [float],
+     INSERTED.col_bool AS [table1.col_bool],
+     INSERTED.col_date AS [table1.col_date]
+VALUES
+     (@p1, @p2);
+`, 1, 2.1)
+}
+
+func TestInsertQuery(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1).
+		QUERY(SELECT(table2Col3).FROM(table2)), `
+INSERT INTO db.table1 (col1) (
+     SELECT table2.col3 AS [table2.col3]
+     FROM db.table2
+);
+`)
+}
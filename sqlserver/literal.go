@@ -0,0 +1,89 @@
+package sqlserver
+
+import (
+	"time"
+
+	"github.com/go-jet/jet/internal/jet"
+)
+
+// Keywords
+var (
+	STAR    = jet.STAR
+	NULL    = jet.NULL
+	DEFAULT = jet.DEFAULT
+)
+
+// Bool creates new bool literal expression
+var Bool = jet.Bool
+
+// Int is constructor for integer expressions literals.
+var Int = jet.Int
+
+// Float creates new float literal expression
+var Float = jet.Float
+
+// String creates new string literal expression
+var String = jet.String
+
+// Date creates new date literal
+var Date = func(year int, month time.Month, day int) DateExpression {
+	return CAST(jet.Date(year, month, day)).AS_DATE()
+}
+
+// DateT creates new date literal from time.Time
+var DateT = func(t time.Time) DateExpression {
+	return CAST(jet.DateT(t)).AS_DATE()
+}
+
+// Time creates new time literal
+var Time = func(hour, minute, second int, nanoseconds ...time.Duration) TimeExpression {
+	return CAST(jet.Time(hour, minute, second, nanoseconds...)).AS_TIME()
+}
+
+// TimeT creates new time literal from time.Time
+var TimeT = func(t time.Time) TimeExpression {
+	return CAST(jet.TimeT(t)).AS_TIME()
+}
+
+// DateTime creates new datetime2 literal
+var DateTime = func(year int, month time.Month, day, hour, minute, second int, nanoseconds ...time.Duration) DateTimeExpression {
+	return CAST(jet.Timestamp(year, month, day, hour, minute, second, nanoseconds...)).AS_DATETIME2()
+}
+
+// DateTimeT creates new datetime2 literal from time.Time
+var DateTimeT = func(t time.Time) DateTimeExpression {
+	return CAST(jet.TimestampT(t)).AS_DATETIME2()
+}
+
+// Timestamp creates new timestamp literal
+var Timestamp = DateTime
+
+// TimestampT creates new timestamp literal from time.Time
+var TimestampT = DateTimeT
+
+// BoolParam creates named bool parameter, bound when prepared statement is executed
+var BoolParam = jet.BoolParam
+
+// IntParam creates named integer parameter, bound when prepared statement is executed
+var IntParam = jet.IntParam
+
+// FloatParam creates named float parameter, bound when prepared statement is executed
+var FloatParam = jet.FloatParam
+
+// StringParam creates named string parameter, bound when prepared statement is executed
+var StringParam = jet.StringParam
+
+// DateParam creates named date parameter, bound when prepared statement is executed
+var DateParam = jet.DateParam
+
+// TimeParam creates named time parameter, bound when prepared statement is executed
+var TimeParam = jet.TimeParam
+
+// DateTimeParam creates named datetime parameter, bound when prepared statement is executed
+var DateTimeParam = jet.TimestampParam
+
+// TimestampParam creates named timestamp parameter, bound when prepared statement is executed
+var TimestampParam = jet.TimestampParam
+
+// TimestampzParam creates named datetimeoffset parameter, bound when prepared statement is executed
+var TimestampzParam = jet.TimestampzParam
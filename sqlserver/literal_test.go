@@ -0,0 +1,38 @@
+package sqlserver
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBool(t *testing.T) {
+	assertClauseSerialize(t, Bool(false), `@p1`, false)
+}
+
+func TestInt(t *testing.T) {
+	assertClauseSerialize(t, Int(11), `@p1`, int64(11))
+}
+
+func TestFloat(t *testing.T) {
+	assertClauseSerialize(t, Float(12.34), `@p1`, float64(12.34))
+}
+
+func TestString(t *testing.T) {
+	assertClauseSerialize(t, String("Some text"), `@p1`, "Some text")
+}
+
+func TestDate(t *testing.T) {
+	assertClauseSerialize(t, Date(2014, time.January, 2), `CAST(@p1 AS DATE)`, "2014-01-02")
+	assertClauseSerialize(t, DateT(time.Now()), `CAST(@p1 AS DATE)`)
+}
+
+func TestTime(t *testing.T) {
+	assertClauseSerialize(t, Time(10, 15, 30), `CAST(@p1 AS TIME)`, "10:15:30")
+	assertClauseSerialize(t, TimeT(time.Now()), `CAST(@p1 AS TIME)`)
+}
+
+func TestDateTime(t *testing.T) {
+	assertClauseSerialize(t, DateTime(2010, time.March, 30, 10, 15, 30), `CAST(@p1 AS DATETIME2)`, "2010-03-30 10:15:30")
+	assertClauseSerialize(t, DateTimeT(time.Now()), `CAST(@p1 AS DATETIME2)`)
+	assertClauseSerialize(t, Timestamp(2010, time.March, 30, 10, 15, 30), `CAST(@p1 AS DATETIME2)`, "2010-03-30 10:15:30")
+}
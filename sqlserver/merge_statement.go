@@ -0,0 +1,253 @@
+package sqlserver
+
+import "github.com/go-jet/jet/internal/jet"
+
+// MergeStatement is interface for SQL Server MERGE statement
+type MergeStatement interface {
+	Statement
+
+	// USING specifies data source that is matched with the target table rows based on onCondition
+	USING(source ReadableTable, onCondition BoolExpression) MergeStatement
+
+	// WHEN_MATCHED specifies action on target rows that match source rows and satisfy optional condition
+	WHEN_MATCHED(condition ...BoolExpression) mergeMatchedAction
+	// WHEN_NOT_MATCHED specifies action on source rows that do not match target rows and satisfy optional condition
+	WHEN_NOT_MATCHED(condition ...BoolExpression) mergeNotMatchedAction
+	// WHEN_NOT_MATCHED_BY_SOURCE specifies action on target rows that do not match source rows
+	// and satisfy optional condition
+	WHEN_NOT_MATCHED_BY_SOURCE(condition ...BoolExpression) mergeMatchedAction
+
+	// OUTPUT returns projections of modified rows. Columns are read from INSERTED pseudo table,
+	// DELETED function can be used to read column values before modification.
+	OUTPUT(projections ...jet.Projection) MergeStatement
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() MergeStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() MergeStatement
+}
+
+type mergeMatchedAction interface {
+	THEN_UPDATE(column jet.Column, columns ...jet.Column) mergeSet
+	THEN_DELETE() MergeStatement
+}
+
+type mergeNotMatchedAction interface {
+	THEN_INSERT(columns ...jet.Column) mergeValues
+}
+
+type mergeSet interface {
+	SET(value interface{}, values ...interface{}) MergeStatement
+}
+
+type mergeValues interface {
+	VALUES(value interface{}, values ...interface{}) MergeStatement
+}
+
+type mergeStatementImpl struct {
+	jet.SerializerStatement
+
+	Merge  jet.ClauseStatementBegin
+	Using  clauseMergeUsing
+	When   clauseMergeWhen
+	Output clauseOutput
+}
+
+func newMergeStatement(table Table) MergeStatement {
+	newMerge := &mergeStatementImpl{}
+	newMerge.SerializerStatement = jet.NewStatementImpl(Dialect, jet.MergeStatementType, newMerge, &newMerge.Merge,
+		&newMerge.Using, &newMerge.When, &newMerge.Output)
+
+	newMerge.Merge.Name = "MERGE INTO"
+	newMerge.Merge.Tables = append(newMerge.Merge.Tables, table)
+	newMerge.Output.Prefix = inserted
+
+	return newMerge
+}
+
+func (m *mergeStatementImpl) USING(source ReadableTable, onCondition BoolExpression) MergeStatement {
+	m = m.edit()
+	m.Using.Source = source
+	m.Using.On = onCondition
+	return m
+}
+
+func (m *mergeStatementImpl) WHEN_MATCHED(condition ...BoolExpression) mergeMatchedAction {
+	m = m.edit()
+	return m.newWhen("MATCHED", condition)
+}
+
+func (m *mergeStatementImpl) WHEN_NOT_MATCHED(condition ...BoolExpression) mergeNotMatchedAction {
+	m = m.edit()
+	return m.newWhen("NOT MATCHED", condition)
+}
+
+func (m *mergeStatementImpl) WHEN_NOT_MATCHED_BY_SOURCE(condition ...BoolExpression) mergeMatchedAction {
+	m = m.edit()
+	return m.newWhen("NOT MATCHED BY SOURCE", condition)
+}
+
+func (m *mergeStatementImpl) OUTPUT(projections ...jet.Projection) MergeStatement {
+	m = m.edit()
+	m.Output.Projections = projections
+	return m
+}
+
+func (m *mergeStatementImpl) newWhen(match string, condition []BoolExpression) *mergeWhen {
+	when := &mergeWhen{statement: m, match: match}
+
+	if len(condition) > 0 {
+		when.condition = condition[0]
+	}
+
+	m.When.List = append(m.When.List, when)
+
+	return when
+}
+
+func (m *mergeStatementImpl) Clone() MergeStatement {
+	return m.clone()
+}
+
+func (m *mergeStatementImpl) Immutable() MergeStatement {
+	newMerge := m.clone()
+	jet.SetImmutable(newMerge.SerializerStatement)
+
+	return newMerge
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (m *mergeStatementImpl) edit() *mergeStatementImpl {
+	if !jet.IsImmutable(m.SerializerStatement) {
+		return m
+	}
+
+	return m.clone()
+}
+
+func (m *mergeStatementImpl) clone() *mergeStatementImpl {
+	newMerge := newMergeStatement(nil).(*mergeStatementImpl)
+	jet.CloneStatement(newMerge.SerializerStatement, m.SerializerStatement)
+
+	newMerge.When.List = nil
+
+	for _, when := range m.When.List {
+		newWhen := *when
+		newWhen.statement = newMerge
+		newMerge.When.List = append(newMerge.When.List, &newWhen)
+	}
+
+	return newMerge
+}
+
+type clauseMergeUsing struct {
+	Source ReadableTable
+	On     BoolExpression
+}
+
+func (u *clauseMergeUsing) Serialize(statementType jet.StatementType, out *jet.SQLBuilder) {
+	if u.Source == nil {
+		panic("jet: MERGE statement requires USING source")
+	}
+
+	if u.On == nil {
+		panic("jet: MERGE statement requires USING ON condition")
+	}
+
+	out.NewLine()
+	out.WriteString("USING")
+	out.IncreaseIdent()
+	jet.Serialize(u.Source, statementType, out)
+	out.DecreaseIdent()
+	out.WriteString("ON")
+	jet.Serialize(u.On, statementType, out)
+}
+
+type clauseMergeWhen struct {
+	List []*mergeWhen
+}
+
+func (w *clauseMergeWhen) Serialize(statementType jet.StatementType, out *jet.SQLBuilder) {
+	if len(w.List) == 0 {
+		panic("jet: MERGE statement requires at least one WHEN clause")
+	}
+
+	for _, when := range w.List {
+		when.Serialize(statementType, out)
+	}
+}
+
+type mergeWhen struct {
+	statement *mergeStatementImpl
+	match     string
+	condition BoolExpression
+
+	delete        bool
+	update        *jet.ClauseSet
+	insert        bool
+	insertColumns []jet.Column
+	insertValues  []jet.Serializer
+}
+
+func (w *mergeWhen) THEN_UPDATE(column jet.Column, columns ...jet.Column) mergeSet {
+	w.update = &jet.ClauseSet{Columns: jet.UnwindColumns(column, columns...)}
+	return w
+}
+
+func (w *mergeWhen) SET(value interface{}, values ...interface{}) MergeStatement {
+	w.update.Values = jet.UnwindRowFromValues(value, values)
+	return w.statement
+}
+
+func (w *mergeWhen) THEN_DELETE() MergeStatement {
+	w.delete = true
+	return w.statement
+}
+
+func (w *mergeWhen) THEN_INSERT(columns ...jet.Column) mergeValues {
+	w.insert = true
+	w.insertColumns = jet.UnwidColumnList(columns)
+	return w
+}
+
+func (w *mergeWhen) VALUES(value interface{}, values ...interface{}) MergeStatement {
+	w.insertValues = jet.UnwindRowFromValues(value, values)
+	return w.statement
+}
+
+func (w *mergeWhen) Serialize(statementType jet.StatementType, out *jet.SQLBuilder) {
+	out.NewLine()
+	out.WriteString("WHEN " + w.match)
+
+	if w.condition != nil {
+		out.WriteString("AND")
+		jet.Serialize(w.condition, statementType, out)
+	}
+
+	out.WriteString("THEN")
+
+	switch {
+	case w.update != nil:
+		out.WriteString("UPDATE")
+		out.IncreaseIdent()
+		w.update.Serialize(statementType, out)
+		out.DecreaseIdent()
+	case w.delete:
+		out.WriteString("DELETE")
+	case w.insert:
+		out.WriteString("INSERT")
+
+		if len(w.insertColumns) > 0 {
+			out.WriteString("(")
+			jet.SerializeColumnNames(w.insertColumns, out)
+			out.WriteString(")")
+		}
+
+		out.WriteString("VALUES (")
+		jet.SerializeClauseList(statementType, w.insertValues, out)
+		out.WriteString(")")
+	default:
+		panic("jet: WHEN clause of MERGE statement requires THEN action")
+	}
+}
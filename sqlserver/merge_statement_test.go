@@ -0,0 +1,80 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestInvalidMerge(t *testing.T) {
+	assertStatementSqlErr(t, table1.MERGE(), "jet: MERGE statement requires USING source")
+	assertStatementSqlErr(t, table1.MERGE().USING(table2, table1Col1.EQ(table2Col3)),
+		"jet: MERGE statement requires at least one WHEN clause")
+}
+
+func TestMerge(t *testing.T) {
+	assertStatementSql(t, table1.MERGE().
+		USING(table2, table1Col1.EQ(table2Col3)).
+		WHEN_MATCHED(table2ColBool.IS_TRUE()).THEN_DELETE().
+		WHEN_MATCHED().THEN_UPDATE(table1ColInt, table1ColFloat).SET(table2ColInt, table2ColFloat).
+		WHEN_NOT_MATCHED().THEN_INSERT(table1Col1, table1ColInt).VALUES(table2Col3, Int(0)).
+		WHEN_NOT_MATCHED_BY_SOURCE().THEN_DELETE().
+		OUTPUT(Raw("$action").AS("action"), table1Col1), `
+MERGE INTO db.table1
+USING db.table2 ON (table1.col1 = table2.col3)
+WHEN MATCHED AND table2.col_bool IS TRUE THEN DELETE
+WHEN MATCHED THEN UPDATE
+     SET col_int = table2.col_int, 
+         col_float = table2.col_float
+WHEN NOT MATCHED THEN INSERT (col1, col_int) VALUES (table2.col3, @p1)
+WHEN NOT MATCHED BY SOURCE THEN DELETE
+OUTPUT $action AS [action],
+     INSERTED.col1 AS [table1.col1];
+`, int64(0))
+}
+
+func TestMergeUsingSubQuery(t *testing.T) {
+	source := SELECT(table2Col3, table2ColInt).FROM(table2).AsTable("source")
+
+	assertStatementSql(t, table1.MERGE().
+		USING(source, table1Col1.EQ(table2Col3.From(source))).
+		WHEN_MATCHED().THEN_UPDATE(table1ColInt).SET(table2ColInt.From(source)), `
+MERGE INTO db.table1
+USING (
+          SELECT table2.col3 AS [table2.col3],
+               table2.col_int AS [table2.col_int]
+          FROM db.table2
+     ) AS source ON (table1.col1 = source.[table2.col3])
+WHEN MATCHED THEN UPDATE
+     SET col_int = source.[table2.col_int];
+`)
+}
+
+func TestMergeImmutable(t *testing.T) {
+	base := table1.MERGE().
+		USING(table2, table1Col1.EQ(table2Col3)).
+		WHEN_MATCHED().THEN_DELETE().
+		Immutable()
+
+	withInsert := base.WHEN_NOT_MATCHED().THEN_INSERT(table1Col1).VALUES(table2Col3)
+
+	assertStatementSql(t, base, `
+MERGE INTO db.table1
+USING db.table2 ON (table1.col1 = table2.col3)
+WHEN MATCHED THEN DELETE;
+`)
+
+	assertStatementSql(t, withInsert, `
+MERGE INTO db.table1
+USING db.table2 ON (table1.col1 = table2.col3)
+WHEN MATCHED THEN DELETE
+WHEN NOT MATCHED THEN INSERT (col1) VALUES (table2.col3);
+`)
+}
+
+func TestSequence(t *testing.T) {
+	sequence := NewSequence("dbo", "table1_seq")
+
+	assertClauseSerialize(t, sequence.NEXT_VALUE(), `NEXT VALUE FOR [dbo].[table1_seq]`)
+	assertStatementSql(t, SELECT(sequence.NEXT_VALUE().AS("next")), `
+SELECT NEXT VALUE FOR [dbo].[table1_seq] AS [next];
+`)
+}
@@ -0,0 +1,229 @@
+package sqlserver
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+)
+
+// Window function clauses
+var (
+	PARTITION_BY = jet.PARTITION_BY
+	ORDER_BY     = jet.ORDER_BY
+	UNBOUNDED    = jet.UNBOUNDED
+	CURRENT_ROW  = jet.CURRENT_ROW
+)
+
+// PRECEDING window frame clause
+func PRECEDING(offset interface{}) jet.FrameExtent {
+	return jet.PRECEDING(toJetFrameOffset(offset))
+}
+
+// FOLLOWING window frame clause
+func FOLLOWING(offset interface{}) jet.FrameExtent {
+	return jet.FOLLOWING(toJetFrameOffset(offset))
+}
+
+// Window is used to specify window reference from WINDOW clause
+var Window = jet.WindowName
+
+// SelectStatement is interface for SQL Server SELECT statement
+type SelectStatement interface {
+	Statement
+	jet.HasProjections
+	Expression
+
+	DISTINCT() SelectStatement
+	TOP(count int64) SelectStatement
+	FROM(table ReadableTable) SelectStatement
+	WHERE(expression BoolExpression) SelectStatement
+	GROUP_BY(groupByClauses ...jet.GroupByClause) SelectStatement
+	HAVING(boolExpression BoolExpression) SelectStatement
+	WINDOW(name string) windowExpand
+	ORDER_BY(orderByClauses ...jet.OrderByClause) SelectStatement
+	// LIMIT is serialized as OFFSET FETCH clause, and it requires ORDER BY clause
+	LIMIT(limit int64) SelectStatement
+	// OFFSET is serialized as OFFSET FETCH clause, and it requires ORDER BY clause
+	OFFSET(offset int64) SelectStatement
+
+	UNION(rhs SelectStatement) setStatement
+	UNION_ALL(rhs SelectStatement) setStatement
+	INTERSECT(rhs SelectStatement) setStatement
+	EXCEPT(rhs SelectStatement) setStatement
+
+	AsTable(alias string) SelectTable
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() SelectStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() SelectStatement
+}
+
+// SELECT creates new SelectStatement with list of projections
+func SELECT(projection Projection, projections ...Projection) SelectStatement {
+	return newSelectStatement(nil, append([]Projection{projection}, projections...))
+}
+
+// CountQuery derives statement that counts rows of statement: SELECT COUNT(*) AS "count" FROM (statement) AS "count_query".
+// TOP, ORDER BY, OFFSET and FETCH clauses of statement are dropped, so total count can be derived from page query.
+func CountQuery(statement SelectStatement) SelectStatement {
+	if selectStmt, ok := statement.(*selectStatementImpl); ok {
+		countedStmt := selectStmt.clone()
+		countedStmt.Select.Top = -1
+		countedStmt.OrderBy.List = nil
+		countedStmt.OffsetFetch.Offset = -1
+		countedStmt.OffsetFetch.Fetch = -1
+		statement = countedStmt
+	}
+
+	return SELECT(COUNT(STAR).AS("count")).FROM(statement.AsTable("count_query"))
+}
+
+func newSelectStatement(table ReadableTable, projections []Projection) SelectStatement {
+	newSelect := &selectStatementImpl{}
+	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect, &newSelect.Select,
+		&newSelect.From, &newSelect.Where, &newSelect.GroupBy, &newSelect.Having, &newSelect.Window, &newSelect.OrderBy,
+		&newSelect.OffsetFetch)
+
+	newSelect.Select.Projections = projections
+	newSelect.Select.Top = -1
+	newSelect.From.Table = table
+	newSelect.OffsetFetch.Offset = -1
+	newSelect.OffsetFetch.Fetch = -1
+
+	newSelect.setOperatorsImpl.parent = newSelect
+
+	return newSelect
+}
+
+type selectStatementImpl struct {
+	jet.ExpressionStatement
+	setOperatorsImpl
+
+	Select      clauseSelect
+	From        jet.ClauseFrom
+	Where       jet.ClauseWhere
+	GroupBy     jet.ClauseGroupBy
+	Having      jet.ClauseHaving
+	Window      jet.ClauseWindow
+	OrderBy     jet.ClauseOrderBy
+	OffsetFetch clauseOffsetFetch
+}
+
+func (s *selectStatementImpl) DISTINCT() SelectStatement {
+	s = s.edit()
+	s.Select.Distinct = true
+	return s
+}
+
+func (s *selectStatementImpl) TOP(count int64) SelectStatement {
+	s = s.edit()
+	s.Select.Top = count
+	return s
+}
+
+func (s *selectStatementImpl) FROM(table ReadableTable) SelectStatement {
+	s = s.edit()
+	s.From.Table = table
+	return s
+}
+
+func (s *selectStatementImpl) WHERE(condition BoolExpression) SelectStatement {
+	s = s.edit()
+	s.Where.Condition = condition
+	return s
+}
+
+func (s *selectStatementImpl) GROUP_BY(groupByClauses ...jet.GroupByClause) SelectStatement {
+	s = s.edit()
+	s.GroupBy.List = groupByClauses
+	return s
+}
+
+func (s *selectStatementImpl) HAVING(boolExpression BoolExpression) SelectStatement {
+	s = s.edit()
+	s.Having.Condition = boolExpression
+	return s
+}
+
+func (s *selectStatementImpl) WINDOW(name string) windowExpand {
+	s = s.edit()
+	s.Window.Definitions = append(s.Window.Definitions, jet.WindowDefinition{Name: name})
+	return windowExpand{selectStatement: s}
+}
+
+func (s *selectStatementImpl) ORDER_BY(orderByClauses ...jet.OrderByClause) SelectStatement {
+	s = s.edit()
+	s.OrderBy.List = orderByClauses
+	return s
+}
+
+func (s *selectStatementImpl) LIMIT(limit int64) SelectStatement {
+	s = s.edit()
+	s.OffsetFetch.Fetch = limit
+	return s
+}
+
+func (s *selectStatementImpl) OFFSET(offset int64) SelectStatement {
+	s = s.edit()
+	s.OffsetFetch.Offset = offset
+	return s
+}
+
+func (s *selectStatementImpl) AsTable(alias string) SelectTable {
+	return newSelectTable(s, alias)
+}
+
+func (s *selectStatementImpl) Clone() SelectStatement {
+	return s.clone()
+}
+
+func (s *selectStatementImpl) Immutable() SelectStatement {
+	newSelect := s.clone()
+	jet.SetImmutable(newSelect.ExpressionStatement)
+
+	return newSelect
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (s *selectStatementImpl) edit() *selectStatementImpl {
+	if !jet.IsImmutable(s.ExpressionStatement) {
+		return s
+	}
+
+	return s.clone()
+}
+
+func (s *selectStatementImpl) clone() *selectStatementImpl {
+	newSelect := newSelectStatement(nil, nil).(*selectStatementImpl)
+	jet.CloneStatement(newSelect.ExpressionStatement, s.ExpressionStatement)
+
+	return newSelect
+}
+
+//-----------------------------------------------------
+
+type windowExpand struct {
+	selectStatement *selectStatementImpl
+}
+
+func (w windowExpand) AS(window ...jet.Window) SelectStatement {
+	if len(window) == 0 {
+		return w.selectStatement
+	}
+	windowsDefinition := w.selectStatement.Window.Definitions
+	windowsDefinition[len(windowsDefinition)-1].Window = window[0]
+	return w.selectStatement
+}
+
+func toJetFrameOffset(offset interface{}) jet.Serializer {
+	if offset == UNBOUNDED {
+		return jet.UNBOUNDED
+	}
+
+	// check for interval expression
+	//if exp, ok := offset.(Expression); ok {
+	//	return exp
+	//}
+
+	return jet.FixedLiteral(offset)
+}
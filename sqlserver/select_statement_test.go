@@ -0,0 +1,108 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestInvalidSelect(t *testing.T) {
+	assertStatementSqlErr(t, SELECT(nil), "jet: Projection is nil")
+}
+
+func TestSelectFrom(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt, table2ColFloat).FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))), `
+SELECT table1.col_int AS [table1.col_int],
+     table2.col_float AS [table2.col_float]
+FROM db.table1
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int);
+`)
+}
+
+func TestSelectTop(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt).DISTINCT().TOP(5).FROM(table1).ORDER_BY(table1ColInt), `
+SELECT DISTINCT TOP (@p1) table1.col_int AS [table1.col_int]
+FROM db.table1
+ORDER BY table1.col_int;
+`, int64(5))
+}
+
+func TestCountQuery(t *testing.T) {
+	top := SELECT(table1ColInt).TOP(5).FROM(table1).WHERE(table1ColInt.GT(Int(10))).ORDER_BY(table1ColInt)
+
+	assertStatementSql(t, CountQuery(top), `
+SELECT COUNT(*) AS [count]
+FROM (
+          SELECT table1.col_int AS [table1.col_int]
+          FROM db.table1
+          WHERE table1.col_int > @p1
+     ) AS count_query;
+`, int64(10))
+
+	page := SELECT(table1ColInt).FROM(table1).ORDER_BY(table1ColInt).LIMIT(10).OFFSET(20)
+
+	assertStatementSql(t, CountQuery(page), `
+SELECT COUNT(*) AS [count]
+FROM (
+          SELECT table1.col_int AS [table1.col_int]
+          FROM db.table1
+     ) AS count_query;
+`)
+
+	assertStatementSql(t, top, `
+SELECT TOP (@p1) table1.col_int AS [table1.col_int]
+FROM db.table1
+WHERE table1.col_int > @p2
+ORDER BY table1.col_int;
+`, int64(5), int64(10))
+}
+
+func TestSelectWhereGroupByOrderByLimitOffset(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt, COUNT(table1Col1)).
+		FROM(table1).
+		WHERE(table1ColBool.EQ(Bool(true))).
+		GROUP_BY(table1ColInt).
+		HAVING(COUNT(table1Col1).GT(Int(1))).
+		ORDER_BY(table1ColInt.DESC()).
+		LIMIT(10).
+		OFFSET(20), `
+SELECT table1.col_int AS [table1.col_int],
+     COUNT(table1.col1)
+FROM db.table1
+WHERE table1.col_bool = @p1
+GROUP BY table1.col_int
+HAVING COUNT(table1.col1) > @p2
+ORDER BY table1.col_int DESC
+OFFSET @p3 ROWS
+FETCH NEXT @p4 ROWS ONLY;
+`, true, int64(1), int64(20), int64(10))
+}
+
+func TestSelectLimitOnly(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).ORDER_BY(table1ColInt).LIMIT(10), `
+SELECT table1.col_int AS [table1.col_int]
+FROM db.table1
+ORDER BY table1.col_int
+OFFSET @p1 ROWS
+FETCH NEXT @p2 ROWS ONLY;
+`, int64(0), int64(10))
+}
+
+func TestSelectOffsetOnly(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).ORDER_BY(table1ColInt).OFFSET(10), `
+SELECT table1.col_int AS [table1.col_int]
+FROM db.table1
+ORDER BY table1.col_int
+OFFSET @p1 ROWS;
+`, int64(10))
+}
+
+func TestSelectSubQuery(t *testing.T) {
+	subQuery := SELECT(table1ColInt).FROM(table1).AsTable("sub")
+
+	assertStatementSql(t, SELECT(table1ColInt.From(subQuery)).FROM(subQuery), `
+SELECT sub.[table1.col_int] AS [table1.col_int]
+FROM (
+          SELECT table1.col_int AS [table1.col_int]
+          FROM db.table1
+     ) AS sub;
+`)
+}
@@ -0,0 +1,41 @@
+package sqlserver
+
+import "strings"
+
+// Sequence is interface for SQL Server sequences
+type Sequence interface {
+	SchemaName() string
+	SequenceName() string
+
+	// NEXT_VALUE advances sequence and returns new value
+	NEXT_VALUE() IntegerExpression
+}
+
+// NewSequence creates new sequence with schema name and sequence name
+func NewSequence(schemaName, name string) Sequence {
+	return &sequenceImpl{
+		schemaName: schemaName,
+		name:       name,
+	}
+}
+
+type sequenceImpl struct {
+	schemaName string
+	name       string
+}
+
+func (s *sequenceImpl) SchemaName() string {
+	return s.schemaName
+}
+
+func (s *sequenceImpl) SequenceName() string {
+	return s.name
+}
+
+func (s *sequenceImpl) NEXT_VALUE() IntegerExpression {
+	return IntExp(Raw("NEXT VALUE FOR " + quoteIdentifier(s.schemaName) + "." + quoteIdentifier(s.name)))
+}
+
+func quoteIdentifier(name string) string {
+	return "[" + strings.Replace(name, "]", "]]", -1) + "]"
+}
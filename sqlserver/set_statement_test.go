@@ -0,0 +1,58 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestSelectSets(t *testing.T) {
+	select1 := SELECT(table1ColBool).FROM(table1)
+	select2 := SELECT(table2ColBool).FROM(table2)
+
+	assertStatementSql(t, select1.UNION(select2), `
+(
+     SELECT table1.col_bool AS [table1.col_bool]
+     FROM db.table1
+)
+UNION
+(
+     SELECT table2.col_bool AS [table2.col_bool]
+     FROM db.table2
+);
+`)
+	assertStatementSql(t, select1.UNION_ALL(select2).ORDER_BY(table1ColBool).LIMIT(1).OFFSET(2), `
+(
+     SELECT table1.col_bool AS [table1.col_bool]
+     FROM db.table1
+)
+UNION ALL
+(
+     SELECT table2.col_bool AS [table2.col_bool]
+     FROM db.table2
+)
+ORDER BY [table1.col_bool]
+OFFSET @p1 ROWS
+FETCH NEXT @p2 ROWS ONLY;
+`, int64(2), int64(1))
+	assertStatementSql(t, INTERSECT(select1, select2), `
+(
+     SELECT table1.col_bool AS [table1.col_bool]
+     FROM db.table1
+)
+INTERSECT
+(
+     SELECT table2.col_bool AS [table2.col_bool]
+     FROM db.table2
+);
+`)
+	assertStatementSql(t, select1.EXCEPT(select2), `
+(
+     SELECT table1.col_bool AS [table1.col_bool]
+     FROM db.table1
+)
+EXCEPT
+(
+     SELECT table2.col_bool AS [table2.col_bool]
+     FROM db.table2
+);
+`)
+}
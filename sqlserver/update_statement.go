@@ -0,0 +1,95 @@
+package sqlserver
+
+import "github.com/go-jet/jet/internal/jet"
+
+// UpdateStatement is interface of SQL UPDATE statement
+type UpdateStatement interface {
+	jet.Statement
+
+	SET(value interface{}, values ...interface{}) UpdateStatement
+	MODEL(data interface{}) UpdateStatement
+
+	WHERE(expression BoolExpression) UpdateStatement
+	// OUTPUT returns projections of updated rows. Columns are read from INSERTED pseudo table,
+	// DELETED function can be used to read column values before update.
+	OUTPUT(projections ...jet.Projection) UpdateStatement
+
+	// Clone returns copy of the statement, that can be modified without changing the statement
+	Clone() UpdateStatement
+	// Immutable returns copy of the statement in immutable mode. In immutable mode every builder method
+	// returns modified copy of the statement, and the statement itself stays unchanged.
+	Immutable() UpdateStatement
+}
+
+type updateStatementImpl struct {
+	jet.SerializerStatement
+
+	Update jet.ClauseUpdate
+	Set    jet.ClauseSet
+	Output clauseOutput
+	Where  jet.ClauseWhere
+}
+
+func newUpdateStatement(table Table, columns []jet.Column) UpdateStatement {
+	update := &updateStatementImpl{}
+	update.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, update, &update.Update,
+		&update.Set, &update.Output, &update.Where)
+
+	update.Update.Table = table
+	update.Set.Columns = columns
+	update.Output.Prefix = inserted
+	update.Where.Mandatory = true
+
+	return update
+}
+
+func (u *updateStatementImpl) SET(value interface{}, values ...interface{}) UpdateStatement {
+	u = u.edit()
+	u.Set.Values = jet.UnwindRowFromValues(value, values)
+	return u
+}
+
+func (u *updateStatementImpl) MODEL(data interface{}) UpdateStatement {
+	u = u.edit()
+	u.Set.Values = jet.UnwindRowFromModel(u.Set.Columns, data)
+	return u
+}
+
+func (u *updateStatementImpl) WHERE(expression BoolExpression) UpdateStatement {
+	u = u.edit()
+	u.Where.Condition = expression
+	return u
+}
+
+func (u *updateStatementImpl) OUTPUT(projections ...jet.Projection) UpdateStatement {
+	u = u.edit()
+	u.Output.Projections = projections
+	return u
+}
+
+func (u *updateStatementImpl) Clone() UpdateStatement {
+	return u.clone()
+}
+
+func (u *updateStatementImpl) Immutable() UpdateStatement {
+	newUpdate := u.clone()
+	jet.SetImmutable(newUpdate.SerializerStatement)
+
+	return newUpdate
+}
+
+// edit returns statement builder methods should modify, which is a copy of the statement in immutable mode
+func (u *updateStatementImpl) edit() *updateStatementImpl {
+	if !jet.IsImmutable(u.SerializerStatement) {
+		return u
+	}
+
+	return u.clone()
+}
+
+func (u *updateStatementImpl) clone() *updateStatementImpl {
+	newUpdate := newUpdateStatement(nil, nil).(*updateStatementImpl)
+	jet.CloneStatement(newUpdate.SerializerStatement, u.SerializerStatement)
+
+	return newUpdate
+}
@@ -0,0 +1,30 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestUpdate(t *testing.T) {
+	assertStatementSql(t, table1.UPDATE(table1ColInt, table1ColFloat).
+		SET(1, 2.2).
+		WHERE(table1Col1.EQ(Int(3))), `
+UPDATE db.table1
+SET col_int = @p1, 
+    col_float = @p2
+WHERE table1.col1 = @p3;
+`, 1, 2.2, int64(3))
+}
+
+func TestUpdateOutput(t *testing.T) {
+	assertStatementSql(t, table1.UPDATE(table1ColInt).
+		SET(1).
+		WHERE(table1Col1.EQ(Int(3))).
+		OUTPUT(table1Col1, table1ColInt, DELETED(table1ColInt).AS("old_int")), `
+UPDATE db.table1
+SET col_int = @p1
+OUTPUT INSERTED.col1 AS [table1.col1],
+     INSERTED.col_int AS [table1.col_int],
+     DELETED.col_int AS [old_int]
+WHERE table1.col1 = @p2;
+`, 1, int64(3))
+}
@@ -0,0 +1,71 @@
+package mysql
+
+import (
+	"database/sql"
+	. "github.com/go-jet/jet/mysql"
+	"github.com/go-jet/jet/qrm"
+	"github.com/go-jet/jet/tests/.gentestdata/mysql/dvds/model"
+	. "github.com/go-jet/jet/tests/.gentestdata/mysql/dvds/table"
+	"github.com/go-jet/jet/tests/dbconfig"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func openMultiStatementsDB(t *testing.T) *sql.DB {
+	multiStatementsDB, err := sql.Open("mysql", dbconfig.MySQLConnectionString+"?multiStatements=true&interpolateParams=true")
+	assert.NilError(t, err)
+
+	return multiStatementsDB
+}
+
+func TestBatchQuery(t *testing.T) {
+	multiStatementsDB := openMultiStatementsDB(t)
+	defer multiStatementsDB.Close()
+
+	var actor model.Actor
+	var films []model.Film
+	var count struct {
+		Count int64
+	}
+
+	err := BATCH(
+		SELECT(Actor.AllColumns).FROM(Actor).WHERE(Actor.ActorID.EQ(Int(2))),
+		SELECT(Film.AllColumns).FROM(Film).ORDER_BY(Film.FilmID).LIMIT(3),
+		SELECT(COUNT(STAR).AS("count")).FROM(Language),
+	).Query(multiStatementsDB, &actor, &films, &count)
+
+	assert.NilError(t, err)
+	assert.Equal(t, actor.FirstName, "NICK")
+	assert.Equal(t, len(films), 3)
+	assert.Equal(t, films[0].Title, "ACADEMY DINOSAUR")
+	assert.Equal(t, count.Count, int64(6))
+}
+
+func TestBatchQueryNoRows(t *testing.T) {
+	multiStatementsDB := openMultiStatementsDB(t)
+	defer multiStatementsDB.Close()
+
+	var actor model.Actor
+	var films []model.Film
+
+	err := BATCH(
+		SELECT(Actor.AllColumns).FROM(Actor).WHERE(Actor.ActorID.EQ(Int(-1))),
+		SELECT(Film.AllColumns).FROM(Film).ORDER_BY(Film.FilmID).LIMIT(3),
+	).Query(multiStatementsDB, &actor, &films)
+
+	assert.Equal(t, err, qrm.ErrNoRows)
+	assert.Equal(t, len(films), 3)
+}
+
+func TestBatchQueryMissingResultSet(t *testing.T) {
+	multiStatementsDB := openMultiStatementsDB(t)
+	defer multiStatementsDB.Close()
+
+	var actors, actors2 []model.Actor
+
+	err := BATCH(
+		SELECT(Actor.AllColumns).FROM(Actor).WHERE(Actor.ActorID.EQ(Int(2))),
+	).Query(multiStatementsDB, &actors, &actors2)
+
+	assert.Error(t, err, "jet: query returned 1 result sets, expected 2")
+}
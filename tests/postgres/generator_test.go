@@ -93,6 +93,42 @@ func TestGenerator(t *testing.T) {
 	assert.NilError(t, err)
 }
 
+func TestGenerateSchemas(t *testing.T) {
+	err := postgres.GenerateSchemas(genTestDir2, postgres.DBConnection{
+		Host:     dbconfig.Host,
+		Port:     dbconfig.Port,
+		User:     dbconfig.User,
+		Password: dbconfig.Password,
+		SslMode:  "disable",
+		Params:   "",
+
+		DBName: dbconfig.DBName,
+	}, "dvds", "test_*")
+
+	assert.NilError(t, err)
+
+	assertGeneratedFiles(t)
+
+	testSampleTableFiles, err := ioutil.ReadDir("./.gentestdata2/jetdb/test_sample/table")
+	assert.NilError(t, err)
+	assert.Assert(t, len(testSampleTableFiles) > 0)
+
+	err = postgres.GenerateSchemas(genTestDir2, postgres.DBConnection{
+		Host:     dbconfig.Host,
+		Port:     dbconfig.Port,
+		User:     dbconfig.User,
+		Password: dbconfig.Password,
+		SslMode:  "disable",
+
+		DBName: dbconfig.DBName,
+	}, "non_existing_*")
+
+	assert.Error(t, err, "jet: no schema matching non_existing_* found")
+
+	err = os.RemoveAll(genTestDir2)
+	assert.NilError(t, err)
+}
+
 func assertGeneratedFiles(t *testing.T) {
 	// Table SQL Builder files
 	tableSQLBuilderFiles, err := ioutil.ReadDir("./.gentestdata2/jetdb/dvds/table")
@@ -120,6 +156,22 @@ func assertGeneratedFiles(t *testing.T) {
 	testutils.AssertFileNamesEqual(t, enumFiles, "mpaa_rating.go")
 	testutils.AssertFileContent(t, "./.gentestdata2/jetdb/dvds/enum/mpaa_rating.go", "\npackage enum", mpaaRatingEnumFile)
 
+	// Function SQL Builder files
+	functionFiles, err := ioutil.ReadDir("./.gentestdata2/jetdb/dvds/function")
+	assert.NilError(t, err)
+
+	testutils.AssertFileNamesEqual(t, functionFiles, "film_in_stock.go", "film_not_in_stock.go", "rewards_report.go")
+	testutils.AssertFileContent(t, "./.gentestdata2/jetdb/dvds/function/film_in_stock.go", "\npackage function", filmInStockFunctionFile)
+
+	// Sequence SQL Builder files
+	sequenceFiles, err := ioutil.ReadDir("./.gentestdata2/jetdb/dvds/sequence")
+	assert.NilError(t, err)
+
+	testutils.AssertFileNamesEqual(t, sequenceFiles, "actor_actor_id_seq.go", "address_address_id_seq.go",
+		"category_category_id_seq.go", "city_city_id_seq.go", "country_country_id_seq.go", "customer_customer_id_seq.go",
+		"film_film_id_seq.go", "inventory_inventory_id_seq.go", "language_language_id_seq.go", "payment_payment_id_seq.go",
+		"rental_rental_id_seq.go", "staff_staff_id_seq.go", "store_store_id_seq.go")
+
 	// Model files
 	modelFiles, err := ioutil.ReadDir("./.gentestdata2/jetdb/dvds/model")
 	assert.NilError(t, err)
@@ -128,7 +180,8 @@ func assertGeneratedFiles(t *testing.T) {
 		"customer.go", "film.go", "film_actor.go", "film_category.go", "inventory.go", "language.go",
 		"payment.go", "rental.go", "staff.go", "store.go", "mpaa_rating.go",
 		"actor_info.go", "film_list.go", "nicer_but_slower_film_list.go", "sales_by_film_category.go",
-		"customer_list.go", "sales_by_store.go", "staff_list.go")
+		"customer_list.go", "sales_by_store.go", "staff_list.go",
+		"film_in_stock.go", "film_not_in_stock.go", "rewards_report.go")
 
 	testutils.AssertFileContent(t, "./.gentestdata2/jetdb/dvds/model/actor.go", "\npackage model", actorModelFile)
 }
@@ -153,6 +206,48 @@ var MpaaRating = &struct {
 }
 `
 
+var filmInStockFunctionFile = `
+package function
+
+import (
+	"github.com/go-jet/jet/postgres"
+)
+
+type FilmInStockTable struct {
+	postgres.TableFunction
+
+	//Columns
+	PFilmCount postgres.ColumnInteger
+
+	AllColumns postgres.ColumnList
+}
+
+// assigns alias to FilmInStockTable
+func (a *FilmInStockTable) AS(alias string) *FilmInStockTable {
+	a.TableFunction.AS(alias)
+
+	return a
+}
+
+// FilmInStock creates new call of dvds.film_in_stock table function
+func FilmInStock(pFilmID postgres.IntegerExpression, pStoreID postgres.IntegerExpression) *FilmInStockTable {
+	var (
+		PFilmCountColumn = postgres.IntegerColumn("p_film_count")
+	)
+
+	return &FilmInStockTable{
+		TableFunction: postgres.NewTableFunction("dvds", "film_in_stock",
+			[]postgres.Expression{pFilmID, pStoreID},
+			PFilmCountColumn),
+
+		//Columns
+		PFilmCount: PFilmCountColumn,
+
+		AllColumns: postgres.ColumnList{PFilmCountColumn},
+	}
+}
+`
+
 var actorSQLBuilderFile = `
 package table
 
@@ -282,3 +282,70 @@ func TestInsertWithExecContext(t *testing.T) {
 
 	assert.Error(t, err, "context deadline exceeded")
 }
+
+func TestInsertExecReturning(t *testing.T) {
+	links := []model.Link{
+		{URL: "http://www.google.com", Name: "Google"},
+		{URL: "http://www.yahoo.com", Name: "Yahoo"},
+	}
+
+	rowsAffected, err := Link.INSERT(Link.URL, Link.Name).
+		MODELS(links).
+		RETURNING(Link.ID).
+		ExecReturning(context.Background(), db, &links)
+
+	assert.NilError(t, err)
+	assert.Equal(t, rowsAffected, int64(2))
+	assert.Assert(t, links[0].ID > 0)
+	assert.Assert(t, links[1].ID > links[0].ID)
+	assert.Equal(t, links[1].Name, "Yahoo")
+
+	var deleted []model.Link
+
+	rowsAffected, err = Link.DELETE().
+		WHERE(Link.ID.EQ(Int(int64(links[0].ID))).OR(Link.ID.EQ(Int(int64(links[1].ID))))).
+		RETURNING(Link.AllColumns).
+		ExecReturning(context.Background(), db, &deleted)
+
+	assert.NilError(t, err)
+	assert.Equal(t, rowsAffected, int64(2))
+	assert.Equal(t, len(deleted), 2)
+	assert.Equal(t, deleted[0].URL, "http://www.google.com")
+
+	rowsAffected, err = Link.DELETE().
+		WHERE(Link.ID.EQ(Int(int64(links[0].ID)))).
+		ExecReturning(context.Background(), db, &deleted)
+
+	assert.NilError(t, err)
+	assert.Equal(t, rowsAffected, int64(0))
+}
+
+func TestExecReturningSkippedRow(t *testing.T) {
+	links := []model.Link{
+		{URL: "http://www.google.com", Name: "Google"},
+		{URL: "http://www.yahoo.com", Name: "Yahoo"},
+	}
+
+	_, err := Link.INSERT(Link.URL, Link.Name).
+		MODELS(links).
+		RETURNING(Link.ID).
+		ExecReturning(context.Background(), db, &links)
+	assert.NilError(t, err)
+
+	defer Link.DELETE().WHERE(Link.ID.IN(Int(int64(links[0].ID)), Int(int64(links[1].ID)))).Exec(db)
+
+	// the second model does not exist, so the statement returns rows only for the first and the third model
+	updated := []model.Link{links[0], {ID: -1}, links[1]}
+
+	rowsAffected, err := Link.UPDATE(Link.Name).
+		SET(String("Updated")).
+		WHERE(Link.ID.IN(Int(int64(links[0].ID)), Int(-1), Int(int64(links[1].ID)))).
+		RETURNING(Link.ID, Link.Name).
+		ExecReturning(context.Background(), db, &updated)
+
+	assert.Error(t, err, "jet: statement returned 2 rows, but destination slice has 3 elements")
+	assert.Equal(t, rowsAffected, int64(2))
+	assert.Equal(t, updated[0].Name, "Google")
+	assert.Equal(t, updated[1].ID, int32(-1))
+	assert.Equal(t, updated[2].Name, "Yahoo")
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"github.com/go-jet/jet/internal/jet"
+	. "github.com/go-jet/jet/postgres"
+	"github.com/go-jet/jet/tests/.gentestdata/jetdb/dvds/model"
+	. "github.com/go-jet/jet/tests/.gentestdata/jetdb/dvds/table"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestPreparedStatement(t *testing.T) {
+	stmt := SELECT(Film.FilmID, Film.Title).
+		FROM(Film).
+		WHERE(Film.Length.GT_EQ(IntParam("min_len")).AND(Film.Rating.EQ(StringParam("rating")))).
+		ORDER_BY(Film.FilmID).
+		LIMIT(3)
+
+	prepared, err := stmt.Prepare(db)
+	assert.NilError(t, err)
+	defer prepared.Close()
+
+	query, args := prepared.Sql()
+	assert.Equal(t, query, `
+SELECT film.film_id AS "film.film_id",
+     film.title AS "film.title"
+FROM dvds.film
+WHERE (film.length >= $1) AND (film.rating = $2)
+ORDER BY film.film_id
+LIMIT $3;
+`)
+	assert.DeepEqual(t, args, []interface{}{jet.NamedArgument{Name: "min_len"}, jet.NamedArgument{Name: "rating"}, int64(3)})
+
+	var films []model.Film
+
+	err = prepared.Query(map[string]interface{}{"min_len": 180, "rating": "R"}, &films)
+	assert.NilError(t, err)
+	assert.Equal(t, len(films), 3)
+
+	for _, film := range films {
+		assert.Assert(t, film.Title != "")
+	}
+
+	type filmParams struct {
+		MinLen int64
+		Rating string
+	}
+
+	var longFilms []model.Film
+
+	err = prepared.Query(filmParams{MinLen: 185, Rating: "PG"}, &longFilms)
+	assert.NilError(t, err)
+	assert.Assert(t, len(longFilms) > 0)
+
+	err = prepared.Query(map[string]interface{}{"min_len": 180}, &films)
+	assert.Error(t, err, "jet: missing value for parameter rating")
+}
+
+func TestPreparedStatementCache(t *testing.T) {
+	cache := NewStatementCache(db, 1)
+	defer cache.Close()
+
+	stmt := SELECT(Film.FilmID).FROM(Film).WHERE(Film.Length.GT(IntParam("min_len"))).ORDER_BY(Film.FilmID)
+
+	prepared, err := stmt.Prepare(cache)
+	assert.NilError(t, err)
+
+	preparedAgain, err := stmt.Prepare(cache)
+	assert.NilError(t, err)
+	assert.Equal(t, cache.Len(), 1)
+
+	other, err := SELECT(Actor.ActorID).FROM(Actor).Prepare(cache)
+	assert.NilError(t, err)
+
+	var actors []model.Actor
+	assert.NilError(t, other.Query(nil, &actors))
+	assert.Equal(t, cache.Len(), 1)
+
+	var films []model.Film
+	assert.NilError(t, prepared.Query(map[string]interface{}{"min_len": 180}, &films))
+	assert.NilError(t, preparedAgain.Query(map[string]interface{}{"min_len": 180}, &films))
+	assert.Assert(t, len(films) > 0)
+}
+
+func TestNamedParamWithoutPrepare(t *testing.T) {
+	var films []model.Film
+
+	err := SELECT(Film.FilmID).FROM(Film).WHERE(Film.Length.GT(IntParam("min_len"))).Query(db, &films)
+
+	assert.ErrorContains(t, err, "jet: parameter min_len is not bound")
+}
@@ -0,0 +1,68 @@
+//go:build go1.18
+// +build go1.18
+
+package postgres
+
+import (
+	"context"
+	. "github.com/go-jet/jet/postgres"
+	"github.com/go-jet/jet/qrm"
+	"github.com/go-jet/jet/tests/.gentestdata/jetdb/dvds/model"
+	. "github.com/go-jet/jet/tests/.gentestdata/jetdb/dvds/table"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestQueryAll(t *testing.T) {
+	actors, err := qrm.QueryAll[model.Actor](context.Background(), db,
+		SELECT(Actor.AllColumns).FROM(Actor).ORDER_BY(Actor.ActorID).LIMIT(3),
+	)
+
+	assert.NilError(t, err)
+	assert.Equal(t, len(actors), 3)
+	assert.Equal(t, actors[1].FirstName, "Nick")
+
+	actors, err = qrm.QueryAll[model.Actor](context.Background(), db,
+		SELECT(Actor.AllColumns).FROM(Actor).WHERE(Actor.ActorID.EQ(Int(-1))),
+	)
+
+	assert.NilError(t, err)
+	assert.Equal(t, len(actors), 0)
+}
+
+func TestQueryOne(t *testing.T) {
+	actor, err := qrm.QueryOne[model.Actor](context.Background(), db,
+		SELECT(Actor.AllColumns).FROM(Actor).WHERE(Actor.ActorID.EQ(Int(2))),
+	)
+
+	assert.NilError(t, err)
+	assert.Equal(t, actor.FirstName, "Nick")
+
+	actorPtr, err := qrm.QueryOne[*model.Actor](context.Background(), db,
+		SELECT(Actor.AllColumns).FROM(Actor).WHERE(Actor.ActorID.EQ(Int(2))),
+	)
+
+	assert.NilError(t, err)
+	assert.Equal(t, actorPtr.LastName, "Wahlberg")
+
+	_, err = qrm.QueryOne[model.Actor](context.Background(), db,
+		SELECT(Actor.AllColumns).FROM(Actor).WHERE(Actor.ActorID.EQ(Int(-1))),
+	)
+
+	assert.Equal(t, err, qrm.ErrNoRows)
+}
+
+func TestQueryScalar(t *testing.T) {
+	count, err := qrm.QueryScalar[int64](context.Background(), db,
+		SELECT(COUNT(Actor.ActorID)).FROM(Actor),
+	)
+
+	assert.NilError(t, err)
+	assert.Equal(t, count, int64(200))
+
+	_, err = qrm.QueryScalar[int64](context.Background(), db,
+		SELECT(Actor.ActorID).FROM(Actor).LIMIT(2),
+	)
+
+	assert.Error(t, err, "jet: scalar query returned more than one row")
+}
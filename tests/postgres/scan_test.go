@@ -20,31 +20,31 @@ var query = Inventory.
 func TestScanToInvalidDestination(t *testing.T) {
 
 	t.Run("nil dest", func(t *testing.T) {
-		testutils.AssertQueryPanicErr(t, query, db, nil, "jet: destination is nil")
+		testutils.AssertQueryErr(t, query, db, nil, "jet: destination is nil")
 	})
 
 	t.Run("struct dest", func(t *testing.T) {
-		testutils.AssertQueryPanicErr(t, query, db, struct{}{}, "jet: destination has to be a pointer to slice or pointer to struct")
+		testutils.AssertQueryErr(t, query, db, struct{}{}, "jet: destination has to be a pointer to slice, pointer to struct or pointer to map")
 	})
 
 	t.Run("slice dest", func(t *testing.T) {
-		testutils.AssertQueryPanicErr(t, query, db, []struct{}{}, "jet: destination has to be a pointer to slice or pointer to struct")
+		testutils.AssertQueryErr(t, query, db, []struct{}{}, "jet: destination has to be a pointer to slice, pointer to struct or pointer to map")
 	})
 
 	t.Run("slice of pointers to pointer dest", func(t *testing.T) {
-		testutils.AssertQueryPanicErr(t, query, db, []**struct{}{}, "jet: destination has to be a pointer to slice or pointer to struct")
+		testutils.AssertQueryErr(t, query, db, []**struct{}{}, "jet: destination has to be a pointer to slice, pointer to struct or pointer to map")
 	})
 
 	t.Run("map dest", func(t *testing.T) {
-		testutils.AssertQueryPanicErr(t, query, db, &map[string]string{}, "jet: destination has to be a pointer to slice or pointer to struct")
+		testutils.AssertQueryErr(t, query, db, &map[string]string{}, "jet: can't set int32 to string")
 	})
 
 	t.Run("map dest", func(t *testing.T) {
-		testutils.AssertQueryPanicErr(t, query, db, []map[string]string{}, "jet: destination has to be a pointer to slice or pointer to struct")
+		testutils.AssertQueryErr(t, query, db, []map[string]string{}, "jet: destination has to be a pointer to slice, pointer to struct or pointer to map")
 	})
 
 	t.Run("map dest", func(t *testing.T) {
-		testutils.AssertQueryPanicErr(t, query, db, &[]map[string]string{}, "jet: unsupported slice element type")
+		testutils.AssertQueryErr(t, query, db, &[]map[string]string{}, "jet: unsupported slice element type")
 	})
 }
 
@@ -136,7 +136,7 @@ func TestScanToStruct(t *testing.T) {
 			Inventory **model.Inventory
 		}{}
 
-		testutils.AssertQueryPanicErr(t, query, db, &dest, "jet: unsupported dest type: Inventory **model.Inventory")
+		testutils.AssertQueryErr(t, query, db, &dest, "jet: unsupported dest type: Inventory **model.Inventory")
 	})
 
 	t.Run("invalid dest 2", func(t *testing.T) {
@@ -144,7 +144,7 @@ func TestScanToStruct(t *testing.T) {
 			Inventory ***model.Inventory
 		}{}
 
-		testutils.AssertQueryPanicErr(t, query, db, &dest, "jet: unsupported dest type: Inventory ***model.Inventory")
+		testutils.AssertQueryErr(t, query, db, &dest, "jet: unsupported dest type: Inventory ***model.Inventory")
 	})
 
 	t.Run("custom struct", func(t *testing.T) {
@@ -186,7 +186,7 @@ func TestScanToStruct(t *testing.T) {
 
 		dest := Inventory{}
 
-		testutils.AssertQueryPanicErr(t, query, db, &dest, `jet: Scan: unable to scan type int32 into UUID,  at 'InventoryID uuid.UUID' of type postgres.Inventory`)
+		testutils.AssertQueryErr(t, query, db, &dest, `jet: Scan: unable to scan type int32 into UUID,  at 'InventoryID uuid.UUID' of type postgres.Inventory`)
 	})
 
 	t.Run("type mismatch base type", func(t *testing.T) {
@@ -197,7 +197,90 @@ func TestScanToStruct(t *testing.T) {
 
 		dest := []Inventory{}
 
-		testutils.AssertQueryPanicErr(t, query.OFFSET(10), db, &dest, `jet: can't set int16 to bool`)
+		testutils.AssertQueryErr(t, query.OFFSET(10), db, &dest, `jet: can't set int16 to bool`)
+	})
+}
+
+func TestScanStrictMapping(t *testing.T) {
+	qrm.SetStrictMapping(true)
+	defer qrm.SetStrictMapping(false)
+
+	t.Run("exact match", func(t *testing.T) {
+		dest := model.Inventory{}
+
+		err := query.Query(db, &dest)
+		assert.NilError(t, err)
+	})
+
+	t.Run("unmapped columns and unfilled fields", func(t *testing.T) {
+		type Inventory struct {
+			InventoryID int32
+			Title       string
+		}
+
+		dest := []Inventory{}
+
+		testutils.AssertQueryErr(t, query, db, &dest, "jet: destination does not match query result, "+
+			"unmapped columns: inventory.film_id, inventory.store_id, inventory.last_update; unfilled fields: Title")
+	})
+
+	t.Run("conversion error", func(t *testing.T) {
+		dest := []struct {
+			model.Inventory
+
+			FilmID bool `alias:"inventory.film_id"`
+		}{}
+
+		err := query.Query(db, &dest)
+
+		conversionErr, ok := err.(*qrm.ConversionError)
+		assert.Assert(t, ok)
+		assert.Equal(t, conversionErr.Column, "inventory.film_id")
+		assert.Equal(t, conversionErr.Field, "FilmID")
+		assert.Error(t, err, "jet: can't set int16 to bool, column 'inventory.film_id' to field 'FilmID'")
+	})
+
+	t.Run("explain", func(t *testing.T) {
+		dest := model.Inventory{}
+
+		assert.Equal(t, ExplainMapping(query, &dest).String(), `InventoryID int32 <- inventory.inventory_id
+FilmID int16 <- inventory.film_id
+StoreID int16 <- inventory.store_id
+LastUpdate time.Time <- inventory.last_update`)
+	})
+}
+
+func TestScanToMapAndRow(t *testing.T) {
+	t.Run("slice of maps", func(t *testing.T) {
+		var dest []map[string]interface{}
+
+		err := query.Query(db, &dest)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, dest, []map[string]interface{}{
+			{
+				"inventory.inventory_id": int32(1),
+				"inventory.film_id":      int16(1),
+				"inventory.store_id":     int16(1),
+				"inventory.last_update":  inventory1.LastUpdate,
+			},
+		})
+	})
+
+	t.Run("map keyed by column", func(t *testing.T) {
+		var dest map[int32]model.Inventory
+
+		err := query.Query(db, &dest)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, dest, map[int32]model.Inventory{1: inventory1})
+	})
+
+	t.Run("row", func(t *testing.T) {
+		var dest qrm.Row
+
+		err := query.Query(db, &dest)
+		assert.NilError(t, err)
+		assert.Equal(t, dest.GetInt64("inventory.film_id"), int64(1))
+		assert.Equal(t, dest.GetTime("inventory.last_update"), inventory1.LastUpdate)
 	})
 }
 
@@ -448,7 +531,7 @@ func TestScanToSlice(t *testing.T) {
 		t.Run("slice type mismatch", func(t *testing.T) {
 			var dest []bool
 
-			testutils.AssertQueryPanicErr(t, query, db, &dest, `jet: can't append int32 to []bool slice`)
+			testutils.AssertQueryErr(t, query, db, &dest, `jet: can't append int32 to []bool slice`)
 			//assert.Error(t, err, `jet: can't append int32 to []bool slice `)
 		})
 	})
@@ -690,7 +773,7 @@ func TestScanToSlice(t *testing.T) {
 			}
 		}
 
-		testutils.AssertQueryPanicErr(t, query, db, &dest, "jet: unsupported slice element type at 'Cities []**struct { *model.City }'")
+		testutils.AssertQueryErr(t, query, db, &dest, "jet: unsupported slice element type at 'Cities []**struct { *model.City }'")
 	})
 }
 